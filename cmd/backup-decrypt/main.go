@@ -0,0 +1,49 @@
+// Command backup-decrypt decrypts an export, if needed, and checks it against
+// its manifest so a truncated download is caught before it is restored.
+//
+//	BACKUP_PASSPHRASE=... backup-decrypt show-ratings.json.enc > show-ratings.json
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/handsomefox/website-rating/internal/backup"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if len(os.Args) != 2 {
+		return errors.New("usage: backup-decrypt <file>")
+	}
+
+	data, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		return err
+	}
+	if backup.IsEncrypted(data) {
+		passphrase := os.Getenv("BACKUP_PASSPHRASE")
+		if passphrase == "" {
+			return errors.New("BACKUP_PASSPHRASE is required")
+		}
+		if data, err = backup.Decrypt(data, passphrase); err != nil {
+			return err
+		}
+	}
+
+	// Only CSV/Trakt single-person files lack a manifest; those are meant
+	// for other services rather than for restoring here.
+	if err := backup.Verify(data); err != nil && !errors.Is(err, backup.ErrNoManifest) {
+		return err
+	}
+
+	_, err = os.Stdout.Write(data)
+	return err
+}
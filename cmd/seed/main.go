@@ -0,0 +1,124 @@
+// Command seed loads a fixtures file into the database for local development,
+// so the frontend can be worked on without a TMDB key or manual data entry.
+// The file uses the JSON export format, so a real export works too.
+//
+//	DB_PATH=./data/website-rating.db go run ./cmd/seed cmd/seed/fixtures.json
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/env"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+
+	_ "github.com/joho/godotenv/autoload"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if env.Current != env.Local {
+		return errors.New("seed only runs with ENV=local")
+	}
+	if len(os.Args) != 2 {
+		return errors.New("usage: seed <fixtures.json>")
+	}
+
+	raw, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		return err
+	}
+	var payload pb.ExportPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return fmt.Errorf("parse fixtures: %w", err)
+	}
+
+	st, err := store.Open(os.Getenv("DB_PATH"))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = st.Close() }()
+
+	ctx := context.Background()
+	for _, fixture := range payload.Shows {
+		if err := seedShow(ctx, st, fixture); err != nil {
+			return fmt.Errorf("seed %q: %w", fixture.Title, err)
+		}
+	}
+	fmt.Printf("Seeded %d shows\n", len(payload.Shows))
+	return nil
+}
+
+func seedShow(ctx context.Context, st *store.Store, fixture *pb.Show) error {
+	status := fixture.Status
+	if !store.ValidStatus(status) {
+		status = store.StatusPlanned
+	}
+
+	show := store.Show{
+		TMDBID:        fixture.TmdbId,
+		MediaType:     fixture.MediaType,
+		Title:         fixture.Title,
+		Year:          toSQLNull(fixture.Year),
+		Genres:        toSQLNull(fixture.Genres),
+		Overview:      toSQLNull(fixture.Overview),
+		PosterPath:    toSQLNull(fixture.PosterPath),
+		IMDbID:        toSQLNull(fixture.ImdbId),
+		TVDBID:        toSQLNull(fixture.TvdbId),
+		WikidataID:    toSQLNull(fixture.WikidataId),
+		TMDBRating:    toSQLNull(fixture.TmdbRating),
+		TMDBVotes:     toSQLNull(fixture.TmdbVotes),
+		Runtime:       toSQLNull(fixture.Runtime),
+		ReleaseDate:   toSQLNull(fixture.ReleaseDate),
+		NextAirDate:   toSQLNull(fixture.NextAirDate),
+		NextSeason:    toSQLNull(fixture.NextEpisodeSeason),
+		NextEpisode:   toSQLNull(fixture.NextEpisodeNumber),
+		OriginCountry: sql.Null[string]{V: strings.Join(fixture.OriginCountry, ","), Valid: len(fixture.OriginCountry) > 0},
+		Status:        status,
+	}
+	id, err := st.UpsertShow(ctx, &show)
+	if err != nil {
+		return err
+	}
+
+	patch := store.ShowPatch{
+		Status: &status,
+		Ratings: store.RatingsUpdate{
+			BfRating:  ptrIfSet(toSQLNull(fixture.BfRating)),
+			GfRating:  ptrIfSet(toSQLNull(fixture.GfRating)),
+			BfComment: ptrIfSet(toSQLNull(fixture.BfComment)),
+			GfComment: ptrIfSet(toSQLNull(fixture.GfComment)),
+		},
+		Pinned: &fixture.Pinned,
+	}
+	if fixture.WatchedAt != nil {
+		patch.WatchedAt = &sql.Null[string]{V: *fixture.WatchedAt, Valid: true}
+	}
+	return st.PatchShow(ctx, id, patch)
+}
+
+func toSQLNull[T any](v *T) sql.Null[T] {
+	if v == nil {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: *v, Valid: true}
+}
+
+func ptrIfSet[T any](v sql.Null[T]) *sql.Null[T] {
+	if !v.Valid {
+		return nil
+	}
+	return &v
+}
@@ -1,24 +1,37 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"net/http"
+	"net/netip"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	"github.com/go-chi/httplog/v3"
+	"github.com/handsomefox/website-rating/internal/arr"
+	"github.com/handsomefox/website-rating/internal/ddtd"
 	"github.com/handsomefox/website-rating/internal/env"
 	"github.com/handsomefox/website-rating/internal/handlers"
+	"github.com/handsomefox/website-rating/internal/i18n"
+	"github.com/handsomefox/website-rating/internal/jobs"
 	"github.com/handsomefox/website-rating/internal/logger"
+	"github.com/handsomefox/website-rating/internal/mediaserver"
+	"github.com/handsomefox/website-rating/internal/omdb"
+	"github.com/handsomefox/website-rating/internal/overseerr"
 	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/streaming"
 	"github.com/handsomefox/website-rating/internal/tmdb"
 	"github.com/handsomefox/website-rating/internal/web"
+	"github.com/handsomefox/website-rating/internal/wikipedia"
 
 	_ "github.com/joho/godotenv/autoload"
 )
@@ -26,18 +39,68 @@ import (
 const (
 	defaultPort      = "8080"
 	defaultImageBase = "https://image.tmdb.org/t/p/w342"
+	// tmdbRefreshDelay spaces out the background refresh's TMDB calls.
+	tmdbRefreshDelay = 250 * time.Millisecond
+	// warmupTimeout bounds the optional startup warm-up.
+	warmupTimeout = time.Minute
 )
 
 type appConfig struct {
 	port                 string
 	dbPath               string
 	tmdbAPIKey           string
+	tmdbFallbackKeys     []string
 	password             string
+	apiToken             string
 	imageBase            string
+	tmdbLanguage         string
+	tmdbIncludeAdult     bool
+	tmdbRateLimit        float64
+	tmdbCache            tmdb.CacheConfig
+	tmdbCachePersist     bool
 	bfName               string
 	gfName               string
+	scoreWeights         store.ScoreWeights
+	backupPassphrase     string
+	mediaServers         []mediaserver.Client
+	mediaSyncInterval    time.Duration
+	radarr               *arr.Client
+	sonarr               *arr.Client
+	overseerr            *overseerr.Client
+	overseerrInterval    time.Duration
+	ddtd                 *ddtd.Client
+	omdb                 *omdb.Client
+	wikipedia            *wikipedia.Client
+	readOnly             bool
+	warmup               bool
+	watchRegion          string
+	cookies              handlers.CookieConfig
+	slowQueryThreshold   time.Duration
+	optimizeInterval     time.Duration
+	integrityInterval    time.Duration
+	streamingInterval    time.Duration
+	tmdbRefreshInterval  time.Duration
+	tmdbStaleAfter       time.Duration
+	tmdbRefreshBatch     int
+	reminderInterval     time.Duration
+	reminderAfter        time.Duration
+	vetoLimit            int
+	ratingFreeze         time.Duration
 	allowedOrigins       []string
 	disableStaticContent bool
+	staticDir            string
+	server               serverConfig
+}
+
+// serverConfig holds the HTTP server knobs.
+type serverConfig struct {
+	h2c                bool
+	maxHeaderBytes     int
+	readTimeout        time.Duration
+	writeTimeout       time.Duration
+	longRequestTimeout time.Duration
+	// trustedProxies may set the client address in forwarding headers.
+	trustedProxies []netip.Prefix
 }
 
 func loadConfig() (appConfig, error) {
@@ -59,6 +122,170 @@ func loadConfig() (appConfig, error) {
 		return appConfig{}, err
 	}
 
+	includeAdult, err := strconv.ParseBool(envOr("TMDB_INCLUDE_ADULT", "false"))
+	if err != nil {
+		return appConfig{}, err
+	}
+	tmdbRateLimit, err := strconv.ParseFloat(envOr("TMDB_RATE_LIMIT", strconv.Itoa(tmdb.DefaultRateLimit)), 64)
+	if err != nil || tmdbRateLimit < 0 {
+		return appConfig{}, fmt.Errorf("TMDB_RATE_LIMIT: want requests per second, got %q", os.Getenv("TMDB_RATE_LIMIT"))
+	}
+	tmdbCache := tmdb.CacheConfig{}
+	if tmdbCache.Size, err = strconv.Atoi(envOr("TMDB_CACHE_SIZE", "1000")); err != nil || tmdbCache.Size < 0 {
+		return appConfig{}, fmt.Errorf("TMDB_CACHE_SIZE: want a non-negative number, got %q", os.Getenv("TMDB_CACHE_SIZE"))
+	}
+	if tmdbCache.DetailsTTL, err = time.ParseDuration(envOr("TMDB_CACHE_DETAILS_TTL", "24h")); err != nil {
+		return appConfig{}, fmt.Errorf("TMDB_CACHE_DETAILS_TTL: %w", err)
+	}
+	if tmdbCache.SearchTTL, err = time.ParseDuration(envOr("TMDB_CACHE_SEARCH_TTL", "1h")); err != nil {
+		return appConfig{}, fmt.Errorf("TMDB_CACHE_SEARCH_TTL: %w", err)
+	}
+	tmdbCachePersist, err := strconv.ParseBool(envOr("TMDB_CACHE_PERSIST", "false"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("TMDB_CACHE_PERSIST: %w", err)
+	}
+
+	readOnly, err := strconv.ParseBool(envOr("READ_ONLY", "false"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("READ_ONLY: %w", err)
+	}
+	warmup, err := strconv.ParseBool(envOr("STARTUP_WARMUP", "false"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("STARTUP_WARMUP: %w", err)
+	}
+	watchRegion := strings.ToUpper(strings.TrimSpace(os.Getenv("WATCH_REGION")))
+	if watchRegion != "" && (len(watchRegion) != 2 || strings.Trim(watchRegion, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "") {
+		return appConfig{}, fmt.Errorf("WATCH_REGION: want an ISO 3166-1 alpha-2 code, got %q", os.Getenv("WATCH_REGION"))
+	}
+
+	slowQueryThreshold, err := time.ParseDuration(envOr("SLOW_QUERY_THRESHOLD", "200ms"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("SLOW_QUERY_THRESHOLD: %w", err)
+	}
+
+	optimizeInterval, err := time.ParseDuration(envOr("OPTIMIZE_INTERVAL", "24h"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("OPTIMIZE_INTERVAL: %w", err)
+	}
+
+	integrityInterval, err := time.ParseDuration(envOr("INTEGRITY_CHECK_INTERVAL", "24h"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("INTEGRITY_CHECK_INTERVAL: %w", err)
+	}
+
+	cookies, err := loadCookieConfig()
+	if err != nil {
+		return appConfig{}, err
+	}
+
+	server, err := loadServerConfig()
+	if err != nil {
+		return appConfig{}, err
+	}
+
+	streamingInterval, err := time.ParseDuration(envOr("STREAMING_SYNC_INTERVAL", "24h"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("STREAMING_SYNC_INTERVAL: %w", err)
+	}
+
+	tmdbRefreshInterval, err := time.ParseDuration(envOr("TMDB_REFRESH_INTERVAL", "24h"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("TMDB_REFRESH_INTERVAL: %w", err)
+	}
+	tmdbStaleAfter, err := time.ParseDuration(envOr("TMDB_STALE_AFTER", "720h"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("TMDB_STALE_AFTER: %w", err)
+	}
+	tmdbRefreshBatch, err := strconv.Atoi(envOr("TMDB_REFRESH_BATCH", "100"))
+	if err != nil || tmdbRefreshBatch <= 0 {
+		return appConfig{}, fmt.Errorf("TMDB_REFRESH_BATCH: want a positive number, got %q", os.Getenv("TMDB_REFRESH_BATCH"))
+	}
+
+	reminderInterval, err := time.ParseDuration(envOr("REMINDER_INTERVAL", "24h"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("REMINDER_INTERVAL: %w", err)
+	}
+	reminderAfterDays, err := strconv.Atoi(envOr("REMINDER_AFTER_DAYS", "7"))
+	if err != nil || reminderAfterDays < 0 {
+		return appConfig{}, fmt.Errorf("REMINDER_AFTER_DAYS: want a non-negative number of days, got %q", os.Getenv("REMINDER_AFTER_DAYS"))
+	}
+
+	vetoLimit, err := strconv.Atoi(envOr("VETO_LIMIT", "3"))
+	if err != nil || vetoLimit < 0 {
+		return appConfig{}, fmt.Errorf("VETO_LIMIT: want a non-negative number, got %q", os.Getenv("VETO_LIMIT"))
+	}
+
+	ratingFreezeDays, err := strconv.Atoi(envOr("RATING_FREEZE_DAYS", "0"))
+	if err != nil || ratingFreezeDays < 0 {
+		return appConfig{}, fmt.Errorf("RATING_FREEZE_DAYS: want a non-negative number of days, got %q", os.Getenv("RATING_FREEZE_DAYS"))
+	}
+
+	scoreWeights := store.DefaultScoreWeights
+	if scoreWeights.Bf, err = strconv.ParseFloat(envOr("BF_SCORE_WEIGHT", "1"), 64); err != nil {
+		return appConfig{}, fmt.Errorf("BF_SCORE_WEIGHT: %w", err)
+	}
+	if scoreWeights.Gf, err = strconv.ParseFloat(envOr("GF_SCORE_WEIGHT", "1"), 64); err != nil {
+		return appConfig{}, fmt.Errorf("GF_SCORE_WEIGHT: %w", err)
+	}
+	if !scoreWeights.Valid() {
+		return appConfig{}, errors.New("score weights must be non-negative and not both zero")
+	}
+
+	var mediaServers []mediaserver.Client
+	if base := os.Getenv("PLEX_URL"); base != "" {
+		mediaServers = append(mediaServers, mediaserver.NewPlex(base, os.Getenv("PLEX_TOKEN")))
+	}
+	if base := os.Getenv("JELLYFIN_URL"); base != "" {
+		mediaServers = append(mediaServers, mediaserver.NewJellyfin(base, os.Getenv("JELLYFIN_API_KEY")))
+	}
+	mediaSyncInterval, err := time.ParseDuration(envOr("MEDIA_SYNC_INTERVAL", "6h"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("MEDIA_SYNC_INTERVAL: %w", err)
+	}
+	if mediaSyncInterval <= 0 {
+		return appConfig{}, errors.New("MEDIA_SYNC_INTERVAL must be positive")
+	}
+
+	radarr, err := arrClient("RADARR", arr.NewRadarr)
+	if err != nil {
+		return appConfig{}, err
+	}
+	sonarr, err := arrClient("SONARR", arr.NewSonarr)
+	if err != nil {
+		return appConfig{}, err
+	}
+
+	var overseerrClient *overseerr.Client
+	if base := os.Getenv("OVERSEERR_URL"); base != "" {
+		overseerrClient = overseerr.New(base, os.Getenv("OVERSEERR_API_KEY"))
+	}
+	overseerrInterval, err := time.ParseDuration(envOr("OVERSEERR_POLL_INTERVAL", "15m"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("OVERSEERR_POLL_INTERVAL: %w", err)
+	}
+	if overseerrInterval <= 0 {
+		return appConfig{}, errors.New("OVERSEERR_POLL_INTERVAL must be positive")
+	}
+
+	var ddtdClient *ddtd.Client
+	if key := os.Getenv("DDTD_API_KEY"); key != "" {
+		ddtdClient = ddtd.New(key, strings.Split(os.Getenv("DDTD_SENSITIVITIES"), ","))
+	}
+
+	var omdbClient *omdb.Client
+	if key := os.Getenv("OMDB_API_KEY"); key != "" {
+		omdbClient = omdb.New(key)
+	}
+
+	var wikipediaClient *wikipedia.Client
+	wikipediaEnabled, err := strconv.ParseBool(envOr("WIKIPEDIA_SUMMARIES", "false"))
+	if err != nil {
+		return appConfig{}, fmt.Errorf("WIKIPEDIA_SUMMARIES: %w", err)
+	}
+	if wikipediaEnabled {
+		wikipediaClient = wikipedia.New(os.Getenv("WIKIPEDIA_LANGUAGE"))
+	}
+
 	origins := []string{
 		"https://paired-ratings-production.up.railway.app",
 	}
@@ -73,17 +300,125 @@ func loadConfig() (appConfig, error) {
 		port:                 port,
 		dbPath:               dbPath,
 		tmdbAPIKey:           apiKey,
+		tmdbFallbackKeys:     strings.Split(os.Getenv("TMDB_FALLBACK_KEYS"), ","),
 		password:             password,
+		apiToken:             os.Getenv("API_TOKEN"),
 		imageBase:            envOr("TMDB_IMAGE_BASE", defaultImageBase),
+		tmdbLanguage:         i18n.Normalize(os.Getenv("TMDB_LANGUAGE")),
+		tmdbIncludeAdult:     includeAdult,
+		tmdbRateLimit:        tmdbRateLimit,
+		tmdbCache:            tmdbCache,
+		tmdbCachePersist:     tmdbCachePersist,
 		bfName:               envOr("BF_NAME", "Boyfriend"),
 		gfName:               envOr("GF_NAME", "Girlfriend"),
+		scoreWeights:         scoreWeights,
+		backupPassphrase:     os.Getenv("BACKUP_PASSPHRASE"),
+		mediaServers:         mediaServers,
+		mediaSyncInterval:    mediaSyncInterval,
+		radarr:               radarr,
+		sonarr:               sonarr,
+		overseerr:            overseerrClient,
+		overseerrInterval:    overseerrInterval,
+		ddtd:                 ddtdClient,
+		omdb:                 omdbClient,
+		wikipedia:            wikipediaClient,
+		readOnly:             readOnly,
+		warmup:               warmup,
+		watchRegion:          watchRegion,
+		slowQueryThreshold:   slowQueryThreshold,
+		optimizeInterval:     optimizeInterval,
+		integrityInterval:    integrityInterval,
+		streamingInterval:    streamingInterval,
+		tmdbRefreshInterval:  tmdbRefreshInterval,
+		tmdbStaleAfter:       tmdbStaleAfter,
+		tmdbRefreshBatch:     tmdbRefreshBatch,
+		reminderInterval:     reminderInterval,
+		reminderAfter:        time.Duration(reminderAfterDays) * 24 * time.Hour,
+		vetoLimit:            vetoLimit,
+		ratingFreeze:         time.Duration(ratingFreezeDays) * 24 * time.Hour,
 		allowedOrigins:       origins,
 		disableStaticContent: disableStaticContent,
+		staticDir:            os.Getenv("STATIC_DIR"),
+		cookies:              cookies,
+		server:               server,
 	}, nil
 }
 
+func loadServerConfig() (serverConfig, error) {
+	var (
+		cfg serverConfig
+		err error
+	)
+	if cfg.h2c, err = strconv.ParseBool(envOr("H2C", "false")); err != nil {
+		return cfg, fmt.Errorf("H2C: %w", err)
+	}
+	if cfg.maxHeaderBytes, err = strconv.Atoi(envOr("MAX_HEADER_BYTES", strconv.Itoa(http.DefaultMaxHeaderBytes))); err != nil {
+		return cfg, fmt.Errorf("MAX_HEADER_BYTES: %w", err)
+	}
+	if cfg.readTimeout, err = time.ParseDuration(envOr("READ_TIMEOUT", "10s")); err != nil {
+		return cfg, fmt.Errorf("READ_TIMEOUT: %w", err)
+	}
+	if cfg.writeTimeout, err = time.ParseDuration(envOr("WRITE_TIMEOUT", "10s")); err != nil {
+		return cfg, fmt.Errorf("WRITE_TIMEOUT: %w", err)
+	}
+	if cfg.longRequestTimeout, err = time.ParseDuration(envOr("LONG_REQUEST_TIMEOUT", "5m")); err != nil {
+		return cfg, fmt.Errorf("LONG_REQUEST_TIMEOUT: %w", err)
+	}
+	if cfg.trustedProxies, err = handlers.ParseTrustedProxies(os.Getenv("TRUSTED_PROXIES")); err != nil {
+		return cfg, fmt.Errorf("TRUSTED_PROXIES: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadCookieConfig reads the sign-in cookie settings. AUTH_COOKIE_TTL
+// "session" makes the cookie end with the browser session; empty
+// COOKIE_SAMESITE and COOKIE_SECURE keep the defaults picked from ENV.
+func loadCookieConfig() (handlers.CookieConfig, error) {
+	cfg := handlers.CookieConfig{Name: os.Getenv("AUTH_COOKIE_NAME")}
+
+	switch ttl := envOr("AUTH_COOKIE_TTL", "2160h"); ttl {
+	case "session":
+		cfg.SessionOnly = true
+	default:
+		d, err := time.ParseDuration(ttl)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("AUTH_COOKIE_TTL: want a positive duration or \"session\", got %q", ttl)
+		}
+		cfg.TTL = d
+	}
+
+	switch v := strings.ToLower(strings.TrimSpace(os.Getenv("COOKIE_SAMESITE"))); v {
+	case "":
+	case "lax":
+		cfg.SameSite = http.SameSiteLaxMode
+	case "strict":
+		cfg.SameSite = http.SameSiteStrictMode
+	case "none":
+		cfg.SameSite = http.SameSiteNoneMode
+	default:
+		return cfg, fmt.Errorf("COOKIE_SAMESITE: want lax, strict or none, got %q", v)
+	}
+
+	if v := strings.TrimSpace(os.Getenv("COOKIE_SECURE")); v != "" {
+		secure, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("COOKIE_SECURE: %w", err)
+		}
+		cfg.Secure = &secure
+	}
+	if cfg.SameSite == http.SameSiteNoneMode && cfg.Secure != nil && !*cfg.Secure {
+		return cfg, errors.New("COOKIE_SAMESITE=none needs COOKIE_SECURE=true")
+	}
+	return cfg, nil
+}
+
 func main() {
-	slog.SetDefault(logger.New(slog.LevelDebug))
+	logFile, err := logFileConfig()
+	if err != nil {
+		fmt.Println("Error:", err.Error())
+		os.Exit(1)
+	}
+	slog.SetDefault(logger.New(slog.LevelDebug, logFile))
 	if err := run(); err != nil {
 		fmt.Println("Error:", err.Error())
 		os.Exit(1)
@@ -106,17 +441,71 @@ func run() error {
 		}
 	}()
 
+	if cfg.slowQueryThreshold > 0 {
+		st.LogSlowQueries(cfg.slowQueryThreshold)
+	}
+	st.FreezeRatingsAfter(cfg.ratingFreeze)
+
+	// Read-only instances skip everything that writes in the background; the
+	// stored couple scores keep the weights they were computed with.
+	if !cfg.readOnly {
+		if err := st.SetScoreWeights(context.Background(), cfg.scoreWeights); err != nil {
+			return fmt.Errorf("failed to apply score weights: %w", err)
+		}
+	}
+
+	tmdbClient := tmdb.New(cfg.tmdbAPIKey, os.Getenv("TMDB_API_READ_TOKEN"), cfg.tmdbFallbackKeys...).
+		WithRateLimit(cfg.tmdbRateLimit).
+		WithIncludeAdult(cfg.tmdbIncludeAdult).
+		WithLanguage(cfg.tmdbLanguage)
+	if cfg.tmdbCache.Size > 0 || cfg.tmdbCachePersist {
+		cacheCfg := cfg.tmdbCache
+		// Read-only instances keep the cache in memory only.
+		if cfg.tmdbCachePersist && !cfg.readOnly {
+			cacheCfg.Store = st
+		}
+		tmdbClient = tmdb.NewCached(tmdbClient, cacheCfg)
+	}
+
+	runner := jobs.NewRunner()
+
 	app, err := handlers.New(&handlers.Config{
 		Store:     st,
-		TMDB:      tmdb.New(cfg.tmdbAPIKey, os.Getenv("TMDB_API_READ_TOKEN")),
+		TMDB:      tmdbClient,
 		Password:  cfg.password,
+		APIToken:  cfg.apiToken,
 		ImageBase: cfg.imageBase,
 		BfName:    cfg.bfName,
 		GfName:    cfg.gfName,
+
+		BackupPassphrase: cfg.backupPassphrase,
+		Radarr:           cfg.radarr,
+		Sonarr:           cfg.sonarr,
+		Overseerr:        cfg.overseerr,
+		DDTD:             cfg.ddtd,
+		OMDb:             cfg.omdb,
+		Wikipedia:        cfg.wikipedia,
+		ReadOnly:         cfg.readOnly,
+		Cookies:          cfg.cookies,
+		ReminderAfter:    cfg.reminderAfter,
+		VetoLimit:        cfg.vetoLimit,
+		Jobs:             runner,
+		Warmup:           cfg.warmup,
+		WatchRegion:      cfg.watchRegion,
+
+		LongRequestTimeout: cfg.server.longRequestTimeout,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to init handlers: %w", err)
 	}
+	runner.Start(context.Background(), backgroundJobs(&cfg, st, tmdbClient, app)...)
+	if cfg.warmup {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), warmupTimeout)
+			defer cancel()
+			app.Warmup(ctx)
+		}()
+	}
 
 	r := chi.NewRouter()
 	r.Use(
@@ -132,12 +521,12 @@ func run() error {
 			},
 		}),
 		middleware.Heartbeat("/ping"),
-		middleware.RealIP,
+		handlers.MiddlewareRealIP(cfg.server.trustedProxies),
 		middleware.RequestID,
 		cors.Handler(cors.Options{
 			AllowedOrigins:   cfg.allowedOrigins,
 			AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-			AllowedHeaders:   []string{"Accept", "Content-Type"},
+			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
 			AllowCredentials: true,
 			MaxAge:           600,
 		}),
@@ -148,10 +537,9 @@ func run() error {
 	})
 
 	if !cfg.disableStaticContent {
-		slog.Info("Serving static content")
-		distFS, err := web.Dist()
+		distFS, err := staticFS(cfg.staticDir)
 		if err != nil {
-			return fmt.Errorf("failed to load embedded web dist: %w", err)
+			return err
 		}
 		spa, err := handlers.SPA(distFS)
 		if err != nil {
@@ -166,9 +554,18 @@ func run() error {
 		Addr:              addr,
 		Handler:           r,
 		ReadHeaderTimeout: 5 * time.Second,
-		ReadTimeout:       10 * time.Second,
-		WriteTimeout:      10 * time.Second,
+		ReadTimeout:       cfg.server.readTimeout,
+		WriteTimeout:      cfg.server.writeTimeout,
 		IdleTimeout:       60 * time.Second,
+		MaxHeaderBytes:    cfg.server.maxHeaderBytes,
+	}
+	if cfg.server.h2c {
+		// Reverse proxies that speak HTTP/2 to the backend need prior-knowledge
+		// h2c; HTTP/1.1 keeps working alongside it.
+		protocols := new(http.Protocols)
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		server.Protocols = protocols
 	}
 	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("server error: %w", err)
@@ -176,6 +573,207 @@ func run() error {
 	return nil
 }
 
+// staticFS returns the frontend build: STATIC_DIR when set, otherwise the
+// embedded dist.
+func staticFS(dir string) (fs.FS, error) {
+	if dir != "" {
+		slog.Info("Serving static content", slog.String("dir", dir))
+		return os.DirFS(dir), nil
+	}
+	slog.Info("Serving static content")
+	distFS, err := web.Dist()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embedded web dist: %w", err)
+	}
+	return distFS, nil
+}
+
+// backgroundJobs lists the periodic jobs; read-only instances only get the
+// ones that do not write.
+func backgroundJobs(cfg *appConfig, st *store.Store, tmdbClient tmdb.MetadataProvider, app *handlers.Handler) []jobs.Job {
+	var out []jobs.Job
+	if cfg.integrityInterval > 0 {
+		out = append(out, jobs.Job{
+			Name:       "integrity check",
+			Interval:   cfg.integrityInterval,
+			RunAtStart: true,
+			Run: func(ctx context.Context) error {
+				report, err := st.CheckIntegrity(ctx)
+				if err != nil {
+					return err
+				}
+				if len(report.Problems) > 0 {
+					slog.Error("database integrity check failed", slog.Any("problems", report.Problems))
+				}
+				return nil
+			},
+		})
+	}
+	if cfg.readOnly {
+		return out
+	}
+
+	for _, client := range cfg.mediaServers {
+		out = append(out, jobs.Job{
+			Name:       "media sync: " + client.Name(),
+			Interval:   cfg.mediaSyncInterval,
+			RunAtStart: true,
+			Run: func(ctx context.Context) error {
+				return mediaserver.Sync(ctx, st, client)
+			},
+		})
+	}
+	if cfg.overseerr != nil {
+		out = append(out, jobs.Job{
+			Name:       "overseerr poll",
+			Interval:   cfg.overseerrInterval,
+			RunAtStart: true,
+			Run: func(ctx context.Context) error {
+				return overseerr.Refresh(ctx, st, cfg.overseerr)
+			},
+		})
+	}
+	// Registered even when STREAMING_SYNC_INTERVAL is 0, so changing the
+	// subscriptions can still trigger it.
+	out = append(out, jobs.Job{
+		Name:     handlers.StreamingRefreshJob,
+		Interval: cfg.streamingInterval,
+		Run: func(ctx context.Context) error {
+			return streaming.Refresh(ctx, st, tmdbClient)
+		},
+	})
+	if cfg.tmdbRefreshInterval > 0 {
+		out = append(out, jobs.Job{
+			Name:     "tmdb refresh",
+			Interval: cfg.tmdbRefreshInterval,
+			Jitter:   cfg.tmdbRefreshInterval / 10,
+			Run: func(ctx context.Context) error {
+				return app.RefreshStaleTMDB(ctx, cfg.tmdbStaleAfter, cfg.tmdbRefreshBatch, tmdbRefreshDelay)
+			},
+		})
+	}
+	if cfg.optimizeInterval > 0 {
+		out = append(out, jobs.Job{
+			Name:     "optimize",
+			Interval: cfg.optimizeInterval,
+			Run: func(ctx context.Context) error {
+				_, err := st.Optimize(ctx)
+				return err
+			},
+		})
+	}
+	if cfg.reminderInterval > 0 {
+		out = append(out, jobs.Job{
+			Name:     "reminders",
+			Interval: cfg.reminderInterval,
+			Run: func(ctx context.Context) error {
+				if err := logPlanReminders(ctx, st, cfg.reminderInterval); err != nil {
+					return err
+				}
+				return logRatingReminders(ctx, st, cfg.reminderAfter)
+			},
+		})
+	}
+	return out
+}
+
+// logRatingReminders logs, per person, the watched shows they still have to
+// rate. There is no notification channel, so the log is where reminders go.
+func logRatingReminders(ctx context.Context, st *store.Store, after time.Duration) error {
+	reminders, err := st.RatingReminders(ctx, after)
+	if err != nil {
+		return err
+	}
+	for _, reminder := range reminders {
+		titles := make([]string, 0, len(reminder.Shows))
+		for _, show := range reminder.Shows {
+			titles = append(titles, show.Title)
+		}
+		slog.Info("rating reminder",
+			slog.String("person", reminder.Person),
+			slog.Int("count", len(reminder.Shows)),
+			slog.Any("titles", titles),
+		)
+	}
+	return nil
+}
+
+// logPlanReminders logs the movie nights coming up before the next run.
+func logPlanReminders(ctx context.Context, st *store.Store, within time.Duration) error {
+	now := time.Now().UTC()
+	plans, err := st.ListPlansBetween(ctx, now.Format(time.RFC3339), now.Add(within).Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	for _, plan := range plans {
+		if plan.CompletedAt.Valid {
+			continue
+		}
+		show, err := st.GetShow(ctx, plan.ShowID)
+		if err != nil {
+			return err
+		}
+		slog.Info("movie night reminder",
+			slog.String("title", show.Title),
+			slog.String("scheduled_at", plan.ScheduledAt),
+			slog.Bool("confirmed", plan.BfConfirmed && plan.GfConfirmed),
+		)
+	}
+	return nil
+}
+
+// arrClient reads <prefix>_URL, _API_KEY, _ROOT_FOLDER and _QUALITY_PROFILE.
+// It returns nil when <prefix>_URL is unset.
+func arrClient(prefix string, newClient func(arr.Config) *arr.Client) (*arr.Client, error) {
+	base := os.Getenv(prefix + "_URL")
+	if base == "" {
+		return nil, nil
+	}
+	cfg := arr.Config{
+		URL:        base,
+		APIKey:     os.Getenv(prefix + "_API_KEY"),
+		RootFolder: os.Getenv(prefix + "_ROOT_FOLDER"),
+	}
+	if cfg.APIKey == "" || cfg.RootFolder == "" {
+		return nil, fmt.Errorf("%s_API_KEY and %s_ROOT_FOLDER are required with %s_URL", prefix, prefix, prefix)
+	}
+	profile, err := strconv.ParseInt(envOr(prefix+"_QUALITY_PROFILE", "1"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s_QUALITY_PROFILE: %w", prefix, err)
+	}
+	cfg.QualityProfileID = profile
+	return newClient(cfg), nil
+}
+
+// logFileConfig reads LOG_FILE and its rotation limits. It returns nil when
+// LOG_FILE is unset.
+func logFileConfig() (*logger.RotatingFile, error) {
+	path := os.Getenv("LOG_FILE")
+	if path == "" {
+		return nil, nil
+	}
+
+	maxSizeMB, err := strconv.ParseInt(envOr("LOG_MAX_SIZE_MB", "10"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("LOG_MAX_SIZE_MB: %w", err)
+	}
+	maxAge, err := time.ParseDuration(envOr("LOG_MAX_AGE", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("LOG_MAX_AGE: %w", err)
+	}
+	maxBackups, err := strconv.Atoi(envOr("LOG_MAX_BACKUPS", "7"))
+	if err != nil {
+		return nil, fmt.Errorf("LOG_MAX_BACKUPS: %w", err)
+	}
+
+	return &logger.RotatingFile{
+		Path:       path,
+		MaxSize:    maxSizeMB << 20,
+		MaxAge:     maxAge,
+		MaxBackups: maxBackups,
+	}, nil
+}
+
 func envOr(key, fallback string) string {
 	if val := os.Getenv(key); val != "" {
 		return val
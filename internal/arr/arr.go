@@ -0,0 +1,144 @@
+// Package arr sends titles to Radarr (movies) and Sonarr (TV) for download.
+package arr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrMissingTVDBID is returned by Sonarr requests for shows without a TVDB ID,
+// which Sonarr needs to identify a series.
+var ErrMissingTVDBID = errors.New("arr: sonarr needs a tvdb id")
+
+type Config struct {
+	URL        string
+	APIKey     string
+	RootFolder string
+	// QualityProfileID is used when a request does not pick a profile.
+	QualityProfileID int64
+}
+
+type kind int
+
+const (
+	radarr kind = iota
+	sonarr
+)
+
+type Client struct {
+	http *http.Client
+	kind kind
+	cfg  Config
+}
+
+func NewRadarr(cfg Config) *Client { return newClient(radarr, cfg) }
+func NewSonarr(cfg Config) *Client { return newClient(sonarr, cfg) }
+
+func newClient(k kind, cfg Config) *Client {
+	cfg.URL = strings.TrimRight(strings.TrimSpace(cfg.URL), "/")
+	return &Client{http: &http.Client{Timeout: 30 * time.Second}, kind: k, cfg: cfg}
+}
+
+// Title identifies what to request.
+type Title struct {
+	TMDBID int64
+	TVDBID int64
+}
+
+// Add looks the title up and adds it as monitored, starting a search right
+// away. A title that is already added counts as success.
+func (c *Client) Add(ctx context.Context, title Title, qualityProfileID int64) error {
+	if qualityProfileID <= 0 {
+		qualityProfileID = c.cfg.QualityProfileID
+	}
+
+	var item map[string]any
+	switch c.kind {
+	case radarr:
+		if err := c.do(ctx, http.MethodGet, "/api/v3/movie/lookup/tmdb?tmdbId="+strconv.FormatInt(title.TMDBID, 10), nil, &item); err != nil {
+			return err
+		}
+		item["addOptions"] = map[string]any{"searchForMovie": true}
+	case sonarr:
+		if title.TVDBID <= 0 {
+			return ErrMissingTVDBID
+		}
+		var found []map[string]any
+		term := url.QueryEscape("tvdb:" + strconv.FormatInt(title.TVDBID, 10))
+		if err := c.do(ctx, http.MethodGet, "/api/v3/series/lookup?term="+term, nil, &found); err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			return fmt.Errorf("sonarr: no series for tvdb id %d", title.TVDBID)
+		}
+		item = found[0]
+		item["seasonFolder"] = true
+		item["addOptions"] = map[string]any{"searchForMissingEpisodes": true}
+	}
+
+	// Already added: Radarr/Sonarr return the existing entry with an id.
+	if id, ok := item["id"].(float64); ok && id > 0 {
+		return nil
+	}
+
+	item["qualityProfileId"] = qualityProfileID
+	item["rootFolderPath"] = c.cfg.RootFolder
+	item["monitored"] = true
+
+	endpoint := "/api/v3/movie"
+	if c.kind == sonarr {
+		endpoint = "/api/v3/series"
+	}
+	return c.do(ctx, http.MethodPost, endpoint, item, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, dst any) error {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.cfg.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Api-Key", c.cfg.APIKey)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("%s request failed: %s", c.name(), resp.Status)
+	}
+	if dst == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+func (c *Client) name() string {
+	if c.kind == sonarr {
+		return "sonarr"
+	}
+	return "radarr"
+}
@@ -0,0 +1,151 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// SchemaVersion is bumped whenever the JSON export layout changes.
+const SchemaVersion = 1
+
+// ManifestName is the manifest entry inside zip exports.
+const ManifestName = "manifest.json"
+
+var (
+	// ErrNoManifest is returned for exports made before manifests existed.
+	ErrNoManifest = errors.New("backup: export has no manifest")
+	// ErrMismatch is returned when an export does not match its manifest,
+	// usually because the file was truncated.
+	ErrMismatch = errors.New("backup: export does not match its manifest")
+)
+
+// ArchiveManifest lists every file of a zip export.
+type ArchiveManifest struct {
+	SchemaVersion int           `json:"schema_version"`
+	Files         []ArchiveFile `json:"files"`
+}
+
+type ArchiveFile struct {
+	Name   string `json:"name"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Checksum returns the hex SHA-256 of data.
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks a decrypted export against its manifest. Zip archives are
+// checked file by file; anything else is treated as a JSON export.
+func Verify(data []byte) error {
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return verifyArchive(data)
+	}
+	return verifyExport(data)
+}
+
+// verifyExport checks the show count and the checksum of the compact "shows"
+// array of a JSON export.
+func verifyExport(data []byte) error {
+	var payload struct {
+		Manifest *struct {
+			SchemaVersion int    `json:"schema_version"`
+			ShowCount     int    `json:"show_count"`
+			SHA256        string `json:"sha256"`
+		} `json:"manifest"`
+		Shows json.RawMessage `json:"shows"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("%w: %w", ErrMismatch, err)
+	}
+	if payload.Manifest == nil {
+		return ErrNoManifest
+	}
+	if payload.Manifest.SchemaVersion > SchemaVersion {
+		return fmt.Errorf("backup: unsupported schema version %d", payload.Manifest.SchemaVersion)
+	}
+
+	// The "shows" key is omitted when the library is empty; the manifest
+	// still covers the empty array.
+	if payload.Shows == nil {
+		payload.Shows = json.RawMessage("[]")
+	}
+	var shows bytes.Buffer
+	if err := json.Compact(&shows, payload.Shows); err != nil {
+		return fmt.Errorf("%w: %w", ErrMismatch, err)
+	}
+	var rows []json.RawMessage
+	if err := json.Unmarshal(shows.Bytes(), &rows); err != nil {
+		return fmt.Errorf("%w: %w", ErrMismatch, err)
+	}
+	if len(rows) != payload.Manifest.ShowCount {
+		return fmt.Errorf("%w: %d shows, manifest says %d", ErrMismatch, len(rows), payload.Manifest.ShowCount)
+	}
+	if Checksum(shows.Bytes()) != payload.Manifest.SHA256 {
+		return fmt.Errorf("%w: checksum differs", ErrMismatch)
+	}
+	return nil
+}
+
+func verifyArchive(data []byte) error {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMismatch, err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	raw, err := readZipFile(files[ManifestName])
+	if err != nil {
+		return err
+	}
+	var manifest ArchiveManifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return fmt.Errorf("%w: %w", ErrMismatch, err)
+	}
+	if manifest.SchemaVersion > SchemaVersion {
+		return fmt.Errorf("backup: unsupported schema version %d", manifest.SchemaVersion)
+	}
+
+	for _, want := range manifest.Files {
+		f, ok := files[want.Name]
+		if !ok {
+			return fmt.Errorf("%w: %s is missing", ErrMismatch, want.Name)
+		}
+		body, err := readZipFile(f)
+		if err != nil {
+			return err
+		}
+		if len(body) != want.Size || Checksum(body) != want.SHA256 {
+			return fmt.Errorf("%w: %s differs", ErrMismatch, want.Name)
+		}
+	}
+	return nil
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	if f == nil {
+		return nil, ErrNoManifest
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMismatch, err)
+	}
+	defer rc.Close()
+	body, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMismatch, err)
+	}
+	return body, nil
+}
@@ -0,0 +1,167 @@
+// Package ddtd looks up content warnings on DoesTheDogDie.com.
+package ddtd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultBase = "https://www.doesthedogdie.com"
+
+type Client struct {
+	http   *http.Client
+	base   string
+	apiKey string
+	// sensitivities are lowercased topic names; empty keeps every topic.
+	sensitivities []string
+}
+
+// New returns a client for the API key. With sensitivities, Relevant only
+// accepts topics whose name contains one of them (case-insensitive).
+func New(apiKey string, sensitivities []string) *Client {
+	c := &Client{
+		http:   &http.Client{Timeout: 10 * time.Second},
+		base:   defaultBase,
+		apiKey: strings.TrimSpace(apiKey),
+	}
+	for _, s := range sensitivities {
+		if s = strings.ToLower(strings.TrimSpace(s)); s != "" {
+			c.sensitivities = append(c.sensitivities, s)
+		}
+	}
+	return c
+}
+
+// Warning is one topic the community voted "yes" on.
+type Warning struct {
+	Topic string `json:"topic"`
+	Yes   int    `json:"yes"`
+	No    int    `json:"no"`
+}
+
+type searchResponse struct {
+	Items []struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		ReleaseYear string `json:"releaseYear"`
+		ItemType    struct {
+			Name string `json:"name"`
+		} `json:"itemType"`
+	} `json:"items"`
+}
+
+type mediaResponse struct {
+	TopicItemStats []struct {
+		Topic struct {
+			Name     string `json:"name"`
+			DoesName string `json:"doesName"`
+		} `json:"topic"`
+		YesSum int `json:"yesSum"`
+		NoSum  int `json:"noSum"`
+	} `json:"topicItemStats"`
+}
+
+// Warnings finds the title (by IMDb ID when known, otherwise by title and
+// year) and returns every topic with more "yes" than "no" votes. It returns
+// nil when DoesTheDogDie has no entry.
+func (c *Client) Warnings(ctx context.Context, imdbID, title string, year int64, mediaType string) ([]Warning, error) {
+	values := url.Values{}
+	if imdbID != "" {
+		values.Set("imdb", imdbID)
+	} else {
+		values.Set("q", title)
+	}
+
+	var search searchResponse
+	if err := c.get(ctx, "/dddsearch", values, &search); err != nil {
+		return nil, err
+	}
+
+	var id int64
+	for _, item := range search.Items {
+		if imdbID == "" && !matches(item.ReleaseYear, item.ItemType.Name, year, mediaType) {
+			continue
+		}
+		id = item.ID
+		break
+	}
+	if id == 0 {
+		return nil, nil
+	}
+
+	var media mediaResponse
+	if err := c.get(ctx, "/media/"+strconv.FormatInt(id, 10), nil, &media); err != nil {
+		return nil, err
+	}
+
+	out := make([]Warning, 0, len(media.TopicItemStats))
+	for _, stat := range media.TopicItemStats {
+		if stat.YesSum <= stat.NoSum {
+			continue
+		}
+		topic := stat.Topic.DoesName
+		if topic == "" {
+			topic = stat.Topic.Name
+		}
+		out = append(out, Warning{Topic: topic, Yes: stat.YesSum, No: stat.NoSum})
+	}
+	return out, nil
+}
+
+// matches checks a title search hit against the show's year and media type.
+func matches(releaseYear, itemType string, year int64, mediaType string) bool {
+	if year > 0 && releaseYear != strconv.FormatInt(year, 10) {
+		return false
+	}
+	switch strings.ToLower(itemType) {
+	case "movie":
+		return mediaType == "movie"
+	case "tv show":
+		return mediaType == "tv"
+	}
+	return true
+}
+
+// Relevant reports whether the topic matches the configured sensitivities.
+func (c *Client) Relevant(topic string) bool {
+	if len(c.sensitivities) == 0 {
+		return true
+	}
+	topic = strings.ToLower(topic)
+	for _, s := range c.sensitivities {
+		if strings.Contains(topic, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) get(ctx context.Context, path string, values url.Values, dst any) error {
+	endpoint := c.base + path
+	if len(values) > 0 {
+		endpoint += "?" + values.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-KEY", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("doesthedogdie request failed: %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
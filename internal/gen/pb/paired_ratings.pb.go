@@ -27,6 +27,13 @@ type SessionResponse struct {
 	ImageBase     *string                `protobuf:"bytes,2,opt,name=image_base,proto3,oneof" json:"image_base,omitempty"`
 	BfName        *string                `protobuf:"bytes,3,opt,name=bf_name,proto3,oneof" json:"bf_name,omitempty"`
 	GfName        *string                `protobuf:"bytes,4,opt,name=gf_name,proto3,oneof" json:"gf_name,omitempty"`
+	// Which person ("bf"/"gf") is using this session, when picked.
+	Person *string `protobuf:"bytes,5,opt,name=person,proto3,oneof" json:"person,omitempty"`
+	// Set when the instance runs with READ_ONLY; mutating calls return 403.
+	ReadOnly bool `protobuf:"varint,6,opt,name=read_only,proto3" json:"read_only,omitempty"`
+	// True when signed in to the person's own account: the person can't be
+	// switched and only their own ratings can be written.
+	Account       bool `protobuf:"varint,7,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,27 +96,59 @@ func (x *SessionResponse) GetGfName() string {
 	return ""
 }
 
-type ErrorResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+func (x *SessionResponse) GetPerson() string {
+	if x != nil && x.Person != nil {
+		return *x.Person
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *SessionResponse) GetAccount() bool {
+	if x != nil {
+		return x.Account
+	}
+	return false
+}
+
+// One signed-in device.
+type SessionInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// User-chosen device name ("Kitchen tablet").
+	Name      *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	UserAgent string  `protobuf:"bytes,3,opt,name=user_agent,proto3" json:"user_agent,omitempty"`
+	// Address of the latest request.
+	Ip         string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt  string `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	LastUsedAt string `protobuf:"bytes,6,opt,name=last_used_at,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt  string `protobuf:"bytes,7,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	// Set on the session making the request.
+	Current       bool `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ErrorResponse) Reset() {
-	*x = ErrorResponse{}
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
 	mi := &file_paired_ratings_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ErrorResponse) String() string {
+func (x *SessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorResponse) ProtoMessage() {}
+func (*SessionInfo) ProtoMessage() {}
 
-func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_paired_ratings_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -121,57 +160,88 @@ func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
-func (*ErrorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
 	return file_paired_ratings_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ErrorResponse) GetError() string {
+func (x *SessionInfo) GetId() int64 {
 	if x != nil {
-		return x.Error
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-type Show struct {
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type SessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TmdbId        int64                  `protobuf:"varint,2,opt,name=tmdb_id,proto3" json:"tmdb_id,omitempty"`
-	MediaType     string                 `protobuf:"bytes,3,opt,name=media_type,proto3" json:"media_type,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Year          *int64                 `protobuf:"varint,5,opt,name=year,proto3,oneof" json:"year,omitempty"`
-	Genres        *string                `protobuf:"bytes,6,opt,name=genres,proto3,oneof" json:"genres,omitempty"`
-	Overview      *string                `protobuf:"bytes,7,opt,name=overview,proto3,oneof" json:"overview,omitempty"`
-	PosterPath    *string                `protobuf:"bytes,8,opt,name=poster_path,proto3,oneof" json:"poster_path,omitempty"`
-	ImdbId        *string                `protobuf:"bytes,9,opt,name=imdb_id,proto3,oneof" json:"imdb_id,omitempty"`
-	TmdbRating    *float64               `protobuf:"fixed64,10,opt,name=tmdb_rating,proto3,oneof" json:"tmdb_rating,omitempty"`
-	TmdbVotes     *int64                 `protobuf:"varint,11,opt,name=tmdb_votes,proto3,oneof" json:"tmdb_votes,omitempty"`
-	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	BfRating      *int64                 `protobuf:"varint,13,opt,name=bf_rating,proto3,oneof" json:"bf_rating,omitempty"`
-	GfRating      *int64                 `protobuf:"varint,14,opt,name=gf_rating,proto3,oneof" json:"gf_rating,omitempty"`
-	BfComment     *string                `protobuf:"bytes,15,opt,name=bf_comment,proto3,oneof" json:"bf_comment,omitempty"`
-	GfComment     *string                `protobuf:"bytes,16,opt,name=gf_comment,proto3,oneof" json:"gf_comment,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,17,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
-	OriginCountry []string               `protobuf:"bytes,19,rep,name=origin_country,proto3" json:"origin_country,omitempty"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Show) Reset() {
-	*x = Show{}
+func (x *SessionsResponse) Reset() {
+	*x = SessionsResponse{}
 	mi := &file_paired_ratings_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Show) String() string {
+func (x *SessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Show) ProtoMessage() {}
+func (*SessionsResponse) ProtoMessage() {}
 
-func (x *Show) ProtoReflect() protoreflect.Message {
+func (x *SessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_paired_ratings_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -183,167 +253,5736 @@ func (x *Show) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Show.ProtoReflect.Descriptor instead.
-func (*Show) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionsResponse.ProtoReflect.Descriptor instead.
+func (*SessionsResponse) Descriptor() ([]byte, []int) {
 	return file_paired_ratings_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Show) GetId() int64 {
+func (x *SessionsResponse) GetSessions() []*SessionInfo {
 	if x != nil {
-		return x.Id
+		return x.Sessions
 	}
-	return 0
+	return nil
 }
 
-func (x *Show) GetTmdbId() int64 {
+type SessionPatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionPatch) Reset() {
+	*x = SessionPatch{}
+	mi := &file_paired_ratings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionPatch) ProtoMessage() {}
+
+func (x *SessionPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[3]
 	if x != nil {
-		return x.TmdbId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Show) GetMediaType() string {
+// Deprecated: Use SessionPatch.ProtoReflect.Descriptor instead.
+func (*SessionPatch) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SessionPatch) GetName() string {
 	if x != nil {
-		return x.MediaType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Show) GetTitle() string {
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[4]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
 	}
 	return ""
 }
 
-func (x *Show) GetYear() int64 {
-	if x != nil && x.Year != nil {
-		return *x.Year
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
 	}
-	return 0
+	return ""
 }
 
-func (x *Show) GetGenres() string {
-	if x != nil && x.Genres != nil {
-		return *x.Genres
+type ErrorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Error string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// ID of the failed request as logged by the server, to quote when
+	// reporting it.
+	RequestId     string `protobuf:"bytes,2,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResponse) ProtoMessage() {}
+
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ErrorResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *Show) GetOverview() string {
-	if x != nil && x.Overview != nil {
-		return *x.Overview
+func (x *ErrorResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *Show) GetPosterPath() string {
-	if x != nil && x.PosterPath != nil {
-		return *x.PosterPath
+// RFC 7807 problem details, served as application/problem+json.
+type ProblemDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Instance      string                 `protobuf:"bytes,5,opt,name=instance,proto3" json:"instance,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProblemDetails) Reset() {
+	*x = ProblemDetails{}
+	mi := &file_paired_ratings_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProblemDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProblemDetails) ProtoMessage() {}
+
+func (x *ProblemDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProblemDetails.ProtoReflect.Descriptor instead.
+func (*ProblemDetails) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProblemDetails) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Show) GetImdbId() string {
-	if x != nil && x.ImdbId != nil {
-		return *x.ImdbId
+func (x *ProblemDetails) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Show) GetTmdbRating() float64 {
-	if x != nil && x.TmdbRating != nil {
-		return *x.TmdbRating
+func (x *ProblemDetails) GetStatus() int32 {
+	if x != nil {
+		return x.Status
 	}
 	return 0
 }
 
-func (x *Show) GetTmdbVotes() int64 {
-	if x != nil && x.TmdbVotes != nil {
-		return *x.TmdbVotes
+func (x *ProblemDetails) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ProblemDetails) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *ProblemDetails) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type Show struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TmdbId        int64                  `protobuf:"varint,2,opt,name=tmdb_id,proto3" json:"tmdb_id,omitempty"`
+	MediaType     string                 `protobuf:"bytes,3,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Year          *int64                 `protobuf:"varint,5,opt,name=year,proto3,oneof" json:"year,omitempty"`
+	Genres        *string                `protobuf:"bytes,6,opt,name=genres,proto3,oneof" json:"genres,omitempty"`
+	Overview      *string                `protobuf:"bytes,7,opt,name=overview,proto3,oneof" json:"overview,omitempty"`
+	PosterPath    *string                `protobuf:"bytes,8,opt,name=poster_path,proto3,oneof" json:"poster_path,omitempty"`
+	ImdbId        *string                `protobuf:"bytes,9,opt,name=imdb_id,proto3,oneof" json:"imdb_id,omitempty"`
+	TmdbRating    *float64               `protobuf:"fixed64,10,opt,name=tmdb_rating,proto3,oneof" json:"tmdb_rating,omitempty"`
+	TmdbVotes     *int64                 `protobuf:"varint,11,opt,name=tmdb_votes,proto3,oneof" json:"tmdb_votes,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	BfRating      *int64                 `protobuf:"varint,13,opt,name=bf_rating,proto3,oneof" json:"bf_rating,omitempty"`
+	GfRating      *int64                 `protobuf:"varint,14,opt,name=gf_rating,proto3,oneof" json:"gf_rating,omitempty"`
+	BfComment     *string                `protobuf:"bytes,15,opt,name=bf_comment,proto3,oneof" json:"bf_comment,omitempty"`
+	GfComment     *string                `protobuf:"bytes,16,opt,name=gf_comment,proto3,oneof" json:"gf_comment,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,17,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	OriginCountry []string               `protobuf:"bytes,19,rep,name=origin_country,proto3" json:"origin_country,omitempty"`
+	TvdbId        *int64                 `protobuf:"varint,20,opt,name=tvdb_id,proto3,oneof" json:"tvdb_id,omitempty"`
+	WikidataId    *string                `protobuf:"bytes,21,opt,name=wikidata_id,proto3,oneof" json:"wikidata_id,omitempty"`
+	// Minutes: movie length, or typical episode length for TV.
+	Runtime *int64 `protobuf:"varint,22,opt,name=runtime,proto3,oneof" json:"runtime,omitempty"`
+	Pinned  bool   `protobuf:"varint,23,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Movie release or TV first air date (YYYY-MM-DD).
+	ReleaseDate *string `protobuf:"bytes,24,opt,name=release_date,proto3,oneof" json:"release_date,omitempty"`
+	// When the next TV episode airs (YYYY-MM-DD), if known.
+	NextAirDate       *string `protobuf:"bytes,25,opt,name=next_air_date,proto3,oneof" json:"next_air_date,omitempty"`
+	NextEpisodeSeason *int64  `protobuf:"varint,26,opt,name=next_episode_season,proto3,oneof" json:"next_episode_season,omitempty"`
+	NextEpisodeNumber *int64  `protobuf:"varint,27,opt,name=next_episode_number,proto3,oneof" json:"next_episode_number,omitempty"`
+	// Weighted mean of the present ratings (BF_SCORE_WEIGHT/GF_SCORE_WEIGHT).
+	CoupleScore *float64 `protobuf:"fixed64,28,opt,name=couple_score,proto3,oneof" json:"couple_score,omitempty"`
+	// |bf_rating - gf_rating|, once both are set.
+	RatingDelta *int64 `protobuf:"varint,29,opt,name=rating_delta,proto3,oneof" json:"rating_delta,omitempty"`
+	// "agree" (delta 0-1), "mixed" (2-3), "disagree" (4+), or "" until both rated.
+	Agreement string  `protobuf:"bytes,30,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	WatchedAt *string `protobuf:"bytes,31,opt,name=watched_at,proto3,oneof" json:"watched_at,omitempty"`
+	// Media servers ("plex", "jellyfin") that had the show at the last sync.
+	AvailableOn []string `protobuf:"bytes,32,rep,name=available_on,proto3" json:"available_on,omitempty"`
+	// Download request state: "requested" once sent to Radarr/Sonarr, or the
+	// Overseerr status (pending, processing, partially_available, available,
+	// declined).
+	RequestStatus *string `protobuf:"bytes,33,opt,name=request_status,proto3,oneof" json:"request_status,omitempty"`
+	RequestedAt   *string `protobuf:"bytes,34,opt,name=requested_at,proto3,oneof" json:"requested_at,omitempty"`
+	// Production company (studio) names.
+	Companies []string `protobuf:"bytes,35,rep,name=companies,proto3" json:"companies,omitempty"`
+	// TV network names.
+	Networks []string `protobuf:"bytes,36,rep,name=networks,proto3" json:"networks,omitempty"`
+	// TV episode count from TMDB.
+	EpisodeCount *int64 `protobuf:"varint,37,opt,name=episode_count,proto3,oneof" json:"episode_count,omitempty"`
+	// OMDb scores (0-100), set when OMDB_API_KEY is configured.
+	RtScore   *int64 `protobuf:"varint,38,opt,name=rt_score,proto3,oneof" json:"rt_score,omitempty"`
+	Metascore *int64 `protobuf:"varint,39,opt,name=metascore,proto3,oneof" json:"metascore,omitempty"`
+	// ISO 639-1 codes: spoken languages, and languages TMDB has a
+	// translation in.
+	AudioLanguages []string `protobuf:"bytes,40,rep,name=audio_languages,proto3" json:"audio_languages,omitempty"`
+	Translations   []string `protobuf:"bytes,41,rep,name=translations,proto3" json:"translations,omitempty"`
+	// Who chose the show ("bf" or "gf"), recorded once it is watched.
+	PickedBy *string `protobuf:"bytes,42,opt,name=picked_by,proto3,oneof" json:"picked_by,omitempty"`
+	// Who vetoed the show ("bf" or "gf"); vetoed shows are left out of the
+	// random picker.
+	VetoedBy      *string `protobuf:"bytes,43,opt,name=vetoed_by,proto3,oneof" json:"vetoed_by,omitempty"`
+	VetoedAt      *string `protobuf:"bytes,44,opt,name=vetoed_at,proto3,oneof" json:"vetoed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Show) Reset() {
+	*x = Show{}
+	mi := &file_paired_ratings_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Show) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Show) ProtoMessage() {}
+
+func (x *Show) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Show.ProtoReflect.Descriptor instead.
+func (*Show) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Show) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Show) GetTmdbId() int64 {
+	if x != nil {
+		return x.TmdbId
+	}
+	return 0
+}
+
+func (x *Show) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *Show) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Show) GetYear() int64 {
+	if x != nil && x.Year != nil {
+		return *x.Year
+	}
+	return 0
+}
+
+func (x *Show) GetGenres() string {
+	if x != nil && x.Genres != nil {
+		return *x.Genres
+	}
+	return ""
+}
+
+func (x *Show) GetOverview() string {
+	if x != nil && x.Overview != nil {
+		return *x.Overview
+	}
+	return ""
+}
+
+func (x *Show) GetPosterPath() string {
+	if x != nil && x.PosterPath != nil {
+		return *x.PosterPath
+	}
+	return ""
+}
+
+func (x *Show) GetImdbId() string {
+	if x != nil && x.ImdbId != nil {
+		return *x.ImdbId
+	}
+	return ""
+}
+
+func (x *Show) GetTmdbRating() float64 {
+	if x != nil && x.TmdbRating != nil {
+		return *x.TmdbRating
+	}
+	return 0
+}
+
+func (x *Show) GetTmdbVotes() int64 {
+	if x != nil && x.TmdbVotes != nil {
+		return *x.TmdbVotes
+	}
+	return 0
+}
+
+func (x *Show) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Show) GetBfRating() int64 {
+	if x != nil && x.BfRating != nil {
+		return *x.BfRating
+	}
+	return 0
+}
+
+func (x *Show) GetGfRating() int64 {
+	if x != nil && x.GfRating != nil {
+		return *x.GfRating
+	}
+	return 0
+}
+
+func (x *Show) GetBfComment() string {
+	if x != nil && x.BfComment != nil {
+		return *x.BfComment
+	}
+	return ""
+}
+
+func (x *Show) GetGfComment() string {
+	if x != nil && x.GfComment != nil {
+		return *x.GfComment
+	}
+	return ""
+}
+
+func (x *Show) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Show) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Show) GetOriginCountry() []string {
+	if x != nil {
+		return x.OriginCountry
+	}
+	return nil
+}
+
+func (x *Show) GetTvdbId() int64 {
+	if x != nil && x.TvdbId != nil {
+		return *x.TvdbId
+	}
+	return 0
+}
+
+func (x *Show) GetWikidataId() string {
+	if x != nil && x.WikidataId != nil {
+		return *x.WikidataId
+	}
+	return ""
+}
+
+func (x *Show) GetRuntime() int64 {
+	if x != nil && x.Runtime != nil {
+		return *x.Runtime
+	}
+	return 0
+}
+
+func (x *Show) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Show) GetReleaseDate() string {
+	if x != nil && x.ReleaseDate != nil {
+		return *x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *Show) GetNextAirDate() string {
+	if x != nil && x.NextAirDate != nil {
+		return *x.NextAirDate
+	}
+	return ""
+}
+
+func (x *Show) GetNextEpisodeSeason() int64 {
+	if x != nil && x.NextEpisodeSeason != nil {
+		return *x.NextEpisodeSeason
+	}
+	return 0
+}
+
+func (x *Show) GetNextEpisodeNumber() int64 {
+	if x != nil && x.NextEpisodeNumber != nil {
+		return *x.NextEpisodeNumber
+	}
+	return 0
+}
+
+func (x *Show) GetCoupleScore() float64 {
+	if x != nil && x.CoupleScore != nil {
+		return *x.CoupleScore
+	}
+	return 0
+}
+
+func (x *Show) GetRatingDelta() int64 {
+	if x != nil && x.RatingDelta != nil {
+		return *x.RatingDelta
+	}
+	return 0
+}
+
+func (x *Show) GetAgreement() string {
+	if x != nil {
+		return x.Agreement
+	}
+	return ""
+}
+
+func (x *Show) GetWatchedAt() string {
+	if x != nil && x.WatchedAt != nil {
+		return *x.WatchedAt
+	}
+	return ""
+}
+
+func (x *Show) GetAvailableOn() []string {
+	if x != nil {
+		return x.AvailableOn
+	}
+	return nil
+}
+
+func (x *Show) GetRequestStatus() string {
+	if x != nil && x.RequestStatus != nil {
+		return *x.RequestStatus
+	}
+	return ""
+}
+
+func (x *Show) GetRequestedAt() string {
+	if x != nil && x.RequestedAt != nil {
+		return *x.RequestedAt
+	}
+	return ""
+}
+
+func (x *Show) GetCompanies() []string {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+func (x *Show) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *Show) GetEpisodeCount() int64 {
+	if x != nil && x.EpisodeCount != nil {
+		return *x.EpisodeCount
+	}
+	return 0
+}
+
+func (x *Show) GetRtScore() int64 {
+	if x != nil && x.RtScore != nil {
+		return *x.RtScore
+	}
+	return 0
+}
+
+func (x *Show) GetMetascore() int64 {
+	if x != nil && x.Metascore != nil {
+		return *x.Metascore
+	}
+	return 0
+}
+
+func (x *Show) GetAudioLanguages() []string {
+	if x != nil {
+		return x.AudioLanguages
+	}
+	return nil
+}
+
+func (x *Show) GetTranslations() []string {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *Show) GetPickedBy() string {
+	if x != nil && x.PickedBy != nil {
+		return *x.PickedBy
+	}
+	return ""
+}
+
+func (x *Show) GetVetoedBy() string {
+	if x != nil && x.VetoedBy != nil {
+		return *x.VetoedBy
+	}
+	return ""
+}
+
+func (x *Show) GetVetoedAt() string {
+	if x != nil && x.VetoedAt != nil {
+		return *x.VetoedAt
+	}
+	return ""
+}
+
+type ShowDetail struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Show        *Show                  `protobuf:"bytes,1,opt,name=show,proto3" json:"show,omitempty"`
+	ImdbUrl     *string                `protobuf:"bytes,2,opt,name=imdb_url,proto3,oneof" json:"imdb_url,omitempty"`
+	TvdbUrl     *string                `protobuf:"bytes,3,opt,name=tvdb_url,proto3,oneof" json:"tvdb_url,omitempty"`
+	WikidataUrl *string                `protobuf:"bytes,4,opt,name=wikidata_url,proto3,oneof" json:"wikidata_url,omitempty"`
+	// DoesTheDogDie topics matching DDTD_SENSITIVITIES; empty unless
+	// DDTD_API_KEY is set.
+	Warnings []*ContentWarning `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Scores from TMDB and, with OMDB_API_KEY, IMDb, Rotten Tomatoes and
+	// Metacritic; filled in on TMDB refreshes.
+	ExternalRatings []*ExternalRating `protobuf:"bytes,6,rep,name=external_ratings,proto3" json:"external_ratings,omitempty"`
+	// First paragraph of the Wikipedia article, found through the Wikidata
+	// ID; only with WIKIPEDIA_SUMMARIES=true.
+	WikipediaSummary *string `protobuf:"bytes,7,opt,name=wikipedia_summary,proto3,oneof" json:"wikipedia_summary,omitempty"`
+	WikipediaUrl     *string `protobuf:"bytes,8,opt,name=wikipedia_url,proto3,oneof" json:"wikipedia_url,omitempty"`
+	// People ("bf"/"gf") without a rating, set when the show was just marked
+	// watched under the "warn" watched-ratings rule.
+	MissingRatings []string `protobuf:"bytes,9,rep,name=missing_ratings,proto3" json:"missing_ratings,omitempty"`
+	// Flat-rate providers the show streams on in watch_region; both empty
+	// without WATCH_REGION or a subscriptions region.
+	WatchProviders []*WatchProvider `protobuf:"bytes,10,rep,name=watch_providers,proto3" json:"watch_providers,omitempty"`
+	WatchRegion    string           `protobuf:"bytes,11,opt,name=watch_region,proto3" json:"watch_region,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShowDetail) Reset() {
+	*x = ShowDetail{}
+	mi := &file_paired_ratings_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowDetail) ProtoMessage() {}
+
+func (x *ShowDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowDetail.ProtoReflect.Descriptor instead.
+func (*ShowDetail) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShowDetail) GetShow() *Show {
+	if x != nil {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *ShowDetail) GetImdbUrl() string {
+	if x != nil && x.ImdbUrl != nil {
+		return *x.ImdbUrl
+	}
+	return ""
+}
+
+func (x *ShowDetail) GetTvdbUrl() string {
+	if x != nil && x.TvdbUrl != nil {
+		return *x.TvdbUrl
+	}
+	return ""
+}
+
+func (x *ShowDetail) GetWikidataUrl() string {
+	if x != nil && x.WikidataUrl != nil {
+		return *x.WikidataUrl
+	}
+	return ""
+}
+
+func (x *ShowDetail) GetWarnings() []*ContentWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ShowDetail) GetExternalRatings() []*ExternalRating {
+	if x != nil {
+		return x.ExternalRatings
+	}
+	return nil
+}
+
+func (x *ShowDetail) GetWikipediaSummary() string {
+	if x != nil && x.WikipediaSummary != nil {
+		return *x.WikipediaSummary
+	}
+	return ""
+}
+
+func (x *ShowDetail) GetWikipediaUrl() string {
+	if x != nil && x.WikipediaUrl != nil {
+		return *x.WikipediaUrl
+	}
+	return ""
+}
+
+func (x *ShowDetail) GetMissingRatings() []string {
+	if x != nil {
+		return x.MissingRatings
+	}
+	return nil
+}
+
+func (x *ShowDetail) GetWatchProviders() []*WatchProvider {
+	if x != nil {
+		return x.WatchProviders
+	}
+	return nil
+}
+
+func (x *ShowDetail) GetWatchRegion() string {
+	if x != nil {
+		return x.WatchRegion
+	}
+	return ""
+}
+
+type ExternalRating struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "tmdb", "imdb", "rotten_tomatoes" or "metacritic".
+	Source string  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Value  float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Top of the scale: 10 for TMDB/IMDb, 100 for the others.
+	Max   float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Votes int64   `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty"`
+	// RFC 3339 time of the provider's last successful fetch.
+	FetchedAt     string `protobuf:"bytes,5,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalRating) Reset() {
+	*x = ExternalRating{}
+	mi := &file_paired_ratings_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalRating) ProtoMessage() {}
+
+func (x *ExternalRating) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalRating.ProtoReflect.Descriptor instead.
+func (*ExternalRating) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExternalRating) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExternalRating) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ExternalRating) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ExternalRating) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *ExternalRating) GetFetchedAt() string {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return ""
+}
+
+type ContentWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	YesVotes      int32                  `protobuf:"varint,2,opt,name=yes_votes,proto3" json:"yes_votes,omitempty"`
+	NoVotes       int32                  `protobuf:"varint,3,opt,name=no_votes,proto3" json:"no_votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentWarning) Reset() {
+	*x = ContentWarning{}
+	mi := &file_paired_ratings_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentWarning) ProtoMessage() {}
+
+func (x *ContentWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentWarning.ProtoReflect.Descriptor instead.
+func (*ContentWarning) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ContentWarning) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ContentWarning) GetYesVotes() int32 {
+	if x != nil {
+		return x.YesVotes
+	}
+	return 0
+}
+
+func (x *ContentWarning) GetNoVotes() int32 {
+	if x != nil {
+		return x.NoVotes
+	}
+	return 0
+}
+
+type ListResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Shows     []*Show                `protobuf:"bytes,1,rep,name=shows,proto3" json:"shows,omitempty"`
+	Genres    []string               `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
+	Countries []string               `protobuf:"bytes,3,rep,name=countries,proto3" json:"countries,omitempty"`
+	// Decade buckets present in the library ("pre-1970", "1980s", ...), for
+	// the decade filter.
+	Decades []string `protobuf:"bytes,4,rep,name=decades,proto3" json:"decades,omitempty"`
+	// Production companies of library shows, for the company filter.
+	Companies []*Company `protobuf:"bytes,5,rep,name=companies,proto3" json:"companies,omitempty"`
+	// TV networks of library shows, for the network filter.
+	Networks      []*Network `protobuf:"bytes,6,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListResponse) GetShows() []*Show {
+	if x != nil {
+		return x.Shows
+	}
+	return nil
+}
+
+func (x *ListResponse) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *ListResponse) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *ListResponse) GetDecades() []string {
+	if x != nil {
+		return x.Decades
+	}
+	return nil
+}
+
+func (x *ListResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+func (x *ListResponse) GetNetworks() []*Network {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type Network struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	mi := &file_paired_ratings_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Network) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Network) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Company struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_paired_ratings_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Company) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Library criteria for a smart list; mirrors the /api/shows filters.
+type ListCriteria struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Genre         string                 `protobuf:"bytes,2,opt,name=genre,proto3" json:"genre,omitempty"`
+	OriginCountry string                 `protobuf:"bytes,3,opt,name=origin_country,proto3" json:"origin_country,omitempty"`
+	YearFrom      *int32                 `protobuf:"varint,4,opt,name=year_from,proto3,oneof" json:"year_from,omitempty"`
+	YearTo        *int32                 `protobuf:"varint,5,opt,name=year_to,proto3,oneof" json:"year_to,omitempty"`
+	Unrated       bool                   `protobuf:"varint,6,opt,name=unrated,proto3" json:"unrated,omitempty"`
+	MaxRuntime    *int32                 `protobuf:"varint,7,opt,name=max_runtime,proto3,oneof" json:"max_runtime,omitempty"`
+	Sort          string                 `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCriteria) Reset() {
+	*x = ListCriteria{}
+	mi := &file_paired_ratings_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCriteria) ProtoMessage() {}
+
+func (x *ListCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCriteria.ProtoReflect.Descriptor instead.
+func (*ListCriteria) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCriteria) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListCriteria) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *ListCriteria) GetOriginCountry() string {
+	if x != nil {
+		return x.OriginCountry
+	}
+	return ""
+}
+
+func (x *ListCriteria) GetYearFrom() int32 {
+	if x != nil && x.YearFrom != nil {
+		return *x.YearFrom
+	}
+	return 0
+}
+
+func (x *ListCriteria) GetYearTo() int32 {
+	if x != nil && x.YearTo != nil {
+		return *x.YearTo
+	}
+	return 0
+}
+
+func (x *ListCriteria) GetUnrated() bool {
+	if x != nil {
+		return x.Unrated
+	}
+	return false
+}
+
+func (x *ListCriteria) GetMaxRuntime() int32 {
+	if x != nil && x.MaxRuntime != nil {
+		return *x.MaxRuntime
+	}
+	return 0
+}
+
+func (x *ListCriteria) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type SavedList struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// "smart" lists materialize from criteria on read.
+	Kind          string        `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Criteria      *ListCriteria `protobuf:"bytes,4,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	ShowCount     int32         `protobuf:"varint,5,opt,name=show_count,proto3" json:"show_count,omitempty"`
+	CreatedAt     string        `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string        `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedList) Reset() {
+	*x = SavedList{}
+	mi := &file_paired_ratings_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedList) ProtoMessage() {}
+
+func (x *SavedList) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedList.ProtoReflect.Descriptor instead.
+func (*SavedList) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SavedList) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedList) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SavedList) GetCriteria() *ListCriteria {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *SavedList) GetShowCount() int32 {
+	if x != nil {
+		return x.ShowCount
+	}
+	return 0
+}
+
+func (x *SavedList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SavedList) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SavedListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*SavedList           `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedListsResponse) Reset() {
+	*x = SavedListsResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedListsResponse) ProtoMessage() {}
+
+func (x *SavedListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedListsResponse.ProtoReflect.Descriptor instead.
+func (*SavedListsResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SavedListsResponse) GetLists() []*SavedList {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type SavedListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Criteria      *ListCriteria          `protobuf:"bytes,2,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedListRequest) Reset() {
+	*x = SavedListRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedListRequest) ProtoMessage() {}
+
+func (x *SavedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedListRequest.ProtoReflect.Descriptor instead.
+func (*SavedListRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SavedListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedListRequest) GetCriteria() *ListCriteria {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type SavedListDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *SavedList             `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Shows         []*Show                `protobuf:"bytes,2,rep,name=shows,proto3" json:"shows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedListDetail) Reset() {
+	*x = SavedListDetail{}
+	mi := &file_paired_ratings_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedListDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedListDetail) ProtoMessage() {}
+
+func (x *SavedListDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedListDetail.ProtoReflect.Descriptor instead.
+func (*SavedListDetail) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SavedListDetail) GetList() *SavedList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *SavedListDetail) GetShows() []*Show {
+	if x != nil {
+		return x.Shows
+	}
+	return nil
+}
+
+// Plan is a movie night: a show scheduled for a time that both partners
+// confirm.
+type Plan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Show  *Show                  `protobuf:"bytes,2,opt,name=show,proto3" json:"show,omitempty"`
+	// RFC 3339.
+	ScheduledAt string  `protobuf:"bytes,3,opt,name=scheduled_at,proto3" json:"scheduled_at,omitempty"`
+	Note        *string `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	BfConfirmed bool    `protobuf:"varint,5,opt,name=bf_confirmed,proto3" json:"bf_confirmed,omitempty"`
+	GfConfirmed bool    `protobuf:"varint,6,opt,name=gf_confirmed,proto3" json:"gf_confirmed,omitempty"`
+	// Both partners confirmed.
+	Confirmed     bool    `protobuf:"varint,7,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	CompletedAt   *string `protobuf:"bytes,8,opt,name=completed_at,proto3,oneof" json:"completed_at,omitempty"`
+	CreatedAt     string  `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_paired_ratings_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Plan) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Plan) GetShow() *Show {
+	if x != nil {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *Plan) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *Plan) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *Plan) GetBfConfirmed() bool {
+	if x != nil {
+		return x.BfConfirmed
+	}
+	return false
+}
+
+func (x *Plan) GetGfConfirmed() bool {
+	if x != nil {
+		return x.GfConfirmed
+	}
+	return false
+}
+
+func (x *Plan) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *Plan) GetCompletedAt() string {
+	if x != nil && x.CompletedAt != nil {
+		return *x.CompletedAt
+	}
+	return ""
+}
+
+func (x *Plan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*Plan                `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlansResponse) Reset() {
+	*x = PlansResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlansResponse) ProtoMessage() {}
+
+func (x *PlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlansResponse.ProtoReflect.Descriptor instead.
+func (*PlansResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PlansResponse) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// PlanRequest creates a plan or, on PATCH, changes the set fields.
+type PlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required on create, ignored on PATCH.
+	ShowId int64 `protobuf:"varint,1,opt,name=show_id,proto3" json:"show_id,omitempty"`
+	// RFC 3339; required on create.
+	ScheduledAt *string `protobuf:"bytes,2,opt,name=scheduled_at,proto3,oneof" json:"scheduled_at,omitempty"`
+	// An empty note clears it.
+	Note          *string `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanRequest) Reset() {
+	*x = PlanRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanRequest) ProtoMessage() {}
+
+func (x *PlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanRequest.ProtoReflect.Descriptor instead.
+func (*PlanRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlanRequest) GetShowId() int64 {
+	if x != nil {
+		return x.ShowId
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetScheduledAt() string {
+	if x != nil && x.ScheduledAt != nil {
+		return *x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type RSVPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "bf" or "gf"; defaults to the session's person.
+	Person        string `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Going         bool   `protobuf:"varint,2,opt,name=going,proto3" json:"going,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RSVPRequest) Reset() {
+	*x = RSVPRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RSVPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RSVPRequest) ProtoMessage() {}
+
+func (x *RSVPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RSVPRequest.ProtoReflect.Descriptor instead.
+func (*RSVPRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RSVPRequest) GetPerson() string {
+	if x != nil {
+		return x.Person
+	}
+	return ""
+}
+
+func (x *RSVPRequest) GetGoing() bool {
+	if x != nil {
+		return x.Going
+	}
+	return false
+}
+
+type CalendarEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// "release" for planned titles coming out, "episode" for TV episodes
+	// airing, "plan" for a scheduled movie night.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Show *Show  `protobuf:"bytes,3,opt,name=show,proto3" json:"show,omitempty"`
+	// Set for "plan" entries.
+	PlanId        *int64 `protobuf:"varint,4,opt,name=plan_id,proto3,oneof" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarEntry) Reset() {
+	*x = CalendarEntry{}
+	mi := &file_paired_ratings_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEntry) ProtoMessage() {}
+
+func (x *CalendarEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEntry.ProtoReflect.Descriptor instead.
+func (*CalendarEntry) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CalendarEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetShow() *Show {
+	if x != nil {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *CalendarEntry) GetPlanId() int64 {
+	if x != nil && x.PlanId != nil {
+		return *x.PlanId
+	}
+	return 0
+}
+
+type CalendarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM.
+	Month         string           `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Entries       []*CalendarEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarResponse) Reset() {
+	*x = CalendarResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarResponse) ProtoMessage() {}
+
+func (x *CalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarResponse.ProtoReflect.Descriptor instead.
+func (*CalendarResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CalendarResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *CalendarResponse) GetEntries() []*CalendarEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type UpcomingItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Show  *Show                  `protobuf:"bytes,1,opt,name=show,proto3" json:"show,omitempty"`
+	// YYYY-MM-DD.
+	ReleaseDate   string `protobuf:"bytes,2,opt,name=release_date,proto3" json:"release_date,omitempty"`
+	DaysUntil     int32  `protobuf:"varint,3,opt,name=days_until,proto3" json:"days_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpcomingItem) Reset() {
+	*x = UpcomingItem{}
+	mi := &file_paired_ratings_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingItem) ProtoMessage() {}
+
+func (x *UpcomingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingItem.ProtoReflect.Descriptor instead.
+func (*UpcomingItem) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpcomingItem) GetShow() *Show {
+	if x != nil {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *UpcomingItem) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *UpcomingItem) GetDaysUntil() int32 {
+	if x != nil {
+		return x.DaysUntil
+	}
+	return 0
+}
+
+type UpcomingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UpcomingItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpcomingResponse) Reset() {
+	*x = UpcomingResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingResponse) ProtoMessage() {}
+
+func (x *UpcomingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingResponse.ProtoReflect.Descriptor instead.
+func (*UpcomingResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpcomingResponse) GetItems() []*UpcomingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TasteBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Genre name, decade ("1990s"), country code, or runtime range ("90-119").
+	Key           string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Average       float64 `protobuf:"fixed64,3,opt,name=average,proto3" json:"average,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TasteBucket) Reset() {
+	*x = TasteBucket{}
+	mi := &file_paired_ratings_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TasteBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasteBucket) ProtoMessage() {}
+
+func (x *TasteBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TasteBucket.ProtoReflect.Descriptor instead.
+func (*TasteBucket) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TasteBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TasteBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TasteBucket) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+type TasteProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        string                 `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	RatedCount    int32                  `protobuf:"varint,2,opt,name=rated_count,proto3" json:"rated_count,omitempty"`
+	Average       float64                `protobuf:"fixed64,3,opt,name=average,proto3" json:"average,omitempty"`
+	Genres        []*TasteBucket         `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
+	Decades       []*TasteBucket         `protobuf:"bytes,5,rep,name=decades,proto3" json:"decades,omitempty"`
+	Countries     []*TasteBucket         `protobuf:"bytes,6,rep,name=countries,proto3" json:"countries,omitempty"`
+	Runtimes      []*TasteBucket         `protobuf:"bytes,7,rep,name=runtimes,proto3" json:"runtimes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TasteProfile) Reset() {
+	*x = TasteProfile{}
+	mi := &file_paired_ratings_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TasteProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasteProfile) ProtoMessage() {}
+
+func (x *TasteProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TasteProfile.ProtoReflect.Descriptor instead.
+func (*TasteProfile) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TasteProfile) GetPerson() string {
+	if x != nil {
+		return x.Person
+	}
+	return ""
+}
+
+func (x *TasteProfile) GetRatedCount() int32 {
+	if x != nil {
+		return x.RatedCount
+	}
+	return 0
+}
+
+func (x *TasteProfile) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *TasteProfile) GetGenres() []*TasteBucket {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *TasteProfile) GetDecades() []*TasteBucket {
+	if x != nil {
+		return x.Decades
+	}
+	return nil
+}
+
+func (x *TasteProfile) GetCountries() []*TasteBucket {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *TasteProfile) GetRuntimes() []*TasteBucket {
+	if x != nil {
+		return x.Runtimes
+	}
+	return nil
+}
+
+type GenreCompatibility struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         string                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	BfAverage     float64                `protobuf:"fixed64,2,opt,name=bf_average,proto3" json:"bf_average,omitempty"`
+	GfAverage     float64                `protobuf:"fixed64,3,opt,name=gf_average,proto3" json:"gf_average,omitempty"`
+	Combined      float64                `protobuf:"fixed64,4,opt,name=combined,proto3" json:"combined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenreCompatibility) Reset() {
+	*x = GenreCompatibility{}
+	mi := &file_paired_ratings_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenreCompatibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenreCompatibility) ProtoMessage() {}
+
+func (x *GenreCompatibility) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenreCompatibility.ProtoReflect.Descriptor instead.
+func (*GenreCompatibility) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GenreCompatibility) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *GenreCompatibility) GetBfAverage() float64 {
+	if x != nil {
+		return x.BfAverage
+	}
+	return 0
+}
+
+func (x *GenreCompatibility) GetGfAverage() float64 {
+	if x != nil {
+		return x.GfAverage
+	}
+	return 0
+}
+
+func (x *GenreCompatibility) GetCombined() float64 {
+	if x != nil {
+		return x.Combined
+	}
+	return 0
+}
+
+type FairnessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Watched shows each person picked.
+	BfPicks int32 `protobuf:"varint,1,opt,name=bf_picks,proto3" json:"bf_picks,omitempty"`
+	GfPicks int32 `protobuf:"varint,2,opt,name=gf_picks,proto3" json:"gf_picks,omitempty"`
+	// Watched shows with no recorded picker.
+	Unattributed int32 `protobuf:"varint,3,opt,name=unattributed,proto3" json:"unattributed,omitempty"`
+	// Whose turn it is: whoever picked less, or on a tie who did not pick last.
+	Turn          string `protobuf:"bytes,4,opt,name=turn,proto3" json:"turn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FairnessResponse) Reset() {
+	*x = FairnessResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FairnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FairnessResponse) ProtoMessage() {}
+
+func (x *FairnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FairnessResponse.ProtoReflect.Descriptor instead.
+func (*FairnessResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FairnessResponse) GetBfPicks() int32 {
+	if x != nil {
+		return x.BfPicks
+	}
+	return 0
+}
+
+func (x *FairnessResponse) GetGfPicks() int32 {
+	if x != nil {
+		return x.GfPicks
+	}
+	return 0
+}
+
+func (x *FairnessResponse) GetUnattributed() int32 {
+	if x != nil {
+		return x.Unattributed
+	}
+	return 0
+}
+
+func (x *FairnessResponse) GetTurn() string {
+	if x != nil {
+		return x.Turn
+	}
+	return ""
+}
+
+type VetoStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Active vetoes each person may hold (VETO_LIMIT).
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Vetoed shows still on the planned list.
+	BfActive    int32 `protobuf:"varint,2,opt,name=bf_active,proto3" json:"bf_active,omitempty"`
+	GfActive    int32 `protobuf:"varint,3,opt,name=gf_active,proto3" json:"gf_active,omitempty"`
+	BfRemaining int32 `protobuf:"varint,4,opt,name=bf_remaining,proto3" json:"bf_remaining,omitempty"`
+	GfRemaining int32 `protobuf:"varint,5,opt,name=gf_remaining,proto3" json:"gf_remaining,omitempty"`
+	// Vetoed shows that were watched anyway.
+	Overruled     int32 `protobuf:"varint,6,opt,name=overruled,proto3" json:"overruled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VetoStats) Reset() {
+	*x = VetoStats{}
+	mi := &file_paired_ratings_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VetoStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VetoStats) ProtoMessage() {}
+
+func (x *VetoStats) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VetoStats.ProtoReflect.Descriptor instead.
+func (*VetoStats) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VetoStats) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *VetoStats) GetBfActive() int32 {
+	if x != nil {
+		return x.BfActive
+	}
+	return 0
+}
+
+func (x *VetoStats) GetGfActive() int32 {
+	if x != nil {
+		return x.GfActive
+	}
+	return 0
+}
+
+func (x *VetoStats) GetBfRemaining() int32 {
+	if x != nil {
+		return x.BfRemaining
+	}
+	return 0
+}
+
+func (x *VetoStats) GetGfRemaining() int32 {
+	if x != nil {
+		return x.GfRemaining
+	}
+	return 0
+}
+
+func (x *VetoStats) GetOverruled() int32 {
+	if x != nil {
+		return x.Overruled
+	}
+	return 0
+}
+
+type WatchEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShowId        int64                  `protobuf:"varint,2,opt,name=show_id,proto3" json:"show_id,omitempty"`
+	WatchedAt     string                 `protobuf:"bytes,3,opt,name=watched_at,proto3" json:"watched_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	mi := &file_paired_ratings_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetShowId() int64 {
+	if x != nil {
+		return x.ShowId
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetWatchedAt() string {
+	if x != nil {
+		return x.WatchedAt
+	}
+	return ""
+}
+
+type WatchesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most recent first.
+	Watches       []*WatchEvent `protobuf:"bytes,1,rep,name=watches,proto3" json:"watches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchesResponse) Reset() {
+	*x = WatchesResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchesResponse) ProtoMessage() {}
+
+func (x *WatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchesResponse.ProtoReflect.Descriptor instead.
+func (*WatchesResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchesResponse) GetWatches() []*WatchEvent {
+	if x != nil {
+		return x.Watches
+	}
+	return nil
+}
+
+type LogWatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC 3339 or YYYY-MM-DD; defaults to now.
+	WatchedAt     *string `protobuf:"bytes,1,opt,name=watched_at,proto3,oneof" json:"watched_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogWatchRequest) Reset() {
+	*x = LogWatchRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogWatchRequest) ProtoMessage() {}
+
+func (x *LogWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogWatchRequest.ProtoReflect.Descriptor instead.
+func (*LogWatchRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LogWatchRequest) GetWatchedAt() string {
+	if x != nil && x.WatchedAt != nil {
+		return *x.WatchedAt
+	}
+	return ""
+}
+
+type MonthActivity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM.
+	Month   string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Watches int32  `protobuf:"varint,2,opt,name=watches,proto3" json:"watches,omitempty"`
+	// Distinct shows watched; rewatches within the month count once.
+	Shows         int32 `protobuf:"varint,3,opt,name=shows,proto3" json:"shows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthActivity) Reset() {
+	*x = MonthActivity{}
+	mi := &file_paired_ratings_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthActivity) ProtoMessage() {}
+
+func (x *MonthActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthActivity.ProtoReflect.Descriptor instead.
+func (*MonthActivity) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MonthActivity) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthActivity) GetWatches() int32 {
+	if x != nil {
+		return x.Watches
+	}
+	return 0
+}
+
+func (x *MonthActivity) GetShows() int32 {
+	if x != nil {
+		return x.Shows
+	}
+	return 0
+}
+
+type ActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first; months without watches are left out.
+	Months        []*MonthActivity `protobuf:"bytes,1,rep,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityResponse) Reset() {
+	*x = ActivityResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityResponse) ProtoMessage() {}
+
+func (x *ActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityResponse.ProtoReflect.Descriptor instead.
+func (*ActivityResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ActivityResponse) GetMonths() []*MonthActivity {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+type CompatibilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shows both people rated.
+	SharedCount  int32   `protobuf:"varint,1,opt,name=shared_count,proto3" json:"shared_count,omitempty"`
+	AverageDelta float64 `protobuf:"fixed64,2,opt,name=average_delta,proto3" json:"average_delta,omitempty"`
+	// Share of shared shows rated within one point of each other (0-1).
+	AgreementRate float64 `protobuf:"fixed64,3,opt,name=agreement_rate,proto3" json:"agreement_rate,omitempty"`
+	// 0-100, from the average rating gap on shared shows.
+	RatingScore float64 `protobuf:"fixed64,4,opt,name=rating_score,proto3" json:"rating_score,omitempty"`
+	// 0-100: share of common genres both rate on the same side of their own average.
+	GenreOverlap   float64               `protobuf:"fixed64,5,opt,name=genre_overlap,proto3" json:"genre_overlap,omitempty"`
+	SharedFavorite []*GenreCompatibility `protobuf:"bytes,6,rep,name=shared_favorite,proto3" json:"shared_favorite,omitempty"`
+	AvoidTogether  []*GenreCompatibility `protobuf:"bytes,7,rep,name=avoid_together,proto3" json:"avoid_together,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompatibilityResponse) Reset() {
+	*x = CompatibilityResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityResponse) ProtoMessage() {}
+
+func (x *CompatibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityResponse.ProtoReflect.Descriptor instead.
+func (*CompatibilityResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CompatibilityResponse) GetSharedCount() int32 {
+	if x != nil {
+		return x.SharedCount
+	}
+	return 0
+}
+
+func (x *CompatibilityResponse) GetAverageDelta() float64 {
+	if x != nil {
+		return x.AverageDelta
+	}
+	return 0
+}
+
+func (x *CompatibilityResponse) GetAgreementRate() float64 {
+	if x != nil {
+		return x.AgreementRate
+	}
+	return 0
+}
+
+func (x *CompatibilityResponse) GetRatingScore() float64 {
+	if x != nil {
+		return x.RatingScore
+	}
+	return 0
+}
+
+func (x *CompatibilityResponse) GetGenreOverlap() float64 {
+	if x != nil {
+		return x.GenreOverlap
+	}
+	return 0
+}
+
+func (x *CompatibilityResponse) GetSharedFavorite() []*GenreCompatibility {
+	if x != nil {
+		return x.SharedFavorite
+	}
+	return nil
+}
+
+func (x *CompatibilityResponse) GetAvoidTogether() []*GenreCompatibility {
+	if x != nil {
+		return x.AvoidTogether
+	}
+	return nil
+}
+
+type BacklogTotal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "movie" or "tv"; empty for the overall total.
+	MediaType string `protobuf:"bytes,1,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	Count     int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Minutes   int64  `protobuf:"varint,3,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	// minutes / 60, rounded to one decimal.
+	Hours float64 `protobuf:"fixed64,4,opt,name=hours,proto3" json:"hours,omitempty"`
+	// Planned titles left out of the sum: no runtime (or episode count) yet.
+	UnknownCount  int32 `protobuf:"varint,5,opt,name=unknown_count,proto3" json:"unknown_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BacklogTotal) Reset() {
+	*x = BacklogTotal{}
+	mi := &file_paired_ratings_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BacklogTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacklogTotal) ProtoMessage() {}
+
+func (x *BacklogTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacklogTotal.ProtoReflect.Descriptor instead.
+func (*BacklogTotal) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BacklogTotal) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *BacklogTotal) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BacklogTotal) GetMinutes() int64 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *BacklogTotal) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *BacklogTotal) GetUnknownCount() int32 {
+	if x != nil {
+		return x.UnknownCount
+	}
+	return 0
+}
+
+type BacklogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         *BacklogTotal          `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	MediaTypes    []*BacklogTotal        `protobuf:"bytes,2,rep,name=media_types,proto3" json:"media_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BacklogResponse) Reset() {
+	*x = BacklogResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BacklogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacklogResponse) ProtoMessage() {}
+
+func (x *BacklogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacklogResponse.ProtoReflect.Descriptor instead.
+func (*BacklogResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BacklogResponse) GetTotal() *BacklogTotal {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *BacklogResponse) GetMediaTypes() []*BacklogTotal {
+	if x != nil {
+		return x.MediaTypes
+	}
+	return nil
+}
+
+type TonightResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Random planned shows that fit the time budget.
+	Shows []*Show `protobuf:"bytes,1,rep,name=shows,proto3" json:"shows,omitempty"`
+	// Whose turn it is to pick ("bf" or "gf"), from the pick counts.
+	Turn          string `protobuf:"bytes,2,opt,name=turn,proto3" json:"turn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TonightResponse) Reset() {
+	*x = TonightResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TonightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TonightResponse) ProtoMessage() {}
+
+func (x *TonightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TonightResponse.ProtoReflect.Descriptor instead.
+func (*TonightResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TonightResponse) GetShows() []*Show {
+	if x != nil {
+		return x.Shows
+	}
+	return nil
+}
+
+func (x *TonightResponse) GetTurn() string {
+	if x != nil {
+		return x.Turn
+	}
+	return ""
+}
+
+type RemindersResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Person string                 `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	// Reminders are paused until then (RFC 3339).
+	SnoozedUntil *string `protobuf:"bytes,2,opt,name=snoozed_until,proto3,oneof" json:"snoozed_until,omitempty"`
+	// Shows count once they were watched this many days ago.
+	AfterDays int32 `protobuf:"varint,3,opt,name=after_days,proto3" json:"after_days,omitempty"`
+	// Watched shows the person has not rated, oldest first.
+	Shows         []*Show `protobuf:"bytes,4,rep,name=shows,proto3" json:"shows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindersResponse) Reset() {
+	*x = RemindersResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindersResponse) ProtoMessage() {}
+
+func (x *RemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindersResponse.ProtoReflect.Descriptor instead.
+func (*RemindersResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemindersResponse) GetPerson() string {
+	if x != nil {
+		return x.Person
+	}
+	return ""
+}
+
+func (x *RemindersResponse) GetSnoozedUntil() string {
+	if x != nil && x.SnoozedUntil != nil {
+		return *x.SnoozedUntil
+	}
+	return ""
+}
+
+func (x *RemindersResponse) GetAfterDays() int32 {
+	if x != nil {
+		return x.AfterDays
+	}
+	return 0
+}
+
+func (x *RemindersResponse) GetShows() []*Show {
+	if x != nil {
+		return x.Shows
+	}
+	return nil
+}
+
+type SnoozeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Days to pause reminders for; 0 resumes them.
+	Days          int32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeRequest) Reset() {
+	*x = SnoozeRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeRequest) ProtoMessage() {}
+
+func (x *SnoozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SnoozeRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []string               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenresResponse) Reset() {
+	*x = GenresResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenresResponse) ProtoMessage() {}
+
+func (x *GenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenresResponse.ProtoReflect.Descriptor instead.
+func (*GenresResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GenresResponse) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MediaType        string                 `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Year             string                 `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
+	PosterPath       string                 `protobuf:"bytes,5,opt,name=poster_path,proto3" json:"poster_path,omitempty"`
+	Overview         string                 `protobuf:"bytes,6,opt,name=overview,proto3" json:"overview,omitempty"`
+	VoteAverage      float64                `protobuf:"fixed64,7,opt,name=vote_average,proto3" json:"vote_average,omitempty"`
+	VoteCount        int32                  `protobuf:"varint,8,opt,name=vote_count,proto3" json:"vote_count,omitempty"`
+	InLibrary        bool                   `protobuf:"varint,9,opt,name=in_library,proto3" json:"in_library,omitempty"`
+	Genres           []string               `protobuf:"bytes,10,rep,name=genres,proto3" json:"genres,omitempty"`
+	OriginalLanguage string                 `protobuf:"bytes,12,opt,name=original_language,proto3" json:"original_language,omitempty"`
+	// Flat-rate providers in the response's watch_region; only filled for
+	// searches with with_providers=1.
+	WatchProviders []*WatchProvider `protobuf:"bytes,13,rep,name=watch_providers,proto3" json:"watch_providers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_paired_ratings_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchResult) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *SearchResult) GetPosterPath() string {
+	if x != nil {
+		return x.PosterPath
+	}
+	return ""
+}
+
+func (x *SearchResult) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *SearchResult) GetVoteAverage() float64 {
+	if x != nil {
+		return x.VoteAverage
+	}
+	return 0
+}
+
+func (x *SearchResult) GetVoteCount() int32 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+func (x *SearchResult) GetInLibrary() bool {
+	if x != nil {
+		return x.InLibrary
+	}
+	return false
+}
+
+func (x *SearchResult) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *SearchResult) GetOriginalLanguage() string {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return ""
+}
+
+func (x *SearchResult) GetWatchProviders() []*WatchProvider {
+	if x != nil {
+		return x.WatchProviders
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Q                    string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	MediaType            string                 `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	YearFrom             string                 `protobuf:"bytes,3,opt,name=year_from,proto3" json:"year_from,omitempty"`
+	YearTo               string                 `protobuf:"bytes,4,opt,name=year_to,proto3" json:"year_to,omitempty"`
+	MinRating            string                 `protobuf:"bytes,5,opt,name=min_rating,proto3" json:"min_rating,omitempty"`
+	MinVotes             string                 `protobuf:"bytes,6,opt,name=min_votes,proto3" json:"min_votes,omitempty"`
+	Sort                 string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	Genres               string                 `protobuf:"bytes,8,opt,name=genres,proto3" json:"genres,omitempty"`
+	OriginCountry        string                 `protobuf:"bytes,9,opt,name=origin_country,proto3" json:"origin_country,omitempty"`
+	OriginalLanguage     string                 `protobuf:"bytes,10,opt,name=original_language,proto3" json:"original_language,omitempty"`
+	Page                 int32                  `protobuf:"varint,11,opt,name=page,proto3" json:"page,omitempty"`
+	WatchRegion          string                 `protobuf:"bytes,12,opt,name=watch_region,proto3" json:"watch_region,omitempty"`
+	WatchProviders       string                 `protobuf:"bytes,13,opt,name=watch_providers,proto3" json:"watch_providers,omitempty"`
+	CertificationCountry string                 `protobuf:"bytes,14,opt,name=certification_country,proto3" json:"certification_country,omitempty"`
+	Certification        string                 `protobuf:"bytes,15,opt,name=certification,proto3" json:"certification,omitempty"`
+	ExcludeLibrary       string                 `protobuf:"bytes,16,opt,name=exclude_library,proto3" json:"exclude_library,omitempty"`
+	HideWatched          string                 `protobuf:"bytes,17,opt,name=hide_watched,proto3" json:"hide_watched,omitempty"`
+	// "1" limits discover results to the household's subscriptions.
+	OurServices string `protobuf:"bytes,18,opt,name=our_services,proto3" json:"our_services,omitempty"`
+	// "1980s" or "pre-1970"; expands to year_from/year_to and overrides them.
+	Decade string `protobuf:"bytes,19,opt,name=decade,proto3" json:"decade,omitempty"`
+	// Comma-separated TMDB genre IDs to leave out.
+	GenresExclude string `protobuf:"bytes,20,opt,name=genres_exclude,proto3" json:"genres_exclude,omitempty"`
+	// TMDB production company IDs ("41077|10342" = any); discover only.
+	Companies string `protobuf:"bytes,21,opt,name=companies,proto3" json:"companies,omitempty"`
+	// TMDB TV network IDs ("49|2739" = any); TV discover only.
+	Networks string `protobuf:"bytes,22,opt,name=networks,proto3" json:"networks,omitempty"`
+	// "1" looks up where each result streams (see SearchResult).
+	WithProviders string `protobuf:"bytes,23,opt,name=with_providers,proto3" json:"with_providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetYearFrom() string {
+	if x != nil {
+		return x.YearFrom
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetYearTo() string {
+	if x != nil {
+		return x.YearTo
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMinRating() string {
+	if x != nil {
+		return x.MinRating
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMinVotes() string {
+	if x != nil {
+		return x.MinVotes
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetGenres() string {
+	if x != nil {
+		return x.Genres
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetOriginCountry() string {
+	if x != nil {
+		return x.OriginCountry
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetOriginalLanguage() string {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetWatchRegion() string {
+	if x != nil {
+		return x.WatchRegion
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetWatchProviders() string {
+	if x != nil {
+		return x.WatchProviders
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCertificationCountry() string {
+	if x != nil {
+		return x.CertificationCountry
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCertification() string {
+	if x != nil {
+		return x.Certification
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetExcludeLibrary() string {
+	if x != nil {
+		return x.ExcludeLibrary
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetHideWatched() string {
+	if x != nil {
+		return x.HideWatched
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetOurServices() string {
+	if x != nil {
+		return x.OurServices
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetDecade() string {
+	if x != nil {
+		return x.Decade
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetGenresExclude() string {
+	if x != nil {
+		return x.GenresExclude
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCompanies() string {
+	if x != nil {
+		return x.Companies
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetNetworks() string {
+	if x != nil {
+		return x.Networks
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetWithProviders() string {
+	if x != nil {
+		return x.WithProviders
+	}
+	return ""
+}
+
+type SearchHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SearchedAt    string                 `protobuf:"bytes,3,opt,name=searched_at,proto3" json:"searched_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHistoryEntry) Reset() {
+	*x = SearchHistoryEntry{}
+	mi := &file_paired_ratings_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHistoryEntry) ProtoMessage() {}
+
+func (x *SearchHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHistoryEntry.ProtoReflect.Descriptor instead.
+func (*SearchHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchHistoryEntry) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchHistoryEntry) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SearchHistoryEntry) GetSearchedAt() string {
+	if x != nil {
+		return x.SearchedAt
+	}
+	return ""
+}
+
+type SearchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*SearchHistoryEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHistoryResponse) Reset() {
+	*x = SearchHistoryResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHistoryResponse) ProtoMessage() {}
+
+func (x *SearchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*SearchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SearchHistoryResponse) GetEntries() []*SearchHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// "history" for past searches, "keyword" for TMDB keyword matches.
+	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_paired_ratings_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SuggestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SuggestResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type RecommendationRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "Because you both liked <title>".
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// The library show the row is based on.
+	Source        *Show           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Results       []*SearchResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationRow) Reset() {
+	*x = RecommendationRow{}
+	mi := &file_paired_ratings_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationRow) ProtoMessage() {}
+
+func (x *RecommendationRow) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationRow.ProtoReflect.Descriptor instead.
+func (*RecommendationRow) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RecommendationRow) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RecommendationRow) GetSource() *Show {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *RecommendationRow) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RecommendationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*RecommendationRow   `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationsResponse) Reset() {
+	*x = RecommendationsResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationsResponse) ProtoMessage() {}
+
+func (x *RecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*RecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RecommendationsResponse) GetRows() []*RecommendationRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type SearchResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Results      []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32                  `protobuf:"varint,3,opt,name=total_pages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32                  `protobuf:"varint,4,opt,name=total_results,proto3" json:"total_results,omitempty"`
+	// people are person hits from a combined (media_type=all) text search,
+	// first page only.
+	People []*PersonResult `protobuf:"bytes,5,rep,name=people,proto3" json:"people,omitempty"`
+	// Set when a filtered search stopped reading TMDB pages early, at the
+	// page cap or because TMDB was too slow; results and totals are partial.
+	Truncated bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Whether there is anything past this page.
+	HasMore bool `protobuf:"varint,7,opt,name=has_more,proto3" json:"has_more,omitempty"`
+	// TMDB pages read to answer the request.
+	PagesScanned int32 `protobuf:"varint,8,opt,name=pages_scanned,proto3" json:"pages_scanned,omitempty"`
+	// Region of the results' watch_providers; empty when they weren't looked
+	// up.
+	WatchRegion   string `protobuf:"bytes,9,opt,name=watch_region,proto3" json:"watch_region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetPeople() []*PersonResult {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *SearchResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *SearchResponse) GetPagesScanned() int32 {
+	if x != nil {
+		return x.PagesScanned
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetWatchRegion() string {
+	if x != nil {
+		return x.WatchRegion
+	}
+	return ""
+}
+
+type PersonResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ProfilePath        string                 `protobuf:"bytes,3,opt,name=profile_path,proto3" json:"profile_path,omitempty"`
+	KnownForDepartment string                 `protobuf:"bytes,4,opt,name=known_for_department,proto3" json:"known_for_department,omitempty"`
+	KnownFor           []*SearchResult        `protobuf:"bytes,5,rep,name=known_for,proto3" json:"known_for,omitempty"`
+	TmdbUrl            string                 `protobuf:"bytes,6,opt,name=tmdb_url,proto3" json:"tmdb_url,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PersonResult) Reset() {
+	*x = PersonResult{}
+	mi := &file_paired_ratings_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonResult) ProtoMessage() {}
+
+func (x *PersonResult) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonResult.ProtoReflect.Descriptor instead.
+func (*PersonResult) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PersonResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PersonResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonResult) GetProfilePath() string {
+	if x != nil {
+		return x.ProfilePath
+	}
+	return ""
+}
+
+func (x *PersonResult) GetKnownForDepartment() string {
+	if x != nil {
+		return x.KnownForDepartment
+	}
+	return ""
+}
+
+func (x *PersonResult) GetKnownFor() []*SearchResult {
+	if x != nil {
+		return x.KnownFor
+	}
+	return nil
+}
+
+func (x *PersonResult) GetTmdbUrl() string {
+	if x != nil {
+		return x.TmdbUrl
+	}
+	return ""
+}
+
+type Genre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_paired_ratings_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Genre) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Country struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Country) Reset() {
+	*x = Country{}
+	mi := &file_paired_ratings_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Country) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Country) ProtoMessage() {}
+
+func (x *Country) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Country.ProtoReflect.Descriptor instead.
+func (*Country) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Country) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Country) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Language struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Language) Reset() {
+	*x = Language{}
+	mi := &file_paired_ratings_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Language) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Language) ProtoMessage() {}
+
+func (x *Language) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Language.ProtoReflect.Descriptor instead.
+func (*Language) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Language) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Language) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SearchGenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieGenres   []*Genre               `protobuf:"bytes,1,rep,name=movie_genres,proto3" json:"movie_genres,omitempty"`
+	TvGenres      []*Genre               `protobuf:"bytes,2,rep,name=tv_genres,proto3" json:"tv_genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGenresResponse) Reset() {
+	*x = SearchGenresResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGenresResponse) ProtoMessage() {}
+
+func (x *SearchGenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGenresResponse.ProtoReflect.Descriptor instead.
+func (*SearchGenresResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SearchGenresResponse) GetMovieGenres() []*Genre {
+	if x != nil {
+		return x.MovieGenres
+	}
+	return nil
+}
+
+func (x *SearchGenresResponse) GetTvGenres() []*Genre {
+	if x != nil {
+		return x.TvGenres
+	}
+	return nil
+}
+
+type SearchCountriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Countries     []*Country             `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCountriesResponse) Reset() {
+	*x = SearchCountriesResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCountriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCountriesResponse) ProtoMessage() {}
+
+func (x *SearchCountriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCountriesResponse.ProtoReflect.Descriptor instead.
+func (*SearchCountriesResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchCountriesResponse) GetCountries() []*Country {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+type SearchLanguagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Languages     []*Language            `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLanguagesResponse) Reset() {
+	*x = SearchLanguagesResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLanguagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLanguagesResponse) ProtoMessage() {}
+
+func (x *SearchLanguagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLanguagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchLanguagesResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchLanguagesResponse) GetLanguages() []*Language {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type SearchResolveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImdbUrl       *string                `protobuf:"bytes,1,opt,name=imdb_url,proto3,oneof" json:"imdb_url,omitempty"`
+	TmdbUrl       *string                `protobuf:"bytes,2,opt,name=tmdb_url,proto3,oneof" json:"tmdb_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResolveResponse) Reset() {
+	*x = SearchResolveResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResolveResponse) ProtoMessage() {}
+
+func (x *SearchResolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResolveResponse.ProtoReflect.Descriptor instead.
+func (*SearchResolveResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SearchResolveResponse) GetImdbUrl() string {
+	if x != nil && x.ImdbUrl != nil {
+		return *x.ImdbUrl
+	}
+	return ""
+}
+
+func (x *SearchResolveResponse) GetTmdbUrl() string {
+	if x != nil && x.TmdbUrl != nil {
+		return *x.TmdbUrl
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Person   string                 `protobuf:"bytes,2,opt,name=person,proto3" json:"person,omitempty"`
+	// false signs in until the browser closes; unset or true uses the
+	// configured cookie lifetime.
+	Remember      *bool `protobuf:"varint,3,opt,name=remember,proto3,oneof" json:"remember,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPerson() string {
+	if x != nil {
+		return x.Person
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetRemember() bool {
+	if x != nil && x.Remember != nil {
+		return *x.Remember
+	}
+	return false
+}
+
+type SetPersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        string                 `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPersonRequest) Reset() {
+	*x = SetPersonRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPersonRequest) ProtoMessage() {}
+
+func (x *SetPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPersonRequest.ProtoReflect.Descriptor instead.
+func (*SetPersonRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetPersonRequest) GetPerson() string {
+	if x != nil {
+		return x.Person
+	}
+	return ""
+}
+
+type Preferences struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Person           string                 `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	MetadataLanguage *string                `protobuf:"bytes,2,opt,name=metadata_language,proto3,oneof" json:"metadata_language,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	mi := &file_paired_ratings_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Preferences) GetPerson() string {
+	if x != nil {
+		return x.Person
+	}
+	return ""
+}
+
+func (x *Preferences) GetMetadataLanguage() string {
+	if x != nil && x.MetadataLanguage != nil {
+		return *x.MetadataLanguage
+	}
+	return ""
+}
+
+type PreferencesResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Preferences             []*Preferences         `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	DefaultMetadataLanguage string                 `protobuf:"bytes,2,opt,name=default_metadata_language,proto3" json:"default_metadata_language,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PreferencesResponse) Reset() {
+	*x = PreferencesResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesResponse) ProtoMessage() {}
+
+func (x *PreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesResponse.ProtoReflect.Descriptor instead.
+func (*PreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PreferencesResponse) GetPreferences() []*Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *PreferencesResponse) GetDefaultMetadataLanguage() string {
+	if x != nil {
+		return x.DefaultMetadataLanguage
+	}
+	return ""
+}
+
+type UpdatePreferencesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MetadataLanguage *string                `protobuf:"bytes,1,opt,name=metadata_language,proto3,oneof" json:"metadata_language,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdatePreferencesRequest) GetMetadataLanguage() string {
+	if x != nil && x.MetadataLanguage != nil {
+		return *x.MetadataLanguage
+	}
+	return ""
+}
+
+type AddShowRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TmdbId    int64                  `protobuf:"varint,1,opt,name=tmdb_id,proto3" json:"tmdb_id,omitempty"`
+	MediaType string                 `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	Status    string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// IMDb title ID ("tt0133093") or IMDb URL; used when tmdb_id is not set.
+	ImdbId string `protobuf:"bytes,4,opt,name=imdb_id,proto3" json:"imdb_id,omitempty"`
+	// Full themoviedb.org URL; media type and ID are parsed from the path.
+	TmdbUrl       string `protobuf:"bytes,5,opt,name=tmdb_url,proto3" json:"tmdb_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddShowRequest) Reset() {
+	*x = AddShowRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShowRequest) ProtoMessage() {}
+
+func (x *AddShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShowRequest.ProtoReflect.Descriptor instead.
+func (*AddShowRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddShowRequest) GetTmdbId() int64 {
+	if x != nil {
+		return x.TmdbId
+	}
+	return 0
+}
+
+func (x *AddShowRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *AddShowRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AddShowRequest) GetImdbId() string {
+	if x != nil {
+		return x.ImdbId
+	}
+	return ""
+}
+
+func (x *AddShowRequest) GetTmdbUrl() string {
+	if x != nil {
+		return x.TmdbUrl
+	}
+	return ""
+}
+
+// JSON body of POST /quick-add, required when signing in with the session
+// cookie instead of the API token.
+type QuickAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickAddRequest) Reset() {
+	*x = QuickAddRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddRequest) ProtoMessage() {}
+
+func (x *QuickAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddRequest.ProtoReflect.Descriptor instead.
+func (*QuickAddRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *QuickAddRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QuickAddRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *QuickAddRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type QuickAddResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         bool                   `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Show          *ShowDetail            `protobuf:"bytes,2,opt,name=show,proto3" json:"show,omitempty"`
+	Candidates    []*SearchResult        `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickAddResponse) Reset() {
+	*x = QuickAddResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddResponse) ProtoMessage() {}
+
+func (x *QuickAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddResponse.ProtoReflect.Descriptor instead.
+func (*QuickAddResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *QuickAddResponse) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+func (x *QuickAddResponse) GetShow() *ShowDetail {
+	if x != nil {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *QuickAddResponse) GetCandidates() []*SearchResult {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type RatingsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BfRating  *int32                 `protobuf:"varint,1,opt,name=bf_rating,proto3,oneof" json:"bf_rating,omitempty"`
+	GfRating  *int32                 `protobuf:"varint,2,opt,name=gf_rating,proto3,oneof" json:"gf_rating,omitempty"`
+	BfComment *string                `protobuf:"bytes,3,opt,name=bf_comment,proto3,oneof" json:"bf_comment,omitempty"`
+	GfComment *string                `protobuf:"bytes,4,opt,name=gf_comment,proto3,oneof" json:"gf_comment,omitempty"`
+	// The signed-in person's own rating and comment, written to their
+	// bf_/gf_ fields; needs a person on the session.
+	Rating        *int32  `protobuf:"varint,5,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	Comment       *string `protobuf:"bytes,6,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingsRequest) Reset() {
+	*x = RatingsRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingsRequest) ProtoMessage() {}
+
+func (x *RatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingsRequest.ProtoReflect.Descriptor instead.
+func (*RatingsRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RatingsRequest) GetBfRating() int32 {
+	if x != nil && x.BfRating != nil {
+		return *x.BfRating
+	}
+	return 0
+}
+
+func (x *RatingsRequest) GetGfRating() int32 {
+	if x != nil && x.GfRating != nil {
+		return *x.GfRating
+	}
+	return 0
+}
+
+func (x *RatingsRequest) GetBfComment() string {
+	if x != nil && x.BfComment != nil {
+		return *x.BfComment
+	}
+	return ""
+}
+
+func (x *RatingsRequest) GetGfComment() string {
+	if x != nil && x.GfComment != nil {
+		return *x.GfComment
+	}
+	return ""
+}
+
+func (x *RatingsRequest) GetRating() int32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *RatingsRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// Sparse show update for PATCH /shows/{id}; omitted fields are unchanged.
+type ShowPatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "planned" or "watched".
+	Status *string `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// 1-10, or 0 to clear.
+	BfRating  *int32  `protobuf:"varint,2,opt,name=bf_rating,proto3,oneof" json:"bf_rating,omitempty"`
+	GfRating  *int32  `protobuf:"varint,3,opt,name=gf_rating,proto3,oneof" json:"gf_rating,omitempty"`
+	BfComment *string `protobuf:"bytes,4,opt,name=bf_comment,proto3,oneof" json:"bf_comment,omitempty"`
+	GfComment *string `protobuf:"bytes,5,opt,name=gf_comment,proto3,oneof" json:"gf_comment,omitempty"`
+	// RFC 3339 or YYYY-MM-DD; empty clears.
+	WatchedAt *string `protobuf:"bytes,6,opt,name=watched_at,proto3,oneof" json:"watched_at,omitempty"`
+	Pinned    *bool   `protobuf:"varint,7,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`
+	// "bf" or "gf"; empty clears.
+	PickedBy      *string `protobuf:"bytes,8,opt,name=picked_by,proto3,oneof" json:"picked_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowPatch) Reset() {
+	*x = ShowPatch{}
+	mi := &file_paired_ratings_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowPatch) ProtoMessage() {}
+
+func (x *ShowPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowPatch.ProtoReflect.Descriptor instead.
+func (*ShowPatch) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ShowPatch) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ShowPatch) GetBfRating() int32 {
+	if x != nil && x.BfRating != nil {
+		return *x.BfRating
+	}
+	return 0
+}
+
+func (x *ShowPatch) GetGfRating() int32 {
+	if x != nil && x.GfRating != nil {
+		return *x.GfRating
+	}
+	return 0
+}
+
+func (x *ShowPatch) GetBfComment() string {
+	if x != nil && x.BfComment != nil {
+		return *x.BfComment
+	}
+	return ""
+}
+
+func (x *ShowPatch) GetGfComment() string {
+	if x != nil && x.GfComment != nil {
+		return *x.GfComment
+	}
+	return ""
+}
+
+func (x *ShowPatch) GetWatchedAt() string {
+	if x != nil && x.WatchedAt != nil {
+		return *x.WatchedAt
+	}
+	return ""
+}
+
+func (x *ShowPatch) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *ShowPatch) GetPickedBy() string {
+	if x != nil && x.PickedBy != nil {
+		return *x.PickedBy
+	}
+	return ""
+}
+
+type MediaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Radarr/Sonarr quality profile; the configured default when unset.
+	QualityProfileId *int64 `protobuf:"varint,1,opt,name=quality_profile_id,proto3,oneof" json:"quality_profile_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MediaRequest) Reset() {
+	*x = MediaRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaRequest) ProtoMessage() {}
+
+func (x *MediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaRequest.ProtoReflect.Descriptor instead.
+func (*MediaRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MediaRequest) GetQualityProfileId() int64 {
+	if x != nil && x.QualityProfileId != nil {
+		return *x.QualityProfileId
+	}
+	return 0
+}
+
+type StatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "planned" or "watched".
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UnfreezeRatingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Your password (the shared one, or your own with an account), asked for
+	// again.
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeRatingsRequest) Reset() {
+	*x = UnfreezeRatingsRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeRatingsRequest) ProtoMessage() {}
+
+func (x *UnfreezeRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeRatingsRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UnfreezeRatingsRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type EraseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shared password, asked for again.
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// Must be exactly "erase everything".
+	Confirm       string `protobuf:"bytes,2,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseRequest) Reset() {
+	*x = EraseRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseRequest) ProtoMessage() {}
+
+func (x *EraseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseRequest.ProtoReflect.Descriptor instead.
+func (*EraseRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *EraseRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EraseRequest) GetConfirm() string {
+	if x != nil {
+		return x.Confirm
+	}
+	return ""
+}
+
+type PinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pinned        bool                   `protobuf:"varint,1,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinRequest) Reset() {
+	*x = PinRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinRequest) ProtoMessage() {}
+
+func (x *PinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinRequest.ProtoReflect.Descriptor instead.
+func (*PinRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PinRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type RefreshResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RefreshResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type SyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shows added or changed since the cursor (all shows when since is 0).
+	Shows []*Show `protobuf:"bytes,1,rep,name=shows,proto3" json:"shows,omitempty"`
+	// Shows deleted since the cursor.
+	DeletedIds []int64 `protobuf:"varint,2,rep,packed,name=deleted_ids,proto3" json:"deleted_ids,omitempty"`
+	// Opaque; pass back as since on the next sync.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncResponse) Reset() {
+	*x = SyncResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResponse) ProtoMessage() {}
+
+func (x *SyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SyncResponse) GetShows() []*Show {
+	if x != nil {
+		return x.Shows
+	}
+	return nil
+}
+
+func (x *SyncResponse) GetDeletedIds() []int64 {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+func (x *SyncResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// GET /health answers with status only; GET /admin/health, which needs a
+// login, fills in the rest.
+type HealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "ok", "warming" (startup warm-up still running), "degraded" (integrity
+	// problems), or "unavailable" (no database).
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// When the last integrity check ran; empty before the first one.
+	IntegrityCheckedAt string   `protobuf:"bytes,2,opt,name=integrity_checked_at,proto3" json:"integrity_checked_at,omitempty"`
+	IntegrityProblems  []string `protobuf:"bytes,3,rep,name=integrity_problems,proto3" json:"integrity_problems,omitempty"`
+	// Unset when the metadata provider has no credentials to rotate.
+	Tmdb          *TMDBCredentials `protobuf:"bytes,4,opt,name=tmdb,proto3" json:"tmdb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *HealthResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetIntegrityCheckedAt() string {
+	if x != nil {
+		return x.IntegrityCheckedAt
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetIntegrityProblems() []string {
+	if x != nil {
+		return x.IntegrityProblems
+	}
+	return nil
+}
+
+func (x *HealthResponse) GetTmdb() *TMDBCredentials {
+	if x != nil {
+		return x.Tmdb
+	}
+	return nil
+}
+
+type TMDBCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Configured credentials: TMDB_API_KEY plus TMDB_FALLBACK_KEYS.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Index of the credential in use; 0 is TMDB_API_KEY.
+	Active int32 `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// Consecutive 401/429 responses on the active credential.
+	Failures  int32 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	Rotations int32 `protobuf:"varint,4,opt,name=rotations,proto3" json:"rotations,omitempty"`
+	// When the server last switched credentials; empty if it never did.
+	RotatedAt     string `protobuf:"bytes,5,opt,name=rotated_at,proto3" json:"rotated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TMDBCredentials) Reset() {
+	*x = TMDBCredentials{}
+	mi := &file_paired_ratings_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TMDBCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TMDBCredentials) ProtoMessage() {}
+
+func (x *TMDBCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TMDBCredentials.ProtoReflect.Descriptor instead.
+func (*TMDBCredentials) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TMDBCredentials) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TMDBCredentials) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *TMDBCredentials) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *TMDBCredentials) GetRotations() int32 {
+	if x != nil {
+		return x.Rotations
+	}
+	return 0
+}
+
+func (x *TMDBCredentials) GetRotatedAt() string {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return ""
+}
+
+type OptimizeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Database size in bytes before and after maintenance.
+	SizeBefore    int64 `protobuf:"varint,1,opt,name=size_before,proto3" json:"size_before,omitempty"`
+	SizeAfter     int64 `protobuf:"varint,2,opt,name=size_after,proto3" json:"size_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptimizeResponse) Reset() {
+	*x = OptimizeResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptimizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeResponse) ProtoMessage() {}
+
+func (x *OptimizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *OptimizeResponse) GetSizeBefore() int64 {
+	if x != nil {
+		return x.SizeBefore
+	}
+	return 0
+}
+
+func (x *OptimizeResponse) GetSizeAfter() int64 {
+	if x != nil {
+		return x.SizeAfter
+	}
+	return 0
+}
+
+type LoginFailureIP struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Ip     string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Count  int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	LastAt string                 `protobuf:"bytes,3,opt,name=last_at,proto3" json:"last_at,omitempty"`
+	// Whether sign-ins from the IP are currently refused.
+	Locked        bool `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginFailureIP) Reset() {
+	*x = LoginFailureIP{}
+	mi := &file_paired_ratings_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginFailureIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginFailureIP) ProtoMessage() {}
+
+func (x *LoginFailureIP) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginFailureIP.ProtoReflect.Descriptor instead.
+func (*LoginFailureIP) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *LoginFailureIP) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginFailureIP) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LoginFailureIP) GetLastAt() string {
+	if x != nil {
+		return x.LastAt
+	}
+	return ""
+}
+
+func (x *LoginFailureIP) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+type LoginFailureBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the hour, RFC 3339.
+	Start         string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count         int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginFailureBucket) Reset() {
+	*x = LoginFailureBucket{}
+	mi := &file_paired_ratings_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginFailureBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginFailureBucket) ProtoMessage() {}
+
+func (x *LoginFailureBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginFailureBucket.ProtoReflect.Descriptor instead.
+func (*LoginFailureBucket) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *LoginFailureBucket) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *LoginFailureBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LibraryChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deleted shows keep the ID they had.
+	ShowId    int64  `protobuf:"varint,1,opt,name=show_id,proto3" json:"show_id,omitempty"`
+	TmdbId    int64  `protobuf:"varint,2,opt,name=tmdb_id,proto3" json:"tmdb_id,omitempty"`
+	MediaType string `protobuf:"bytes,3,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	Title     string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// Ratings at the start of the period; unset for added shows.
+	OldBfRating *int64 `protobuf:"varint,5,opt,name=old_bf_rating,proto3,oneof" json:"old_bf_rating,omitempty"`
+	OldGfRating *int64 `protobuf:"varint,6,opt,name=old_gf_rating,proto3,oneof" json:"old_gf_rating,omitempty"`
+	// Ratings at the end of the period; unset for deleted shows.
+	NewBfRating *int64 `protobuf:"varint,7,opt,name=new_bf_rating,proto3,oneof" json:"new_bf_rating,omitempty"`
+	NewGfRating *int64 `protobuf:"varint,8,opt,name=new_gf_rating,proto3,oneof" json:"new_gf_rating,omitempty"`
+	// When the show last changed within the period.
+	ChangedAt string `protobuf:"bytes,9,opt,name=changed_at,proto3" json:"changed_at,omitempty"`
+	// Remaps only: "remapped" or "remap_skipped" when another show already
+	// had the new TMDB entry (tmdb_id), and the entry before (old_tmdb_id).
+	Kind          string `protobuf:"bytes,10,opt,name=kind,proto3" json:"kind,omitempty"`
+	OldTmdbId     *int64 `protobuf:"varint,11,opt,name=old_tmdb_id,proto3,oneof" json:"old_tmdb_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LibraryChange) Reset() {
+	*x = LibraryChange{}
+	mi := &file_paired_ratings_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryChange) ProtoMessage() {}
+
+func (x *LibraryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryChange.ProtoReflect.Descriptor instead.
+func (*LibraryChange) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *LibraryChange) GetShowId() int64 {
+	if x != nil {
+		return x.ShowId
+	}
+	return 0
+}
+
+func (x *LibraryChange) GetTmdbId() int64 {
+	if x != nil {
+		return x.TmdbId
 	}
 	return 0
 }
 
-func (x *Show) GetStatus() string {
+func (x *LibraryChange) GetMediaType() string {
 	if x != nil {
-		return x.Status
+		return x.MediaType
 	}
 	return ""
 }
 
-func (x *Show) GetBfRating() int64 {
-	if x != nil && x.BfRating != nil {
-		return *x.BfRating
+func (x *LibraryChange) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LibraryChange) GetOldBfRating() int64 {
+	if x != nil && x.OldBfRating != nil {
+		return *x.OldBfRating
 	}
 	return 0
 }
 
-func (x *Show) GetGfRating() int64 {
-	if x != nil && x.GfRating != nil {
-		return *x.GfRating
+func (x *LibraryChange) GetOldGfRating() int64 {
+	if x != nil && x.OldGfRating != nil {
+		return *x.OldGfRating
 	}
 	return 0
 }
 
-func (x *Show) GetBfComment() string {
-	if x != nil && x.BfComment != nil {
-		return *x.BfComment
+func (x *LibraryChange) GetNewBfRating() int64 {
+	if x != nil && x.NewBfRating != nil {
+		return *x.NewBfRating
 	}
-	return ""
+	return 0
 }
 
-func (x *Show) GetGfComment() string {
-	if x != nil && x.GfComment != nil {
-		return *x.GfComment
+func (x *LibraryChange) GetNewGfRating() int64 {
+	if x != nil && x.NewGfRating != nil {
+		return *x.NewGfRating
 	}
-	return ""
+	return 0
 }
 
-func (x *Show) GetCreatedAt() string {
+func (x *LibraryChange) GetChangedAt() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ChangedAt
 	}
 	return ""
 }
 
-func (x *Show) GetUpdatedAt() string {
+func (x *LibraryChange) GetKind() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Show) GetOriginCountry() []string {
-	if x != nil {
-		return x.OriginCountry
+func (x *LibraryChange) GetOldTmdbId() int64 {
+	if x != nil && x.OldTmdbId != nil {
+		return *x.OldTmdbId
 	}
-	return nil
+	return 0
 }
 
-type ShowDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Show          *Show                  `protobuf:"bytes,1,opt,name=show,proto3" json:"show,omitempty"`
-	ImdbUrl       *string                `protobuf:"bytes,2,opt,name=imdb_url,proto3,oneof" json:"imdb_url,omitempty"`
+type LibraryDiffResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Since   string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until   string                 `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	Added   []*LibraryChange       `protobuf:"bytes,3,rep,name=added,proto3" json:"added,omitempty"`
+	Deleted []*LibraryChange       `protobuf:"bytes,4,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	// Shows whose ratings differ between the start and end of the period.
+	Rated []*LibraryChange `protobuf:"bytes,5,rep,name=rated,proto3" json:"rated,omitempty"`
+	// TMDB entries shows were re-pointed at after upstream merges.
+	Remapped      []*LibraryChange `protobuf:"bytes,6,rep,name=remapped,proto3" json:"remapped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShowDetail) Reset() {
-	*x = ShowDetail{}
-	mi := &file_paired_ratings_proto_msgTypes[3]
+func (x *LibraryDiffResponse) Reset() {
+	*x = LibraryDiffResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShowDetail) String() string {
+func (x *LibraryDiffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShowDetail) ProtoMessage() {}
+func (*LibraryDiffResponse) ProtoMessage() {}
 
-func (x *ShowDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[3]
+func (x *LibraryDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,107 +5993,85 @@ func (x *ShowDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShowDetail.ProtoReflect.Descriptor instead.
-func (*ShowDetail) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use LibraryDiffResponse.ProtoReflect.Descriptor instead.
+func (*LibraryDiffResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ShowDetail) GetShow() *Show {
+func (x *LibraryDiffResponse) GetSince() string {
 	if x != nil {
-		return x.Show
+		return x.Since
 	}
-	return nil
+	return ""
 }
 
-func (x *ShowDetail) GetImdbUrl() string {
-	if x != nil && x.ImdbUrl != nil {
-		return *x.ImdbUrl
+func (x *LibraryDiffResponse) GetUntil() string {
+	if x != nil {
+		return x.Until
 	}
 	return ""
 }
 
-type ListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shows         []*Show                `protobuf:"bytes,1,rep,name=shows,proto3" json:"shows,omitempty"`
-	Genres        []string               `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
-	Countries     []string               `protobuf:"bytes,3,rep,name=countries,proto3" json:"countries,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListResponse) Reset() {
-	*x = ListResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListResponse) ProtoMessage() {}
-
-func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[4]
+func (x *LibraryDiffResponse) GetAdded() []*LibraryChange {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Added
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
-func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{4}
+	return nil
 }
 
-func (x *ListResponse) GetShows() []*Show {
+func (x *LibraryDiffResponse) GetDeleted() []*LibraryChange {
 	if x != nil {
-		return x.Shows
+		return x.Deleted
 	}
 	return nil
 }
 
-func (x *ListResponse) GetGenres() []string {
+func (x *LibraryDiffResponse) GetRated() []*LibraryChange {
 	if x != nil {
-		return x.Genres
+		return x.Rated
 	}
 	return nil
 }
 
-func (x *ListResponse) GetCountries() []string {
+func (x *LibraryDiffResponse) GetRemapped() []*LibraryChange {
 	if x != nil {
-		return x.Countries
+		return x.Remapped
 	}
 	return nil
 }
 
-type GenresResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Genres        []string               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+type JobStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Interval between runs, e.g. "24h0m0s".
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Runs     int32  `protobuf:"varint,3,opt,name=runs,proto3" json:"runs,omitempty"`
+	Failures int32  `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	// RFC 3339; empty before the first run.
+	LastStartedAt  string `protobuf:"bytes,5,opt,name=last_started_at,proto3" json:"last_started_at,omitempty"`
+	LastFinishedAt string `protobuf:"bytes,6,opt,name=last_finished_at,proto3" json:"last_finished_at,omitempty"`
+	// The latest run's error; empty when it succeeded.
+	LastError     string `protobuf:"bytes,7,opt,name=last_error,proto3" json:"last_error,omitempty"`
+	NextRunAt     string `protobuf:"bytes,8,opt,name=next_run_at,proto3" json:"next_run_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenresResponse) Reset() {
-	*x = GenresResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[5]
+func (x *JobStatus) Reset() {
+	*x = JobStatus{}
+	mi := &file_paired_ratings_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenresResponse) String() string {
+func (x *JobStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenresResponse) ProtoMessage() {}
+func (*JobStatus) ProtoMessage() {}
 
-func (x *GenresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[5]
+func (x *JobStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,174 +6082,136 @@ func (x *GenresResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenresResponse.ProtoReflect.Descriptor instead.
-func (*GenresResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use JobStatus.ProtoReflect.Descriptor instead.
+func (*JobStatus) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GenresResponse) GetGenres() []string {
+func (x *JobStatus) GetName() string {
 	if x != nil {
-		return x.Genres
+		return x.Name
 	}
-	return nil
-}
-
-type SearchResult struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MediaType        string                 `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
-	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Year             string                 `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
-	PosterPath       string                 `protobuf:"bytes,5,opt,name=poster_path,proto3" json:"poster_path,omitempty"`
-	Overview         string                 `protobuf:"bytes,6,opt,name=overview,proto3" json:"overview,omitempty"`
-	VoteAverage      float64                `protobuf:"fixed64,7,opt,name=vote_average,proto3" json:"vote_average,omitempty"`
-	VoteCount        int32                  `protobuf:"varint,8,opt,name=vote_count,proto3" json:"vote_count,omitempty"`
-	InLibrary        bool                   `protobuf:"varint,9,opt,name=in_library,proto3" json:"in_library,omitempty"`
-	Genres           []string               `protobuf:"bytes,10,rep,name=genres,proto3" json:"genres,omitempty"`
-	OriginalLanguage string                 `protobuf:"bytes,12,opt,name=original_language,proto3" json:"original_language,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *SearchResult) Reset() {
-	*x = SearchResult{}
-	mi := &file_paired_ratings_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SearchResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*SearchResult) ProtoMessage() {}
-
-func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[6]
+func (x *JobStatus) GetInterval() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Interval
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
-func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{6}
+	return ""
 }
 
-func (x *SearchResult) GetId() int64 {
+func (x *JobStatus) GetRuns() int32 {
 	if x != nil {
-		return x.Id
+		return x.Runs
 	}
 	return 0
 }
 
-func (x *SearchResult) GetMediaType() string {
+func (x *JobStatus) GetFailures() int32 {
 	if x != nil {
-		return x.MediaType
+		return x.Failures
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchResult) GetTitle() string {
+func (x *JobStatus) GetLastStartedAt() string {
 	if x != nil {
-		return x.Title
+		return x.LastStartedAt
 	}
 	return ""
 }
 
-func (x *SearchResult) GetYear() string {
+func (x *JobStatus) GetLastFinishedAt() string {
 	if x != nil {
-		return x.Year
+		return x.LastFinishedAt
 	}
 	return ""
 }
 
-func (x *SearchResult) GetPosterPath() string {
+func (x *JobStatus) GetLastError() string {
 	if x != nil {
-		return x.PosterPath
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *SearchResult) GetOverview() string {
+func (x *JobStatus) GetNextRunAt() string {
 	if x != nil {
-		return x.Overview
+		return x.NextRunAt
 	}
 	return ""
 }
 
-func (x *SearchResult) GetVoteAverage() float64 {
-	if x != nil {
-		return x.VoteAverage
-	}
-	return 0
+type JobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*JobStatus           `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchResult) GetVoteCount() int32 {
-	if x != nil {
-		return x.VoteCount
-	}
-	return 0
+func (x *JobsResponse) Reset() {
+	*x = JobsResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchResult) GetInLibrary() bool {
-	if x != nil {
-		return x.InLibrary
-	}
-	return false
+func (x *JobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SearchResult) GetGenres() []string {
+func (*JobsResponse) ProtoMessage() {}
+
+func (x *JobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[86]
 	if x != nil {
-		return x.Genres
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SearchResult) GetOriginalLanguage() string {
+// Deprecated: Use JobsResponse.ProtoReflect.Descriptor instead.
+func (*JobsResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *JobsResponse) GetJobs() []*JobStatus {
 	if x != nil {
-		return x.OriginalLanguage
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-type SearchRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Q                string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
-	MediaType        string                 `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
-	YearFrom         string                 `protobuf:"bytes,3,opt,name=year_from,proto3" json:"year_from,omitempty"`
-	YearTo           string                 `protobuf:"bytes,4,opt,name=year_to,proto3" json:"year_to,omitempty"`
-	MinRating        string                 `protobuf:"bytes,5,opt,name=min_rating,proto3" json:"min_rating,omitempty"`
-	MinVotes         string                 `protobuf:"bytes,6,opt,name=min_votes,proto3" json:"min_votes,omitempty"`
-	Sort             string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
-	Genres           string                 `protobuf:"bytes,8,opt,name=genres,proto3" json:"genres,omitempty"`
-	OriginCountry    string                 `protobuf:"bytes,9,opt,name=origin_country,proto3" json:"origin_country,omitempty"`
-	OriginalLanguage string                 `protobuf:"bytes,10,opt,name=original_language,proto3" json:"original_language,omitempty"`
-	Page             int32                  `protobuf:"varint,11,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type SecurityReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	TotalFailures int64                  `protobuf:"varint,2,opt,name=total_failures,proto3" json:"total_failures,omitempty"`
+	Ips           []*LoginFailureIP      `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+	Buckets       []*LoginFailureBucket  `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
-	mi := &file_paired_ratings_proto_msgTypes[7]
+func (x *SecurityReport) Reset() {
+	*x = SecurityReport{}
+	mi := &file_paired_ratings_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchRequest) String() string {
+func (x *SecurityReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*SecurityReport) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[7]
+func (x *SecurityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,113 +6222,125 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SecurityReport.ProtoReflect.Descriptor instead.
+func (*SecurityReport) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SearchRequest) GetQ() string {
+func (x *SecurityReport) GetDays() int32 {
 	if x != nil {
-		return x.Q
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchRequest) GetMediaType() string {
+func (x *SecurityReport) GetTotalFailures() int64 {
 	if x != nil {
-		return x.MediaType
+		return x.TotalFailures
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchRequest) GetYearFrom() string {
+func (x *SecurityReport) GetIps() []*LoginFailureIP {
 	if x != nil {
-		return x.YearFrom
+		return x.Ips
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchRequest) GetYearTo() string {
+func (x *SecurityReport) GetBuckets() []*LoginFailureBucket {
 	if x != nil {
-		return x.YearTo
+		return x.Buckets
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchRequest) GetMinRating() string {
-	if x != nil {
-		return x.MinRating
-	}
-	return ""
+type WebhookResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Matched bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	ShowId  int64                  `protobuf:"varint,2,opt,name=show_id,proto3" json:"show_id,omitempty"`
+	// The title was left planned: the watched-ratings rule is "block" and
+	// someone hasn't rated it.
+	RatingsRequired bool `protobuf:"varint,3,opt,name=ratings_required,proto3" json:"ratings_required,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SearchRequest) GetMinVotes() string {
-	if x != nil {
-		return x.MinVotes
-	}
-	return ""
+func (x *WebhookResponse) Reset() {
+	*x = WebhookResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchRequest) GetSort() string {
+func (x *WebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookResponse) ProtoMessage() {}
+
+func (x *WebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[88]
 	if x != nil {
-		return x.Sort
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SearchRequest) GetGenres() string {
-	if x != nil {
-		return x.Genres
-	}
-	return ""
+// Deprecated: Use WebhookResponse.ProtoReflect.Descriptor instead.
+func (*WebhookResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *SearchRequest) GetOriginCountry() string {
+func (x *WebhookResponse) GetMatched() bool {
 	if x != nil {
-		return x.OriginCountry
+		return x.Matched
 	}
-	return ""
+	return false
 }
 
-func (x *SearchRequest) GetOriginalLanguage() string {
+func (x *WebhookResponse) GetShowId() int64 {
 	if x != nil {
-		return x.OriginalLanguage
+		return x.ShowId
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchRequest) GetPage() int32 {
+func (x *WebhookResponse) GetRatingsRequired() bool {
 	if x != nil {
-		return x.Page
+		return x.RatingsRequired
 	}
-	return 0
+	return false
 }
 
-type SearchResponse struct {
+type WatchProvider struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,proto3" json:"total_pages,omitempty"`
-	TotalResults  int32                  `protobuf:"varint,4,opt,name=total_results,proto3" json:"total_results,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LogoPath      string                 `protobuf:"bytes,3,opt,name=logo_path,proto3" json:"logo_path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchResponse) Reset() {
-	*x = SearchResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[8]
+func (x *WatchProvider) Reset() {
+	*x = WatchProvider{}
+	mi := &file_paired_ratings_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchResponse) String() string {
+func (x *WatchProvider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResponse) ProtoMessage() {}
+func (*WatchProvider) ProtoMessage() {}
 
-func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[8]
+func (x *WatchProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,62 +6351,54 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
-func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *SearchResponse) GetResults() []*SearchResult {
-	if x != nil {
-		return x.Results
-	}
-	return nil
+// Deprecated: Use WatchProvider.ProtoReflect.Descriptor instead.
+func (*WatchProvider) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *SearchResponse) GetPage() int32 {
+func (x *WatchProvider) GetId() int32 {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
 	return 0
 }
 
-func (x *SearchResponse) GetTotalPages() int32 {
+func (x *WatchProvider) GetName() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchResponse) GetTotalResults() int32 {
+func (x *WatchProvider) GetLogoPath() string {
 	if x != nil {
-		return x.TotalResults
+		return x.LogoPath
 	}
-	return 0
+	return ""
 }
 
-type Genre struct {
+type WatchProvidersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Providers     []*WatchProvider       `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Genre) Reset() {
-	*x = Genre{}
-	mi := &file_paired_ratings_proto_msgTypes[9]
+func (x *WatchProvidersResponse) Reset() {
+	*x = WatchProvidersResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Genre) String() string {
+func (x *WatchProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Genre) ProtoMessage() {}
+func (*WatchProvidersResponse) ProtoMessage() {}
 
-func (x *Genre) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[9]
+func (x *WatchProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,48 +6409,42 @@ func (x *Genre) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
-func (*Genre) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Genre) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use WatchProvidersResponse.ProtoReflect.Descriptor instead.
+func (*WatchProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *Genre) GetName() string {
+func (x *WatchProvidersResponse) GetProviders() []*WatchProvider {
 	if x != nil {
-		return x.Name
+		return x.Providers
 	}
-	return ""
+	return nil
 }
 
-type Country struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type Subscriptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 3166-1 region the providers are looked up in.
+	Region        string           `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Providers     []*WatchProvider `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Country) Reset() {
-	*x = Country{}
-	mi := &file_paired_ratings_proto_msgTypes[10]
+func (x *Subscriptions) Reset() {
+	*x = Subscriptions{}
+	mi := &file_paired_ratings_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Country) String() string {
+func (x *Subscriptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Country) ProtoMessage() {}
+func (*Subscriptions) ProtoMessage() {}
 
-func (x *Country) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[10]
+func (x *Subscriptions) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,48 +6455,49 @@ func (x *Country) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Country.ProtoReflect.Descriptor instead.
-func (*Country) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Subscriptions.ProtoReflect.Descriptor instead.
+func (*Subscriptions) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *Country) GetCode() string {
+func (x *Subscriptions) GetRegion() string {
 	if x != nil {
-		return x.Code
+		return x.Region
 	}
 	return ""
 }
 
-func (x *Country) GetName() string {
+func (x *Subscriptions) GetProviders() []*WatchProvider {
 	if x != nil {
-		return x.Name
+		return x.Providers
 	}
-	return ""
+	return nil
 }
 
-type Language struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type UpdateSubscriptionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Region string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// TMDB provider IDs; names are resolved server-side.
+	ProviderIds   []int32 `protobuf:"varint,2,rep,packed,name=provider_ids,proto3" json:"provider_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Language) Reset() {
-	*x = Language{}
-	mi := &file_paired_ratings_proto_msgTypes[11]
+func (x *UpdateSubscriptionsRequest) Reset() {
+	*x = UpdateSubscriptionsRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Language) String() string {
+func (x *UpdateSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Language) ProtoMessage() {}
+func (*UpdateSubscriptionsRequest) ProtoMessage() {}
 
-func (x *Language) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[11]
+func (x *UpdateSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,48 +6508,49 @@ func (x *Language) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Language.ProtoReflect.Descriptor instead.
-func (*Language) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *Language) GetCode() string {
+func (x *UpdateSubscriptionsRequest) GetRegion() string {
 	if x != nil {
-		return x.Code
+		return x.Region
 	}
 	return ""
 }
 
-func (x *Language) GetName() string {
+func (x *UpdateSubscriptionsRequest) GetProviderIds() []int32 {
 	if x != nil {
-		return x.Name
+		return x.ProviderIds
 	}
-	return ""
+	return nil
 }
 
-type SearchGenresResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieGenres   []*Genre               `protobuf:"bytes,1,rep,name=movie_genres,proto3" json:"movie_genres,omitempty"`
-	TvGenres      []*Genre               `protobuf:"bytes,2,rep,name=tv_genres,proto3" json:"tv_genres,omitempty"`
+type WatchedRatingsSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "off", "warn" (mark watched and list missing ratings) or "block"
+	// (refuse until both partners rated).
+	Mode          string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchGenresResponse) Reset() {
-	*x = SearchGenresResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[12]
+func (x *WatchedRatingsSetting) Reset() {
+	*x = WatchedRatingsSetting{}
+	mi := &file_paired_ratings_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchGenresResponse) String() string {
+func (x *WatchedRatingsSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchGenresResponse) ProtoMessage() {}
+func (*WatchedRatingsSetting) ProtoMessage() {}
 
-func (x *SearchGenresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[12]
+func (x *WatchedRatingsSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,47 +6561,42 @@ func (x *SearchGenresResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchGenresResponse.ProtoReflect.Descriptor instead.
-func (*SearchGenresResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *SearchGenresResponse) GetMovieGenres() []*Genre {
-	if x != nil {
-		return x.MovieGenres
-	}
-	return nil
+// Deprecated: Use WatchedRatingsSetting.ProtoReflect.Descriptor instead.
+func (*WatchedRatingsSetting) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *SearchGenresResponse) GetTvGenres() []*Genre {
+func (x *WatchedRatingsSetting) GetMode() string {
 	if x != nil {
-		return x.TvGenres
+		return x.Mode
 	}
-	return nil
+	return ""
 }
 
-type SearchCountriesResponse struct {
+type ExportManifest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Countries     []*Country             `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	SchemaVersion int32                  `protobuf:"varint,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	ShowCount     int32                  `protobuf:"varint,2,opt,name=show_count,proto3" json:"show_count,omitempty"`
+	Sha256        string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchCountriesResponse) Reset() {
-	*x = SearchCountriesResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[13]
+func (x *ExportManifest) Reset() {
+	*x = ExportManifest{}
+	mi := &file_paired_ratings_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchCountriesResponse) String() string {
+func (x *ExportManifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchCountriesResponse) ProtoMessage() {}
+func (*ExportManifest) ProtoMessage() {}
 
-func (x *SearchCountriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[13]
+func (x *ExportManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1033,40 +6607,56 @@ func (x *SearchCountriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchCountriesResponse.ProtoReflect.Descriptor instead.
-func (*SearchCountriesResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ExportManifest.ProtoReflect.Descriptor instead.
+func (*ExportManifest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *SearchCountriesResponse) GetCountries() []*Country {
+func (x *ExportManifest) GetSchemaVersion() int32 {
 	if x != nil {
-		return x.Countries
+		return x.SchemaVersion
 	}
-	return nil
+	return 0
 }
 
-type SearchLanguagesResponse struct {
+func (x *ExportManifest) GetShowCount() int32 {
+	if x != nil {
+		return x.ShowCount
+	}
+	return 0
+}
+
+func (x *ExportManifest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ExportPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Languages     []*Language            `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+	ExportedAt    string                 `protobuf:"bytes,1,opt,name=exported_at,proto3" json:"exported_at,omitempty"`
+	Shows         []*Show                `protobuf:"bytes,2,rep,name=shows,proto3" json:"shows,omitempty"`
+	Manifest      *ExportManifest        `protobuf:"bytes,3,opt,name=manifest,proto3" json:"manifest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchLanguagesResponse) Reset() {
-	*x = SearchLanguagesResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[14]
+func (x *ExportPayload) Reset() {
+	*x = ExportPayload{}
+	mi := &file_paired_ratings_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchLanguagesResponse) String() string {
+func (x *ExportPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchLanguagesResponse) ProtoMessage() {}
+func (*ExportPayload) ProtoMessage() {}
 
-func (x *SearchLanguagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[14]
+func (x *ExportPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,41 +6667,62 @@ func (x *SearchLanguagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchLanguagesResponse.ProtoReflect.Descriptor instead.
-func (*SearchLanguagesResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ExportPayload.ProtoReflect.Descriptor instead.
+func (*ExportPayload) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *SearchLanguagesResponse) GetLanguages() []*Language {
+func (x *ExportPayload) GetExportedAt() string {
 	if x != nil {
-		return x.Languages
+		return x.ExportedAt
+	}
+	return ""
+}
+
+func (x *ExportPayload) GetShows() []*Show {
+	if x != nil {
+		return x.Shows
 	}
 	return nil
 }
 
-type SearchResolveResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImdbUrl       *string                `protobuf:"bytes,1,opt,name=imdb_url,proto3,oneof" json:"imdb_url,omitempty"`
-	TmdbUrl       *string                `protobuf:"bytes,2,opt,name=tmdb_url,proto3,oneof" json:"tmdb_url,omitempty"`
+func (x *ExportPayload) GetManifest() *ExportManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+// A spreadsheet row whose title matched more than one TMDB entry.
+type CSVImportReview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based line in the file, counting the header.
+	Line  int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Year  string `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+	// The best matches.
+	Candidates []*SearchResult `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// Pending import to confirm with POST /api/import/pending/{id}/confirm.
+	Id            int64 `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchResolveResponse) Reset() {
-	*x = SearchResolveResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[15]
+func (x *CSVImportReview) Reset() {
+	*x = CSVImportReview{}
+	mi := &file_paired_ratings_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchResolveResponse) String() string {
+func (x *CSVImportReview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResolveResponse) ProtoMessage() {}
+func (*CSVImportReview) ProtoMessage() {}
 
-func (x *SearchResolveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[15]
+func (x *CSVImportReview) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,47 +6733,73 @@ func (x *SearchResolveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResolveResponse.ProtoReflect.Descriptor instead.
-func (*SearchResolveResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CSVImportReview.ProtoReflect.Descriptor instead.
+func (*CSVImportReview) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *SearchResolveResponse) GetImdbUrl() string {
-	if x != nil && x.ImdbUrl != nil {
-		return *x.ImdbUrl
+func (x *CSVImportReview) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *CSVImportReview) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *SearchResolveResponse) GetTmdbUrl() string {
-	if x != nil && x.TmdbUrl != nil {
-		return *x.TmdbUrl
+func (x *CSVImportReview) GetYear() string {
+	if x != nil {
+		return x.Year
 	}
 	return ""
 }
 
-type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+func (x *CSVImportReview) GetCandidates() []*SearchResult {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *CSVImportReview) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CSVImportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rows added or merged into the library.
+	Imported int32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	// Rows that failed validation or matched nothing; see errors.
+	Skipped       int32              `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Review        []*CSVImportReview `protobuf:"bytes,3,rep,name=review,proto3" json:"review,omitempty"`
+	Errors        []string           `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_paired_ratings_proto_msgTypes[16]
+func (x *CSVImportResponse) Reset() {
+	*x = CSVImportResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *CSVImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*CSVImportResponse) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[16]
+func (x *CSVImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,42 +6810,73 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CSVImportResponse.ProtoReflect.Descriptor instead.
+func (*CSVImportResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *CSVImportResponse) GetImported() int32 {
 	if x != nil {
-		return x.Password
+		return x.Imported
 	}
-	return ""
+	return 0
 }
 
-type AddShowRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TmdbId        int64                  `protobuf:"varint,1,opt,name=tmdb_id,proto3" json:"tmdb_id,omitempty"`
-	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+func (x *CSVImportResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *CSVImportResponse) GetReview() []*CSVImportReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *CSVImportResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type PendingImport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Importer the row came from, e.g. "csv".
+	Source    string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Line      int32  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	Title     string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Year      string `protobuf:"bytes,5,opt,name=year,proto3" json:"year,omitempty"`
+	MediaType string `protobuf:"bytes,6,opt,name=media_type,proto3" json:"media_type,omitempty"`
+	Status    string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	BfRating  *int64 `protobuf:"varint,8,opt,name=bf_rating,proto3,oneof" json:"bf_rating,omitempty"`
+	GfRating  *int64 `protobuf:"varint,9,opt,name=gf_rating,proto3,oneof" json:"gf_rating,omitempty"`
+	// Up to three suggested matches, best first.
+	Candidates    []*SearchResult `protobuf:"bytes,10,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	CreatedAt     string          `protobuf:"bytes,11,opt,name=created_at,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddShowRequest) Reset() {
-	*x = AddShowRequest{}
-	mi := &file_paired_ratings_proto_msgTypes[17]
+func (x *PendingImport) Reset() {
+	*x = PendingImport{}
+	mi := &file_paired_ratings_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddShowRequest) String() string {
+func (x *PendingImport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddShowRequest) ProtoMessage() {}
+func (*PendingImport) ProtoMessage() {}
 
-func (x *AddShowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[17]
+func (x *PendingImport) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,57 +6887,110 @@ func (x *AddShowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddShowRequest.ProtoReflect.Descriptor instead.
-func (*AddShowRequest) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use PendingImport.ProtoReflect.Descriptor instead.
+func (*PendingImport) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *AddShowRequest) GetTmdbId() int64 {
+func (x *PendingImport) GetId() int64 {
 	if x != nil {
-		return x.TmdbId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *AddShowRequest) GetMediaType() string {
+func (x *PendingImport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PendingImport) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *PendingImport) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PendingImport) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *PendingImport) GetMediaType() string {
 	if x != nil {
 		return x.MediaType
 	}
 	return ""
 }
 
-func (x *AddShowRequest) GetStatus() string {
+func (x *PendingImport) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type RatingsRequest struct {
+func (x *PendingImport) GetBfRating() int64 {
+	if x != nil && x.BfRating != nil {
+		return *x.BfRating
+	}
+	return 0
+}
+
+func (x *PendingImport) GetGfRating() int64 {
+	if x != nil && x.GfRating != nil {
+		return *x.GfRating
+	}
+	return 0
+}
+
+func (x *PendingImport) GetCandidates() []*SearchResult {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *PendingImport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PendingImportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BfRating      *int32                 `protobuf:"varint,1,opt,name=bf_rating,proto3,oneof" json:"bf_rating,omitempty"`
-	GfRating      *int32                 `protobuf:"varint,2,opt,name=gf_rating,proto3,oneof" json:"gf_rating,omitempty"`
-	BfComment     *string                `protobuf:"bytes,3,opt,name=bf_comment,proto3,oneof" json:"bf_comment,omitempty"`
-	GfComment     *string                `protobuf:"bytes,4,opt,name=gf_comment,proto3,oneof" json:"gf_comment,omitempty"`
+	Pending       []*PendingImport       `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RatingsRequest) Reset() {
-	*x = RatingsRequest{}
-	mi := &file_paired_ratings_proto_msgTypes[18]
+func (x *PendingImportsResponse) Reset() {
+	*x = PendingImportsResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RatingsRequest) String() string {
+func (x *PendingImportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RatingsRequest) ProtoMessage() {}
+func (*PendingImportsResponse) ProtoMessage() {}
 
-func (x *RatingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[18]
+func (x *PendingImportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,61 +7001,42 @@ func (x *RatingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RatingsRequest.ProtoReflect.Descriptor instead.
-func (*RatingsRequest) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *RatingsRequest) GetBfRating() int32 {
-	if x != nil && x.BfRating != nil {
-		return *x.BfRating
-	}
-	return 0
-}
-
-func (x *RatingsRequest) GetGfRating() int32 {
-	if x != nil && x.GfRating != nil {
-		return *x.GfRating
-	}
-	return 0
-}
-
-func (x *RatingsRequest) GetBfComment() string {
-	if x != nil && x.BfComment != nil {
-		return *x.BfComment
-	}
-	return ""
+// Deprecated: Use PendingImportsResponse.ProtoReflect.Descriptor instead.
+func (*PendingImportsResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *RatingsRequest) GetGfComment() string {
-	if x != nil && x.GfComment != nil {
-		return *x.GfComment
+func (x *PendingImportsResponse) GetPending() []*PendingImport {
+	if x != nil {
+		return x.Pending
 	}
-	return ""
+	return nil
 }
 
-type RefreshResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+type ConfirmImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Usually one of the candidates, but any TMDB entry is accepted.
+	TmdbId        int64  `protobuf:"varint,1,opt,name=tmdb_id,proto3" json:"tmdb_id,omitempty"`
+	MediaType     string `protobuf:"bytes,2,opt,name=media_type,proto3" json:"media_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshResponse) Reset() {
-	*x = RefreshResponse{}
-	mi := &file_paired_ratings_proto_msgTypes[19]
+func (x *ConfirmImportRequest) Reset() {
+	*x = ConfirmImportRequest{}
+	mi := &file_paired_ratings_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshResponse) String() string {
+func (x *ConfirmImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshResponse) ProtoMessage() {}
+func (*ConfirmImportRequest) ProtoMessage() {}
 
-func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[19]
+func (x *ConfirmImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,41 +7047,53 @@ func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
-func (*RefreshResponse) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ConfirmImportRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmImportRequest) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *RefreshResponse) GetUpdated() int32 {
+func (x *ConfirmImportRequest) GetTmdbId() int64 {
 	if x != nil {
-		return x.Updated
+		return x.TmdbId
 	}
 	return 0
 }
 
-type ExportPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExportedAt    string                 `protobuf:"bytes,1,opt,name=exported_at,proto3" json:"exported_at,omitempty"`
-	Shows         []*Show                `protobuf:"bytes,2,rep,name=shows,proto3" json:"shows,omitempty"`
+func (x *ConfirmImportRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+type ImportResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Created int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped int32                  `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// One message per show that failed validation.
+	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Existing shows whose frozen ratings and comments were kept.
+	Frozen        int32 `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportPayload) Reset() {
-	*x = ExportPayload{}
-	mi := &file_paired_ratings_proto_msgTypes[20]
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	mi := &file_paired_ratings_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportPayload) String() string {
+func (x *ImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportPayload) ProtoMessage() {}
+func (*ImportResponse) ProtoMessage() {}
 
-func (x *ExportPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_paired_ratings_proto_msgTypes[20]
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paired_ratings_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,45 +7104,105 @@ func (x *ExportPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportPayload.ProtoReflect.Descriptor instead.
-func (*ExportPayload) Descriptor() ([]byte, []int) {
-	return file_paired_ratings_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_paired_ratings_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ExportPayload) GetExportedAt() string {
+func (x *ImportResponse) GetCreated() int32 {
 	if x != nil {
-		return x.ExportedAt
+		return x.Created
 	}
-	return ""
+	return 0
 }
 
-func (x *ExportPayload) GetShows() []*Show {
+func (x *ImportResponse) GetUpdated() int32 {
 	if x != nil {
-		return x.Shows
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
 	}
 	return nil
 }
 
+func (x *ImportResponse) GetFrozen() int32 {
+	if x != nil {
+		return x.Frozen
+	}
+	return 0
+}
+
 var File_paired_ratings_proto protoreflect.FileDescriptor
 
 const file_paired_ratings_proto_rawDesc = "" +
 	"\n" +
-	"\x14paired_ratings.proto\x12\x10pairedratings.v1\"\xd8\x01\n" +
+	"\x14paired_ratings.proto\x12\x10pairedratings.v1\"\xb8\x02\n" +
 	"\x0fSessionResponse\x12)\n" +
 	"\rauthenticated\x18\x01 \x01(\bH\x00R\rauthenticated\x88\x01\x01\x12#\n" +
 	"\n" +
 	"image_base\x18\x02 \x01(\tH\x01R\n" +
 	"image_base\x88\x01\x01\x12\x1d\n" +
 	"\abf_name\x18\x03 \x01(\tH\x02R\abf_name\x88\x01\x01\x12\x1d\n" +
-	"\agf_name\x18\x04 \x01(\tH\x03R\agf_name\x88\x01\x01B\x10\n" +
+	"\agf_name\x18\x04 \x01(\tH\x03R\agf_name\x88\x01\x01\x12\x1b\n" +
+	"\x06person\x18\x05 \x01(\tH\x04R\x06person\x88\x01\x01\x12\x1c\n" +
+	"\tread_only\x18\x06 \x01(\bR\tread_only\x12\x18\n" +
+	"\aaccount\x18\a \x01(\bR\aaccountB\x10\n" +
 	"\x0e_authenticatedB\r\n" +
 	"\v_image_baseB\n" +
 	"\n" +
 	"\b_bf_nameB\n" +
 	"\n" +
-	"\b_gf_name\"%\n" +
+	"\b_gf_nameB\t\n" +
+	"\a_person\"\xed\x01\n" +
+	"\vSessionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\n" +
+	"user_agent\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x1e\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\n" +
+	"created_at\x12\"\n" +
+	"\flast_used_at\x18\x06 \x01(\tR\flast_used_at\x12\x1e\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\n" +
+	"expires_at\x12\x18\n" +
+	"\acurrent\x18\b \x01(\bR\acurrentB\a\n" +
+	"\x05_name\"M\n" +
+	"\x10SessionsResponse\x129\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1d.pairedratings.v1.SessionInfoR\bsessions\"\"\n" +
+	"\fSessionPatch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"g\n" +
+	"\x15ChangePasswordRequest\x12*\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x10current_password\x12\"\n" +
+	"\fnew_password\x18\x02 \x01(\tR\fnew_password\"E\n" +
 	"\rErrorResponse\x12\x14\n" +
-	"\x05error\x18\x01 \x01(\tR\x05error\"\xf5\x05\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12\x1e\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\n" +
+	"request_id\"\xa6\x01\n" +
+	"\x0eProblemDetails\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12\x1a\n" +
+	"\binstance\x18\x05 \x01(\tR\binstance\x12\x1e\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\n" +
+	"request_id\"\xca\x0f\n" +
 	"\x04Show\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\atmdb_id\x18\x02 \x01(\x03R\atmdb_id\x12\x1e\n" +
@@ -1462,7 +7236,34 @@ const file_paired_ratings_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x12 \x01(\tR\n" +
 	"updated_at\x12&\n" +
-	"\x0eorigin_country\x18\x13 \x03(\tR\x0eorigin_countryB\a\n" +
+	"\x0eorigin_country\x18\x13 \x03(\tR\x0eorigin_country\x12\x1d\n" +
+	"\atvdb_id\x18\x14 \x01(\x03H\vR\atvdb_id\x88\x01\x01\x12%\n" +
+	"\vwikidata_id\x18\x15 \x01(\tH\fR\vwikidata_id\x88\x01\x01\x12\x1d\n" +
+	"\aruntime\x18\x16 \x01(\x03H\rR\aruntime\x88\x01\x01\x12\x16\n" +
+	"\x06pinned\x18\x17 \x01(\bR\x06pinned\x12'\n" +
+	"\frelease_date\x18\x18 \x01(\tH\x0eR\frelease_date\x88\x01\x01\x12)\n" +
+	"\rnext_air_date\x18\x19 \x01(\tH\x0fR\rnext_air_date\x88\x01\x01\x125\n" +
+	"\x13next_episode_season\x18\x1a \x01(\x03H\x10R\x13next_episode_season\x88\x01\x01\x125\n" +
+	"\x13next_episode_number\x18\x1b \x01(\x03H\x11R\x13next_episode_number\x88\x01\x01\x12'\n" +
+	"\fcouple_score\x18\x1c \x01(\x01H\x12R\fcouple_score\x88\x01\x01\x12'\n" +
+	"\frating_delta\x18\x1d \x01(\x03H\x13R\frating_delta\x88\x01\x01\x12\x1c\n" +
+	"\tagreement\x18\x1e \x01(\tR\tagreement\x12#\n" +
+	"\n" +
+	"watched_at\x18\x1f \x01(\tH\x14R\n" +
+	"watched_at\x88\x01\x01\x12\"\n" +
+	"\favailable_on\x18  \x03(\tR\favailable_on\x12+\n" +
+	"\x0erequest_status\x18! \x01(\tH\x15R\x0erequest_status\x88\x01\x01\x12'\n" +
+	"\frequested_at\x18\" \x01(\tH\x16R\frequested_at\x88\x01\x01\x12\x1c\n" +
+	"\tcompanies\x18# \x03(\tR\tcompanies\x12\x1a\n" +
+	"\bnetworks\x18$ \x03(\tR\bnetworks\x12)\n" +
+	"\repisode_count\x18% \x01(\x03H\x17R\repisode_count\x88\x01\x01\x12\x1f\n" +
+	"\brt_score\x18& \x01(\x03H\x18R\brt_score\x88\x01\x01\x12!\n" +
+	"\tmetascore\x18' \x01(\x03H\x19R\tmetascore\x88\x01\x01\x12(\n" +
+	"\x0faudio_languages\x18( \x03(\tR\x0faudio_languages\x12\"\n" +
+	"\ftranslations\x18) \x03(\tR\ftranslations\x12!\n" +
+	"\tpicked_by\x18* \x01(\tH\x1aR\tpicked_by\x88\x01\x01\x12!\n" +
+	"\tvetoed_by\x18+ \x01(\tH\x1bR\tvetoed_by\x88\x01\x01\x12!\n" +
+	"\tvetoed_at\x18, \x01(\tH\x1cR\tvetoed_at\x88\x01\x01B\a\n" +
 	"\x05_yearB\t\n" +
 	"\a_genresB\v\n" +
 	"\t_overviewB\x0e\n" +
@@ -1476,18 +7277,241 @@ const file_paired_ratings_proto_rawDesc = "" +
 	"\n" +
 	"_gf_ratingB\r\n" +
 	"\v_bf_commentB\r\n" +
-	"\v_gf_comment\"f\n" +
+	"\v_gf_commentB\n" +
+	"\n" +
+	"\b_tvdb_idB\x0e\n" +
+	"\f_wikidata_idB\n" +
+	"\n" +
+	"\b_runtimeB\x0f\n" +
+	"\r_release_dateB\x10\n" +
+	"\x0e_next_air_dateB\x16\n" +
+	"\x14_next_episode_seasonB\x16\n" +
+	"\x14_next_episode_numberB\x0f\n" +
+	"\r_couple_scoreB\x0f\n" +
+	"\r_rating_deltaB\r\n" +
+	"\v_watched_atB\x11\n" +
+	"\x0f_request_statusB\x0f\n" +
+	"\r_requested_atB\x10\n" +
+	"\x0e_episode_countB\v\n" +
+	"\t_rt_scoreB\f\n" +
+	"\n" +
+	"_metascoreB\f\n" +
+	"\n" +
+	"_picked_byB\f\n" +
+	"\n" +
+	"_vetoed_byB\f\n" +
+	"\n" +
+	"_vetoed_at\"\xf9\x04\n" +
 	"\n" +
 	"ShowDetail\x12*\n" +
 	"\x04show\x18\x01 \x01(\v2\x16.pairedratings.v1.ShowR\x04show\x12\x1f\n" +
-	"\bimdb_url\x18\x02 \x01(\tH\x00R\bimdb_url\x88\x01\x01B\v\n" +
-	"\t_imdb_url\"r\n" +
+	"\bimdb_url\x18\x02 \x01(\tH\x00R\bimdb_url\x88\x01\x01\x12\x1f\n" +
+	"\btvdb_url\x18\x03 \x01(\tH\x01R\btvdb_url\x88\x01\x01\x12'\n" +
+	"\fwikidata_url\x18\x04 \x01(\tH\x02R\fwikidata_url\x88\x01\x01\x12<\n" +
+	"\bwarnings\x18\x05 \x03(\v2 .pairedratings.v1.ContentWarningR\bwarnings\x12L\n" +
+	"\x10external_ratings\x18\x06 \x03(\v2 .pairedratings.v1.ExternalRatingR\x10external_ratings\x121\n" +
+	"\x11wikipedia_summary\x18\a \x01(\tH\x03R\x11wikipedia_summary\x88\x01\x01\x12)\n" +
+	"\rwikipedia_url\x18\b \x01(\tH\x04R\rwikipedia_url\x88\x01\x01\x12(\n" +
+	"\x0fmissing_ratings\x18\t \x03(\tR\x0fmissing_ratings\x12I\n" +
+	"\x0fwatch_providers\x18\n" +
+	" \x03(\v2\x1f.pairedratings.v1.WatchProviderR\x0fwatch_providers\x12\"\n" +
+	"\fwatch_region\x18\v \x01(\tR\fwatch_regionB\v\n" +
+	"\t_imdb_urlB\v\n" +
+	"\t_tvdb_urlB\x0f\n" +
+	"\r_wikidata_urlB\x14\n" +
+	"\x12_wikipedia_summaryB\x10\n" +
+	"\x0e_wikipedia_url\"\x86\x01\n" +
+	"\x0eExternalRating\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\x12\x14\n" +
+	"\x05votes\x18\x04 \x01(\x03R\x05votes\x12\x1e\n" +
+	"\n" +
+	"fetched_at\x18\x05 \x01(\tR\n" +
+	"fetched_at\"`\n" +
+	"\x0eContentWarning\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1c\n" +
+	"\tyes_votes\x18\x02 \x01(\x05R\tyes_votes\x12\x1a\n" +
+	"\bno_votes\x18\x03 \x01(\x05R\bno_votes\"\xfc\x01\n" +
 	"\fListResponse\x12,\n" +
 	"\x05shows\x18\x01 \x03(\v2\x16.pairedratings.v1.ShowR\x05shows\x12\x16\n" +
 	"\x06genres\x18\x02 \x03(\tR\x06genres\x12\x1c\n" +
-	"\tcountries\x18\x03 \x03(\tR\tcountries\"(\n" +
+	"\tcountries\x18\x03 \x03(\tR\tcountries\x12\x18\n" +
+	"\adecades\x18\x04 \x03(\tR\adecades\x127\n" +
+	"\tcompanies\x18\x05 \x03(\v2\x19.pairedratings.v1.CompanyR\tcompanies\x125\n" +
+	"\bnetworks\x18\x06 \x03(\v2\x19.pairedratings.v1.NetworkR\bnetworks\"-\n" +
+	"\aNetwork\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"-\n" +
+	"\aCompany\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa5\x02\n" +
+	"\fListCriteria\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05genre\x18\x02 \x01(\tR\x05genre\x12&\n" +
+	"\x0eorigin_country\x18\x03 \x01(\tR\x0eorigin_country\x12!\n" +
+	"\tyear_from\x18\x04 \x01(\x05H\x00R\tyear_from\x88\x01\x01\x12\x1d\n" +
+	"\ayear_to\x18\x05 \x01(\x05H\x01R\ayear_to\x88\x01\x01\x12\x18\n" +
+	"\aunrated\x18\x06 \x01(\bR\aunrated\x12%\n" +
+	"\vmax_runtime\x18\a \x01(\x05H\x02R\vmax_runtime\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\b \x01(\tR\x04sortB\f\n" +
+	"\n" +
+	"_year_fromB\n" +
+	"\n" +
+	"\b_year_toB\x0e\n" +
+	"\f_max_runtime\"\xdf\x01\n" +
+	"\tSavedList\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12:\n" +
+	"\bcriteria\x18\x04 \x01(\v2\x1e.pairedratings.v1.ListCriteriaR\bcriteria\x12\x1e\n" +
+	"\n" +
+	"show_count\x18\x05 \x01(\x05R\n" +
+	"show_count\x12\x1e\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\n" +
+	"created_at\x12\x1e\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\n" +
+	"updated_at\"G\n" +
+	"\x12SavedListsResponse\x121\n" +
+	"\x05lists\x18\x01 \x03(\v2\x1b.pairedratings.v1.SavedListR\x05lists\"b\n" +
+	"\x10SavedListRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12:\n" +
+	"\bcriteria\x18\x02 \x01(\v2\x1e.pairedratings.v1.ListCriteriaR\bcriteria\"p\n" +
+	"\x0fSavedListDetail\x12/\n" +
+	"\x04list\x18\x01 \x01(\v2\x1b.pairedratings.v1.SavedListR\x04list\x12,\n" +
+	"\x05shows\x18\x02 \x03(\v2\x16.pairedratings.v1.ShowR\x05shows\"\xc8\x02\n" +
+	"\x04Plan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x04show\x18\x02 \x01(\v2\x16.pairedratings.v1.ShowR\x04show\x12\"\n" +
+	"\fscheduled_at\x18\x03 \x01(\tR\fscheduled_at\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01\x12\"\n" +
+	"\fbf_confirmed\x18\x05 \x01(\bR\fbf_confirmed\x12\"\n" +
+	"\fgf_confirmed\x18\x06 \x01(\bR\fgf_confirmed\x12\x1c\n" +
+	"\tconfirmed\x18\a \x01(\bR\tconfirmed\x12'\n" +
+	"\fcompleted_at\x18\b \x01(\tH\x01R\fcompleted_at\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\n" +
+	"created_atB\a\n" +
+	"\x05_noteB\x0f\n" +
+	"\r_completed_at\"=\n" +
+	"\rPlansResponse\x12,\n" +
+	"\x05plans\x18\x01 \x03(\v2\x16.pairedratings.v1.PlanR\x05plans\"\x83\x01\n" +
+	"\vPlanRequest\x12\x18\n" +
+	"\ashow_id\x18\x01 \x01(\x03R\ashow_id\x12'\n" +
+	"\fscheduled_at\x18\x02 \x01(\tH\x00R\fscheduled_at\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x03 \x01(\tH\x01R\x04note\x88\x01\x01B\x0f\n" +
+	"\r_scheduled_atB\a\n" +
+	"\x05_note\";\n" +
+	"\vRSVPRequest\x12\x16\n" +
+	"\x06person\x18\x01 \x01(\tR\x06person\x12\x14\n" +
+	"\x05going\x18\x02 \x01(\bR\x05going\"\x8e\x01\n" +
+	"\rCalendarEntry\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12*\n" +
+	"\x04show\x18\x03 \x01(\v2\x16.pairedratings.v1.ShowR\x04show\x12\x1d\n" +
+	"\aplan_id\x18\x04 \x01(\x03H\x00R\aplan_id\x88\x01\x01B\n" +
+	"\n" +
+	"\b_plan_id\"c\n" +
+	"\x10CalendarResponse\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x129\n" +
+	"\aentries\x18\x02 \x03(\v2\x1f.pairedratings.v1.CalendarEntryR\aentries\"~\n" +
+	"\fUpcomingItem\x12*\n" +
+	"\x04show\x18\x01 \x01(\v2\x16.pairedratings.v1.ShowR\x04show\x12\"\n" +
+	"\frelease_date\x18\x02 \x01(\tR\frelease_date\x12\x1e\n" +
+	"\n" +
+	"days_until\x18\x03 \x01(\x05R\n" +
+	"days_until\"H\n" +
+	"\x10UpcomingResponse\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.pairedratings.v1.UpcomingItemR\x05items\"O\n" +
+	"\vTasteBucket\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x18\n" +
+	"\aaverage\x18\x03 \x01(\x01R\aaverage\"\xca\x02\n" +
+	"\fTasteProfile\x12\x16\n" +
+	"\x06person\x18\x01 \x01(\tR\x06person\x12 \n" +
+	"\vrated_count\x18\x02 \x01(\x05R\vrated_count\x12\x18\n" +
+	"\aaverage\x18\x03 \x01(\x01R\aaverage\x125\n" +
+	"\x06genres\x18\x04 \x03(\v2\x1d.pairedratings.v1.TasteBucketR\x06genres\x127\n" +
+	"\adecades\x18\x05 \x03(\v2\x1d.pairedratings.v1.TasteBucketR\adecades\x12;\n" +
+	"\tcountries\x18\x06 \x03(\v2\x1d.pairedratings.v1.TasteBucketR\tcountries\x129\n" +
+	"\bruntimes\x18\a \x03(\v2\x1d.pairedratings.v1.TasteBucketR\bruntimes\"\x86\x01\n" +
+	"\x12GenreCompatibility\x12\x14\n" +
+	"\x05genre\x18\x01 \x01(\tR\x05genre\x12\x1e\n" +
+	"\n" +
+	"bf_average\x18\x02 \x01(\x01R\n" +
+	"bf_average\x12\x1e\n" +
+	"\n" +
+	"gf_average\x18\x03 \x01(\x01R\n" +
+	"gf_average\x12\x1a\n" +
+	"\bcombined\x18\x04 \x01(\x01R\bcombined\"\x82\x01\n" +
+	"\x10FairnessResponse\x12\x1a\n" +
+	"\bbf_picks\x18\x01 \x01(\x05R\bbf_picks\x12\x1a\n" +
+	"\bgf_picks\x18\x02 \x01(\x05R\bgf_picks\x12\"\n" +
+	"\funattributed\x18\x03 \x01(\x05R\funattributed\x12\x12\n" +
+	"\x04turn\x18\x04 \x01(\tR\x04turn\"\xc3\x01\n" +
+	"\tVetoStats\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1c\n" +
+	"\tbf_active\x18\x02 \x01(\x05R\tbf_active\x12\x1c\n" +
+	"\tgf_active\x18\x03 \x01(\x05R\tgf_active\x12\"\n" +
+	"\fbf_remaining\x18\x04 \x01(\x05R\fbf_remaining\x12\"\n" +
+	"\fgf_remaining\x18\x05 \x01(\x05R\fgf_remaining\x12\x1c\n" +
+	"\toverruled\x18\x06 \x01(\x05R\toverruled\"V\n" +
+	"\n" +
+	"WatchEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\ashow_id\x18\x02 \x01(\x03R\ashow_id\x12\x1e\n" +
+	"\n" +
+	"watched_at\x18\x03 \x01(\tR\n" +
+	"watched_at\"I\n" +
+	"\x0fWatchesResponse\x126\n" +
+	"\awatches\x18\x01 \x03(\v2\x1c.pairedratings.v1.WatchEventR\awatches\"E\n" +
+	"\x0fLogWatchRequest\x12#\n" +
+	"\n" +
+	"watched_at\x18\x01 \x01(\tH\x00R\n" +
+	"watched_at\x88\x01\x01B\r\n" +
+	"\v_watched_at\"U\n" +
+	"\rMonthActivity\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x18\n" +
+	"\awatches\x18\x02 \x01(\x05R\awatches\x12\x14\n" +
+	"\x05shows\x18\x03 \x01(\x05R\x05shows\"K\n" +
+	"\x10ActivityResponse\x127\n" +
+	"\x06months\x18\x01 \x03(\v2\x1f.pairedratings.v1.MonthActivityR\x06months\"\xf1\x02\n" +
+	"\x15CompatibilityResponse\x12\"\n" +
+	"\fshared_count\x18\x01 \x01(\x05R\fshared_count\x12$\n" +
+	"\raverage_delta\x18\x02 \x01(\x01R\raverage_delta\x12&\n" +
+	"\x0eagreement_rate\x18\x03 \x01(\x01R\x0eagreement_rate\x12\"\n" +
+	"\frating_score\x18\x04 \x01(\x01R\frating_score\x12$\n" +
+	"\rgenre_overlap\x18\x05 \x01(\x01R\rgenre_overlap\x12N\n" +
+	"\x0fshared_favorite\x18\x06 \x03(\v2$.pairedratings.v1.GenreCompatibilityR\x0fshared_favorite\x12L\n" +
+	"\x0eavoid_together\x18\a \x03(\v2$.pairedratings.v1.GenreCompatibilityR\x0eavoid_together\"\x9a\x01\n" +
+	"\fBacklogTotal\x12\x1e\n" +
+	"\n" +
+	"media_type\x18\x01 \x01(\tR\n" +
+	"media_type\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x18\n" +
+	"\aminutes\x18\x03 \x01(\x03R\aminutes\x12\x14\n" +
+	"\x05hours\x18\x04 \x01(\x01R\x05hours\x12$\n" +
+	"\runknown_count\x18\x05 \x01(\x05R\runknown_count\"\x89\x01\n" +
+	"\x0fBacklogResponse\x124\n" +
+	"\x05total\x18\x01 \x01(\v2\x1e.pairedratings.v1.BacklogTotalR\x05total\x12@\n" +
+	"\vmedia_types\x18\x02 \x03(\v2\x1e.pairedratings.v1.BacklogTotalR\vmedia_types\"S\n" +
+	"\x0fTonightResponse\x12,\n" +
+	"\x05shows\x18\x01 \x03(\v2\x16.pairedratings.v1.ShowR\x05shows\x12\x12\n" +
+	"\x04turn\x18\x02 \x01(\tR\x04turn\"\xb6\x01\n" +
+	"\x11RemindersResponse\x12\x16\n" +
+	"\x06person\x18\x01 \x01(\tR\x06person\x12)\n" +
+	"\rsnoozed_until\x18\x02 \x01(\tH\x00R\rsnoozed_until\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"after_days\x18\x03 \x01(\x05R\n" +
+	"after_days\x12,\n" +
+	"\x05shows\x18\x04 \x03(\v2\x16.pairedratings.v1.ShowR\x05showsB\x10\n" +
+	"\x0e_snoozed_until\"#\n" +
+	"\rSnoozeRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"(\n" +
 	"\x0eGenresResponse\x12\x16\n" +
-	"\x06genres\x18\x01 \x03(\tR\x06genres\"\xd6\x02\n" +
+	"\x06genres\x18\x01 \x03(\tR\x06genres\"\xa1\x03\n" +
 	"\fSearchResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
 	"\n" +
@@ -1506,7 +7530,8 @@ const file_paired_ratings_proto_rawDesc = "" +
 	"in_library\x12\x16\n" +
 	"\x06genres\x18\n" +
 	" \x03(\tR\x06genres\x12,\n" +
-	"\x11original_language\x18\f \x01(\tR\x11original_languageJ\x04\b\v\x10\f\"\xc9\x02\n" +
+	"\x11original_language\x18\f \x01(\tR\x11original_language\x12I\n" +
+	"\x0fwatch_providers\x18\r \x03(\v2\x1f.pairedratings.v1.WatchProviderR\x0fwatch_providersJ\x04\b\v\x10\f\"\x87\x06\n" +
 	"\rSearchRequest\x12\f\n" +
 	"\x01q\x18\x01 \x01(\tR\x01q\x12\x1e\n" +
 	"\n" +
@@ -1523,12 +7548,54 @@ const file_paired_ratings_proto_rawDesc = "" +
 	"\x0eorigin_country\x18\t \x01(\tR\x0eorigin_country\x12,\n" +
 	"\x11original_language\x18\n" +
 	" \x01(\tR\x11original_language\x12\x12\n" +
-	"\x04page\x18\v \x01(\x05R\x04page\"\xa6\x01\n" +
+	"\x04page\x18\v \x01(\x05R\x04page\x12\"\n" +
+	"\fwatch_region\x18\f \x01(\tR\fwatch_region\x12(\n" +
+	"\x0fwatch_providers\x18\r \x01(\tR\x0fwatch_providers\x124\n" +
+	"\x15certification_country\x18\x0e \x01(\tR\x15certification_country\x12$\n" +
+	"\rcertification\x18\x0f \x01(\tR\rcertification\x12(\n" +
+	"\x0fexclude_library\x18\x10 \x01(\tR\x0fexclude_library\x12\"\n" +
+	"\fhide_watched\x18\x11 \x01(\tR\fhide_watched\x12\"\n" +
+	"\four_services\x18\x12 \x01(\tR\four_services\x12\x16\n" +
+	"\x06decade\x18\x13 \x01(\tR\x06decade\x12&\n" +
+	"\x0egenres_exclude\x18\x14 \x01(\tR\x0egenres_exclude\x12\x1c\n" +
+	"\tcompanies\x18\x15 \x01(\tR\tcompanies\x12\x1a\n" +
+	"\bnetworks\x18\x16 \x01(\tR\bnetworks\x12&\n" +
+	"\x0ewith_providers\x18\x17 \x01(\tR\x0ewith_providers\"b\n" +
+	"\x12SearchHistoryEntry\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12 \n" +
+	"\vsearched_at\x18\x03 \x01(\tR\vsearched_at\"W\n" +
+	"\x15SearchHistoryResponse\x12>\n" +
+	"\aentries\x18\x01 \x03(\v2$.pairedratings.v1.SearchHistoryEntryR\aentries\"8\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"Q\n" +
+	"\x0fSuggestResponse\x12>\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1c.pairedratings.v1.SuggestionR\vsuggestions\"\x93\x01\n" +
+	"\x11RecommendationRow\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12.\n" +
+	"\x06source\x18\x02 \x01(\v2\x16.pairedratings.v1.ShowR\x06source\x128\n" +
+	"\aresults\x18\x03 \x03(\v2\x1e.pairedratings.v1.SearchResultR\aresults\"R\n" +
+	"\x17RecommendationsResponse\x127\n" +
+	"\x04rows\x18\x01 \x03(\v2#.pairedratings.v1.RecommendationRowR\x04rows\"\xe2\x02\n" +
 	"\x0eSearchResponse\x128\n" +
 	"\aresults\x18\x01 \x03(\v2\x1e.pairedratings.v1.SearchResultR\aresults\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12 \n" +
 	"\vtotal_pages\x18\x03 \x01(\x05R\vtotal_pages\x12$\n" +
-	"\rtotal_results\x18\x04 \x01(\x05R\rtotal_results\"+\n" +
+	"\rtotal_results\x18\x04 \x01(\x05R\rtotal_results\x126\n" +
+	"\x06people\x18\x05 \x03(\v2\x1e.pairedratings.v1.PersonResultR\x06people\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\x12\x1a\n" +
+	"\bhas_more\x18\a \x01(\bR\bhas_more\x12$\n" +
+	"\rpages_scanned\x18\b \x01(\x05R\rpages_scanned\x12\"\n" +
+	"\fwatch_region\x18\t \x01(\tR\fwatch_region\"\xe4\x01\n" +
+	"\fPersonResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\fprofile_path\x18\x03 \x01(\tR\fprofile_path\x122\n" +
+	"\x14known_for_department\x18\x04 \x01(\tR\x14known_for_department\x12<\n" +
+	"\tknown_for\x18\x05 \x03(\v2\x1e.pairedratings.v1.SearchResultR\tknown_for\x12\x1a\n" +
+	"\btmdb_url\x18\x06 \x01(\tR\btmdb_url\"+\n" +
 	"\x05Genre\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"1\n" +
@@ -1549,15 +7616,44 @@ const file_paired_ratings_proto_rawDesc = "" +
 	"\bimdb_url\x18\x01 \x01(\tH\x00R\bimdb_url\x88\x01\x01\x12\x1f\n" +
 	"\btmdb_url\x18\x02 \x01(\tH\x01R\btmdb_url\x88\x01\x01B\v\n" +
 	"\t_imdb_urlB\v\n" +
-	"\t_tmdb_url\"*\n" +
+	"\t_tmdb_url\"p\n" +
 	"\fLoginRequest\x12\x1a\n" +
-	"\bpassword\x18\x01 \x01(\tR\bpassword\"b\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06person\x18\x02 \x01(\tR\x06person\x12\x1f\n" +
+	"\bremember\x18\x03 \x01(\bH\x00R\bremember\x88\x01\x01B\v\n" +
+	"\t_remember\"*\n" +
+	"\x10SetPersonRequest\x12\x16\n" +
+	"\x06person\x18\x01 \x01(\tR\x06person\"n\n" +
+	"\vPreferences\x12\x16\n" +
+	"\x06person\x18\x01 \x01(\tR\x06person\x121\n" +
+	"\x11metadata_language\x18\x02 \x01(\tH\x00R\x11metadata_language\x88\x01\x01B\x14\n" +
+	"\x12_metadata_language\"\x94\x01\n" +
+	"\x13PreferencesResponse\x12?\n" +
+	"\vpreferences\x18\x01 \x03(\v2\x1d.pairedratings.v1.PreferencesR\vpreferences\x12<\n" +
+	"\x19default_metadata_language\x18\x02 \x01(\tR\x19default_metadata_language\"c\n" +
+	"\x18UpdatePreferencesRequest\x121\n" +
+	"\x11metadata_language\x18\x01 \x01(\tH\x00R\x11metadata_language\x88\x01\x01B\x14\n" +
+	"\x12_metadata_language\"\x98\x01\n" +
 	"\x0eAddShowRequest\x12\x18\n" +
 	"\atmdb_id\x18\x01 \x01(\x03R\atmdb_id\x12\x1e\n" +
 	"\n" +
 	"media_type\x18\x02 \x01(\tR\n" +
 	"media_type\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"\xda\x01\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\aimdb_id\x18\x04 \x01(\tR\aimdb_id\x12\x1a\n" +
+	"\btmdb_url\x18\x05 \x01(\tR\btmdb_url\"_\n" +
+	"\x0fQuickAddRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1e\n" +
+	"\n" +
+	"media_type\x18\x02 \x01(\tR\n" +
+	"media_type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\x9a\x01\n" +
+	"\x10QuickAddResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\bR\x05added\x120\n" +
+	"\x04show\x18\x02 \x01(\v2\x1c.pairedratings.v1.ShowDetailR\x04show\x12>\n" +
+	"\n" +
+	"candidates\x18\x03 \x03(\v2\x1e.pairedratings.v1.SearchResultR\n" +
+	"candidates\"\xad\x02\n" +
 	"\x0eRatingsRequest\x12!\n" +
 	"\tbf_rating\x18\x01 \x01(\x05H\x00R\tbf_rating\x88\x01\x01\x12!\n" +
 	"\tgf_rating\x18\x02 \x01(\x05H\x01R\tgf_rating\x88\x01\x01\x12#\n" +
@@ -1566,18 +7662,213 @@ const file_paired_ratings_proto_rawDesc = "" +
 	"bf_comment\x88\x01\x01\x12#\n" +
 	"\n" +
 	"gf_comment\x18\x04 \x01(\tH\x03R\n" +
-	"gf_comment\x88\x01\x01B\f\n" +
+	"gf_comment\x88\x01\x01\x12\x1b\n" +
+	"\x06rating\x18\x05 \x01(\x05H\x04R\x06rating\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x06 \x01(\tH\x05R\acomment\x88\x01\x01B\f\n" +
+	"\n" +
+	"_bf_ratingB\f\n" +
+	"\n" +
+	"_gf_ratingB\r\n" +
+	"\v_bf_commentB\r\n" +
+	"\v_gf_commentB\t\n" +
+	"\a_ratingB\n" +
+	"\n" +
+	"\b_comment\"\x8a\x03\n" +
+	"\tShowPatch\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12!\n" +
+	"\tbf_rating\x18\x02 \x01(\x05H\x01R\tbf_rating\x88\x01\x01\x12!\n" +
+	"\tgf_rating\x18\x03 \x01(\x05H\x02R\tgf_rating\x88\x01\x01\x12#\n" +
+	"\n" +
+	"bf_comment\x18\x04 \x01(\tH\x03R\n" +
+	"bf_comment\x88\x01\x01\x12#\n" +
+	"\n" +
+	"gf_comment\x18\x05 \x01(\tH\x04R\n" +
+	"gf_comment\x88\x01\x01\x12#\n" +
+	"\n" +
+	"watched_at\x18\x06 \x01(\tH\x05R\n" +
+	"watched_at\x88\x01\x01\x12\x1b\n" +
+	"\x06pinned\x18\a \x01(\bH\x06R\x06pinned\x88\x01\x01\x12!\n" +
+	"\tpicked_by\x18\b \x01(\tH\aR\tpicked_by\x88\x01\x01B\t\n" +
+	"\a_statusB\f\n" +
 	"\n" +
 	"_bf_ratingB\f\n" +
 	"\n" +
 	"_gf_ratingB\r\n" +
 	"\v_bf_commentB\r\n" +
-	"\v_gf_comment\"+\n" +
+	"\v_gf_commentB\r\n" +
+	"\v_watched_atB\t\n" +
+	"\a_pinnedB\f\n" +
+	"\n" +
+	"_picked_by\"Z\n" +
+	"\fMediaRequest\x123\n" +
+	"\x12quality_profile_id\x18\x01 \x01(\x03H\x00R\x12quality_profile_id\x88\x01\x01B\x15\n" +
+	"\x13_quality_profile_id\"'\n" +
+	"\rStatusRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"4\n" +
+	"\x16UnfreezeRatingsRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"D\n" +
+	"\fEraseRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x18\n" +
+	"\aconfirm\x18\x02 \x01(\tR\aconfirm\"$\n" +
+	"\n" +
+	"PinRequest\x12\x16\n" +
+	"\x06pinned\x18\x01 \x01(\bR\x06pinned\"+\n" +
 	"\x0fRefreshResponse\x12\x18\n" +
-	"\aupdated\x18\x01 \x01(\x05R\aupdated\"_\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\"v\n" +
+	"\fSyncResponse\x12,\n" +
+	"\x05shows\x18\x01 \x03(\v2\x16.pairedratings.v1.ShowR\x05shows\x12 \n" +
+	"\vdeleted_ids\x18\x02 \x03(\x03R\vdeleted_ids\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\xc3\x01\n" +
+	"\x0eHealthResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x122\n" +
+	"\x14integrity_checked_at\x18\x02 \x01(\tR\x14integrity_checked_at\x12.\n" +
+	"\x12integrity_problems\x18\x03 \x03(\tR\x12integrity_problems\x125\n" +
+	"\x04tmdb\x18\x04 \x01(\v2!.pairedratings.v1.TMDBCredentialsR\x04tmdb\"\x99\x01\n" +
+	"\x0fTMDBCredentials\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\x05R\x06active\x12\x1a\n" +
+	"\bfailures\x18\x03 \x01(\x05R\bfailures\x12\x1c\n" +
+	"\trotations\x18\x04 \x01(\x05R\trotations\x12\x1e\n" +
+	"\n" +
+	"rotated_at\x18\x05 \x01(\tR\n" +
+	"rotated_at\"T\n" +
+	"\x10OptimizeResponse\x12 \n" +
+	"\vsize_before\x18\x01 \x01(\x03R\vsize_before\x12\x1e\n" +
+	"\n" +
+	"size_after\x18\x02 \x01(\x03R\n" +
+	"size_after\"h\n" +
+	"\x0eLoginFailureIP\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x18\n" +
+	"\alast_at\x18\x03 \x01(\tR\alast_at\x12\x16\n" +
+	"\x06locked\x18\x04 \x01(\bR\x06locked\"@\n" +
+	"\x12LoginFailureBucket\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xd8\x03\n" +
+	"\rLibraryChange\x12\x18\n" +
+	"\ashow_id\x18\x01 \x01(\x03R\ashow_id\x12\x18\n" +
+	"\atmdb_id\x18\x02 \x01(\x03R\atmdb_id\x12\x1e\n" +
+	"\n" +
+	"media_type\x18\x03 \x01(\tR\n" +
+	"media_type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12)\n" +
+	"\rold_bf_rating\x18\x05 \x01(\x03H\x00R\rold_bf_rating\x88\x01\x01\x12)\n" +
+	"\rold_gf_rating\x18\x06 \x01(\x03H\x01R\rold_gf_rating\x88\x01\x01\x12)\n" +
+	"\rnew_bf_rating\x18\a \x01(\x03H\x02R\rnew_bf_rating\x88\x01\x01\x12)\n" +
+	"\rnew_gf_rating\x18\b \x01(\x03H\x03R\rnew_gf_rating\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"changed_at\x18\t \x01(\tR\n" +
+	"changed_at\x12\x12\n" +
+	"\x04kind\x18\n" +
+	" \x01(\tR\x04kind\x12%\n" +
+	"\vold_tmdb_id\x18\v \x01(\x03H\x04R\vold_tmdb_id\x88\x01\x01B\x10\n" +
+	"\x0e_old_bf_ratingB\x10\n" +
+	"\x0e_old_gf_ratingB\x10\n" +
+	"\x0e_new_bf_ratingB\x10\n" +
+	"\x0e_new_gf_ratingB\x0e\n" +
+	"\f_old_tmdb_id\"\xa7\x02\n" +
+	"\x13LibraryDiffResponse\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x02 \x01(\tR\x05until\x125\n" +
+	"\x05added\x18\x03 \x03(\v2\x1f.pairedratings.v1.LibraryChangeR\x05added\x129\n" +
+	"\adeleted\x18\x04 \x03(\v2\x1f.pairedratings.v1.LibraryChangeR\adeleted\x125\n" +
+	"\x05rated\x18\x05 \x03(\v2\x1f.pairedratings.v1.LibraryChangeR\x05rated\x12;\n" +
+	"\bremapped\x18\x06 \x03(\v2\x1f.pairedratings.v1.LibraryChangeR\bremapped\"\x83\x02\n" +
+	"\tJobStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12\x12\n" +
+	"\x04runs\x18\x03 \x01(\x05R\x04runs\x12\x1a\n" +
+	"\bfailures\x18\x04 \x01(\x05R\bfailures\x12(\n" +
+	"\x0flast_started_at\x18\x05 \x01(\tR\x0flast_started_at\x12*\n" +
+	"\x10last_finished_at\x18\x06 \x01(\tR\x10last_finished_at\x12\x1e\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\n" +
+	"last_error\x12 \n" +
+	"\vnext_run_at\x18\b \x01(\tR\vnext_run_at\"?\n" +
+	"\fJobsResponse\x12/\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x1b.pairedratings.v1.JobStatusR\x04jobs\"\xc0\x01\n" +
+	"\x0eSecurityReport\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12&\n" +
+	"\x0etotal_failures\x18\x02 \x01(\x03R\x0etotal_failures\x122\n" +
+	"\x03ips\x18\x03 \x03(\v2 .pairedratings.v1.LoginFailureIPR\x03ips\x12>\n" +
+	"\abuckets\x18\x04 \x03(\v2$.pairedratings.v1.LoginFailureBucketR\abuckets\"q\n" +
+	"\x0fWebhookResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\bR\amatched\x12\x18\n" +
+	"\ashow_id\x18\x02 \x01(\x03R\ashow_id\x12*\n" +
+	"\x10ratings_required\x18\x03 \x01(\bR\x10ratings_required\"Q\n" +
+	"\rWatchProvider\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tlogo_path\x18\x03 \x01(\tR\tlogo_path\"W\n" +
+	"\x16WatchProvidersResponse\x12=\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1f.pairedratings.v1.WatchProviderR\tproviders\"f\n" +
+	"\rSubscriptions\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12=\n" +
+	"\tproviders\x18\x02 \x03(\v2\x1f.pairedratings.v1.WatchProviderR\tproviders\"X\n" +
+	"\x1aUpdateSubscriptionsRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\"\n" +
+	"\fprovider_ids\x18\x02 \x03(\x05R\fprovider_ids\"+\n" +
+	"\x15WatchedRatingsSetting\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"p\n" +
+	"\x0eExportManifest\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\x05R\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"show_count\x18\x02 \x01(\x05R\n" +
+	"show_count\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"\x9d\x01\n" +
 	"\rExportPayload\x12 \n" +
 	"\vexported_at\x18\x01 \x01(\tR\vexported_at\x12,\n" +
-	"\x05shows\x18\x02 \x03(\v2\x16.pairedratings.v1.ShowR\x05showsB7Z5github.com/handsomefox/website-rating/internal/gen/pbb\x06proto3"
+	"\x05shows\x18\x02 \x03(\v2\x16.pairedratings.v1.ShowR\x05shows\x12<\n" +
+	"\bmanifest\x18\x03 \x01(\v2 .pairedratings.v1.ExportManifestR\bmanifest\"\x9f\x01\n" +
+	"\x0fCSVImportReview\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\tR\x04year\x12>\n" +
+	"\n" +
+	"candidates\x18\x04 \x03(\v2\x1e.pairedratings.v1.SearchResultR\n" +
+	"candidates\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\x03R\x02id\"\x9c\x01\n" +
+	"\x11CSVImportResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\x129\n" +
+	"\x06review\x18\x03 \x03(\v2!.pairedratings.v1.CSVImportReviewR\x06review\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xef\x02\n" +
+	"\rPendingImport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04year\x18\x05 \x01(\tR\x04year\x12\x1e\n" +
+	"\n" +
+	"media_type\x18\x06 \x01(\tR\n" +
+	"media_type\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\tbf_rating\x18\b \x01(\x03H\x00R\tbf_rating\x88\x01\x01\x12!\n" +
+	"\tgf_rating\x18\t \x01(\x03H\x01R\tgf_rating\x88\x01\x01\x12>\n" +
+	"\n" +
+	"candidates\x18\n" +
+	" \x03(\v2\x1e.pairedratings.v1.SearchResultR\n" +
+	"candidates\x12\x1e\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\n" +
+	"created_atB\f\n" +
+	"\n" +
+	"_bf_ratingB\f\n" +
+	"\n" +
+	"_gf_rating\"S\n" +
+	"\x16PendingImportsResponse\x129\n" +
+	"\apending\x18\x01 \x03(\v2\x1f.pairedratings.v1.PendingImportR\apending\"P\n" +
+	"\x14ConfirmImportRequest\x12\x18\n" +
+	"\atmdb_id\x18\x01 \x01(\x03R\atmdb_id\x12\x1e\n" +
+	"\n" +
+	"media_type\x18\x02 \x01(\tR\n" +
+	"media_type\"\x8e\x01\n" +
+	"\x0eImportResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x05R\askipped\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\x12\x16\n" +
+	"\x06frozen\x18\x05 \x01(\x05R\x06frozenB7Z5github.com/handsomefox/website-rating/internal/gen/pbb\x06proto3"
 
 var (
 	file_paired_ratings_proto_rawDescOnce sync.Once
@@ -1591,44 +7882,181 @@ func file_paired_ratings_proto_rawDescGZIP() []byte {
 	return file_paired_ratings_proto_rawDescData
 }
 
-var file_paired_ratings_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_paired_ratings_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
 var file_paired_ratings_proto_goTypes = []any{
-	(*SessionResponse)(nil),         // 0: pairedratings.v1.SessionResponse
-	(*ErrorResponse)(nil),           // 1: pairedratings.v1.ErrorResponse
-	(*Show)(nil),                    // 2: pairedratings.v1.Show
-	(*ShowDetail)(nil),              // 3: pairedratings.v1.ShowDetail
-	(*ListResponse)(nil),            // 4: pairedratings.v1.ListResponse
-	(*GenresResponse)(nil),          // 5: pairedratings.v1.GenresResponse
-	(*SearchResult)(nil),            // 6: pairedratings.v1.SearchResult
-	(*SearchRequest)(nil),           // 7: pairedratings.v1.SearchRequest
-	(*SearchResponse)(nil),          // 8: pairedratings.v1.SearchResponse
-	(*Genre)(nil),                   // 9: pairedratings.v1.Genre
-	(*Country)(nil),                 // 10: pairedratings.v1.Country
-	(*Language)(nil),                // 11: pairedratings.v1.Language
-	(*SearchGenresResponse)(nil),    // 12: pairedratings.v1.SearchGenresResponse
-	(*SearchCountriesResponse)(nil), // 13: pairedratings.v1.SearchCountriesResponse
-	(*SearchLanguagesResponse)(nil), // 14: pairedratings.v1.SearchLanguagesResponse
-	(*SearchResolveResponse)(nil),   // 15: pairedratings.v1.SearchResolveResponse
-	(*LoginRequest)(nil),            // 16: pairedratings.v1.LoginRequest
-	(*AddShowRequest)(nil),          // 17: pairedratings.v1.AddShowRequest
-	(*RatingsRequest)(nil),          // 18: pairedratings.v1.RatingsRequest
-	(*RefreshResponse)(nil),         // 19: pairedratings.v1.RefreshResponse
-	(*ExportPayload)(nil),           // 20: pairedratings.v1.ExportPayload
+	(*SessionResponse)(nil),            // 0: pairedratings.v1.SessionResponse
+	(*SessionInfo)(nil),                // 1: pairedratings.v1.SessionInfo
+	(*SessionsResponse)(nil),           // 2: pairedratings.v1.SessionsResponse
+	(*SessionPatch)(nil),               // 3: pairedratings.v1.SessionPatch
+	(*ChangePasswordRequest)(nil),      // 4: pairedratings.v1.ChangePasswordRequest
+	(*ErrorResponse)(nil),              // 5: pairedratings.v1.ErrorResponse
+	(*ProblemDetails)(nil),             // 6: pairedratings.v1.ProblemDetails
+	(*Show)(nil),                       // 7: pairedratings.v1.Show
+	(*ShowDetail)(nil),                 // 8: pairedratings.v1.ShowDetail
+	(*ExternalRating)(nil),             // 9: pairedratings.v1.ExternalRating
+	(*ContentWarning)(nil),             // 10: pairedratings.v1.ContentWarning
+	(*ListResponse)(nil),               // 11: pairedratings.v1.ListResponse
+	(*Network)(nil),                    // 12: pairedratings.v1.Network
+	(*Company)(nil),                    // 13: pairedratings.v1.Company
+	(*ListCriteria)(nil),               // 14: pairedratings.v1.ListCriteria
+	(*SavedList)(nil),                  // 15: pairedratings.v1.SavedList
+	(*SavedListsResponse)(nil),         // 16: pairedratings.v1.SavedListsResponse
+	(*SavedListRequest)(nil),           // 17: pairedratings.v1.SavedListRequest
+	(*SavedListDetail)(nil),            // 18: pairedratings.v1.SavedListDetail
+	(*Plan)(nil),                       // 19: pairedratings.v1.Plan
+	(*PlansResponse)(nil),              // 20: pairedratings.v1.PlansResponse
+	(*PlanRequest)(nil),                // 21: pairedratings.v1.PlanRequest
+	(*RSVPRequest)(nil),                // 22: pairedratings.v1.RSVPRequest
+	(*CalendarEntry)(nil),              // 23: pairedratings.v1.CalendarEntry
+	(*CalendarResponse)(nil),           // 24: pairedratings.v1.CalendarResponse
+	(*UpcomingItem)(nil),               // 25: pairedratings.v1.UpcomingItem
+	(*UpcomingResponse)(nil),           // 26: pairedratings.v1.UpcomingResponse
+	(*TasteBucket)(nil),                // 27: pairedratings.v1.TasteBucket
+	(*TasteProfile)(nil),               // 28: pairedratings.v1.TasteProfile
+	(*GenreCompatibility)(nil),         // 29: pairedratings.v1.GenreCompatibility
+	(*FairnessResponse)(nil),           // 30: pairedratings.v1.FairnessResponse
+	(*VetoStats)(nil),                  // 31: pairedratings.v1.VetoStats
+	(*WatchEvent)(nil),                 // 32: pairedratings.v1.WatchEvent
+	(*WatchesResponse)(nil),            // 33: pairedratings.v1.WatchesResponse
+	(*LogWatchRequest)(nil),            // 34: pairedratings.v1.LogWatchRequest
+	(*MonthActivity)(nil),              // 35: pairedratings.v1.MonthActivity
+	(*ActivityResponse)(nil),           // 36: pairedratings.v1.ActivityResponse
+	(*CompatibilityResponse)(nil),      // 37: pairedratings.v1.CompatibilityResponse
+	(*BacklogTotal)(nil),               // 38: pairedratings.v1.BacklogTotal
+	(*BacklogResponse)(nil),            // 39: pairedratings.v1.BacklogResponse
+	(*TonightResponse)(nil),            // 40: pairedratings.v1.TonightResponse
+	(*RemindersResponse)(nil),          // 41: pairedratings.v1.RemindersResponse
+	(*SnoozeRequest)(nil),              // 42: pairedratings.v1.SnoozeRequest
+	(*GenresResponse)(nil),             // 43: pairedratings.v1.GenresResponse
+	(*SearchResult)(nil),               // 44: pairedratings.v1.SearchResult
+	(*SearchRequest)(nil),              // 45: pairedratings.v1.SearchRequest
+	(*SearchHistoryEntry)(nil),         // 46: pairedratings.v1.SearchHistoryEntry
+	(*SearchHistoryResponse)(nil),      // 47: pairedratings.v1.SearchHistoryResponse
+	(*Suggestion)(nil),                 // 48: pairedratings.v1.Suggestion
+	(*SuggestResponse)(nil),            // 49: pairedratings.v1.SuggestResponse
+	(*RecommendationRow)(nil),          // 50: pairedratings.v1.RecommendationRow
+	(*RecommendationsResponse)(nil),    // 51: pairedratings.v1.RecommendationsResponse
+	(*SearchResponse)(nil),             // 52: pairedratings.v1.SearchResponse
+	(*PersonResult)(nil),               // 53: pairedratings.v1.PersonResult
+	(*Genre)(nil),                      // 54: pairedratings.v1.Genre
+	(*Country)(nil),                    // 55: pairedratings.v1.Country
+	(*Language)(nil),                   // 56: pairedratings.v1.Language
+	(*SearchGenresResponse)(nil),       // 57: pairedratings.v1.SearchGenresResponse
+	(*SearchCountriesResponse)(nil),    // 58: pairedratings.v1.SearchCountriesResponse
+	(*SearchLanguagesResponse)(nil),    // 59: pairedratings.v1.SearchLanguagesResponse
+	(*SearchResolveResponse)(nil),      // 60: pairedratings.v1.SearchResolveResponse
+	(*LoginRequest)(nil),               // 61: pairedratings.v1.LoginRequest
+	(*SetPersonRequest)(nil),           // 62: pairedratings.v1.SetPersonRequest
+	(*Preferences)(nil),                // 63: pairedratings.v1.Preferences
+	(*PreferencesResponse)(nil),        // 64: pairedratings.v1.PreferencesResponse
+	(*UpdatePreferencesRequest)(nil),   // 65: pairedratings.v1.UpdatePreferencesRequest
+	(*AddShowRequest)(nil),             // 66: pairedratings.v1.AddShowRequest
+	(*QuickAddRequest)(nil),            // 67: pairedratings.v1.QuickAddRequest
+	(*QuickAddResponse)(nil),           // 68: pairedratings.v1.QuickAddResponse
+	(*RatingsRequest)(nil),             // 69: pairedratings.v1.RatingsRequest
+	(*ShowPatch)(nil),                  // 70: pairedratings.v1.ShowPatch
+	(*MediaRequest)(nil),               // 71: pairedratings.v1.MediaRequest
+	(*StatusRequest)(nil),              // 72: pairedratings.v1.StatusRequest
+	(*UnfreezeRatingsRequest)(nil),     // 73: pairedratings.v1.UnfreezeRatingsRequest
+	(*EraseRequest)(nil),               // 74: pairedratings.v1.EraseRequest
+	(*PinRequest)(nil),                 // 75: pairedratings.v1.PinRequest
+	(*RefreshResponse)(nil),            // 76: pairedratings.v1.RefreshResponse
+	(*SyncResponse)(nil),               // 77: pairedratings.v1.SyncResponse
+	(*HealthResponse)(nil),             // 78: pairedratings.v1.HealthResponse
+	(*TMDBCredentials)(nil),            // 79: pairedratings.v1.TMDBCredentials
+	(*OptimizeResponse)(nil),           // 80: pairedratings.v1.OptimizeResponse
+	(*LoginFailureIP)(nil),             // 81: pairedratings.v1.LoginFailureIP
+	(*LoginFailureBucket)(nil),         // 82: pairedratings.v1.LoginFailureBucket
+	(*LibraryChange)(nil),              // 83: pairedratings.v1.LibraryChange
+	(*LibraryDiffResponse)(nil),        // 84: pairedratings.v1.LibraryDiffResponse
+	(*JobStatus)(nil),                  // 85: pairedratings.v1.JobStatus
+	(*JobsResponse)(nil),               // 86: pairedratings.v1.JobsResponse
+	(*SecurityReport)(nil),             // 87: pairedratings.v1.SecurityReport
+	(*WebhookResponse)(nil),            // 88: pairedratings.v1.WebhookResponse
+	(*WatchProvider)(nil),              // 89: pairedratings.v1.WatchProvider
+	(*WatchProvidersResponse)(nil),     // 90: pairedratings.v1.WatchProvidersResponse
+	(*Subscriptions)(nil),              // 91: pairedratings.v1.Subscriptions
+	(*UpdateSubscriptionsRequest)(nil), // 92: pairedratings.v1.UpdateSubscriptionsRequest
+	(*WatchedRatingsSetting)(nil),      // 93: pairedratings.v1.WatchedRatingsSetting
+	(*ExportManifest)(nil),             // 94: pairedratings.v1.ExportManifest
+	(*ExportPayload)(nil),              // 95: pairedratings.v1.ExportPayload
+	(*CSVImportReview)(nil),            // 96: pairedratings.v1.CSVImportReview
+	(*CSVImportResponse)(nil),          // 97: pairedratings.v1.CSVImportResponse
+	(*PendingImport)(nil),              // 98: pairedratings.v1.PendingImport
+	(*PendingImportsResponse)(nil),     // 99: pairedratings.v1.PendingImportsResponse
+	(*ConfirmImportRequest)(nil),       // 100: pairedratings.v1.ConfirmImportRequest
+	(*ImportResponse)(nil),             // 101: pairedratings.v1.ImportResponse
 }
 var file_paired_ratings_proto_depIdxs = []int32{
-	2,  // 0: pairedratings.v1.ShowDetail.show:type_name -> pairedratings.v1.Show
-	2,  // 1: pairedratings.v1.ListResponse.shows:type_name -> pairedratings.v1.Show
-	6,  // 2: pairedratings.v1.SearchResponse.results:type_name -> pairedratings.v1.SearchResult
-	9,  // 3: pairedratings.v1.SearchGenresResponse.movie_genres:type_name -> pairedratings.v1.Genre
-	9,  // 4: pairedratings.v1.SearchGenresResponse.tv_genres:type_name -> pairedratings.v1.Genre
-	10, // 5: pairedratings.v1.SearchCountriesResponse.countries:type_name -> pairedratings.v1.Country
-	11, // 6: pairedratings.v1.SearchLanguagesResponse.languages:type_name -> pairedratings.v1.Language
-	2,  // 7: pairedratings.v1.ExportPayload.shows:type_name -> pairedratings.v1.Show
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	1,  // 0: pairedratings.v1.SessionsResponse.sessions:type_name -> pairedratings.v1.SessionInfo
+	7,  // 1: pairedratings.v1.ShowDetail.show:type_name -> pairedratings.v1.Show
+	10, // 2: pairedratings.v1.ShowDetail.warnings:type_name -> pairedratings.v1.ContentWarning
+	9,  // 3: pairedratings.v1.ShowDetail.external_ratings:type_name -> pairedratings.v1.ExternalRating
+	89, // 4: pairedratings.v1.ShowDetail.watch_providers:type_name -> pairedratings.v1.WatchProvider
+	7,  // 5: pairedratings.v1.ListResponse.shows:type_name -> pairedratings.v1.Show
+	13, // 6: pairedratings.v1.ListResponse.companies:type_name -> pairedratings.v1.Company
+	12, // 7: pairedratings.v1.ListResponse.networks:type_name -> pairedratings.v1.Network
+	14, // 8: pairedratings.v1.SavedList.criteria:type_name -> pairedratings.v1.ListCriteria
+	15, // 9: pairedratings.v1.SavedListsResponse.lists:type_name -> pairedratings.v1.SavedList
+	14, // 10: pairedratings.v1.SavedListRequest.criteria:type_name -> pairedratings.v1.ListCriteria
+	15, // 11: pairedratings.v1.SavedListDetail.list:type_name -> pairedratings.v1.SavedList
+	7,  // 12: pairedratings.v1.SavedListDetail.shows:type_name -> pairedratings.v1.Show
+	7,  // 13: pairedratings.v1.Plan.show:type_name -> pairedratings.v1.Show
+	19, // 14: pairedratings.v1.PlansResponse.plans:type_name -> pairedratings.v1.Plan
+	7,  // 15: pairedratings.v1.CalendarEntry.show:type_name -> pairedratings.v1.Show
+	23, // 16: pairedratings.v1.CalendarResponse.entries:type_name -> pairedratings.v1.CalendarEntry
+	7,  // 17: pairedratings.v1.UpcomingItem.show:type_name -> pairedratings.v1.Show
+	25, // 18: pairedratings.v1.UpcomingResponse.items:type_name -> pairedratings.v1.UpcomingItem
+	27, // 19: pairedratings.v1.TasteProfile.genres:type_name -> pairedratings.v1.TasteBucket
+	27, // 20: pairedratings.v1.TasteProfile.decades:type_name -> pairedratings.v1.TasteBucket
+	27, // 21: pairedratings.v1.TasteProfile.countries:type_name -> pairedratings.v1.TasteBucket
+	27, // 22: pairedratings.v1.TasteProfile.runtimes:type_name -> pairedratings.v1.TasteBucket
+	32, // 23: pairedratings.v1.WatchesResponse.watches:type_name -> pairedratings.v1.WatchEvent
+	35, // 24: pairedratings.v1.ActivityResponse.months:type_name -> pairedratings.v1.MonthActivity
+	29, // 25: pairedratings.v1.CompatibilityResponse.shared_favorite:type_name -> pairedratings.v1.GenreCompatibility
+	29, // 26: pairedratings.v1.CompatibilityResponse.avoid_together:type_name -> pairedratings.v1.GenreCompatibility
+	38, // 27: pairedratings.v1.BacklogResponse.total:type_name -> pairedratings.v1.BacklogTotal
+	38, // 28: pairedratings.v1.BacklogResponse.media_types:type_name -> pairedratings.v1.BacklogTotal
+	7,  // 29: pairedratings.v1.TonightResponse.shows:type_name -> pairedratings.v1.Show
+	7,  // 30: pairedratings.v1.RemindersResponse.shows:type_name -> pairedratings.v1.Show
+	89, // 31: pairedratings.v1.SearchResult.watch_providers:type_name -> pairedratings.v1.WatchProvider
+	46, // 32: pairedratings.v1.SearchHistoryResponse.entries:type_name -> pairedratings.v1.SearchHistoryEntry
+	48, // 33: pairedratings.v1.SuggestResponse.suggestions:type_name -> pairedratings.v1.Suggestion
+	7,  // 34: pairedratings.v1.RecommendationRow.source:type_name -> pairedratings.v1.Show
+	44, // 35: pairedratings.v1.RecommendationRow.results:type_name -> pairedratings.v1.SearchResult
+	50, // 36: pairedratings.v1.RecommendationsResponse.rows:type_name -> pairedratings.v1.RecommendationRow
+	44, // 37: pairedratings.v1.SearchResponse.results:type_name -> pairedratings.v1.SearchResult
+	53, // 38: pairedratings.v1.SearchResponse.people:type_name -> pairedratings.v1.PersonResult
+	44, // 39: pairedratings.v1.PersonResult.known_for:type_name -> pairedratings.v1.SearchResult
+	54, // 40: pairedratings.v1.SearchGenresResponse.movie_genres:type_name -> pairedratings.v1.Genre
+	54, // 41: pairedratings.v1.SearchGenresResponse.tv_genres:type_name -> pairedratings.v1.Genre
+	55, // 42: pairedratings.v1.SearchCountriesResponse.countries:type_name -> pairedratings.v1.Country
+	56, // 43: pairedratings.v1.SearchLanguagesResponse.languages:type_name -> pairedratings.v1.Language
+	63, // 44: pairedratings.v1.PreferencesResponse.preferences:type_name -> pairedratings.v1.Preferences
+	8,  // 45: pairedratings.v1.QuickAddResponse.show:type_name -> pairedratings.v1.ShowDetail
+	44, // 46: pairedratings.v1.QuickAddResponse.candidates:type_name -> pairedratings.v1.SearchResult
+	7,  // 47: pairedratings.v1.SyncResponse.shows:type_name -> pairedratings.v1.Show
+	79, // 48: pairedratings.v1.HealthResponse.tmdb:type_name -> pairedratings.v1.TMDBCredentials
+	83, // 49: pairedratings.v1.LibraryDiffResponse.added:type_name -> pairedratings.v1.LibraryChange
+	83, // 50: pairedratings.v1.LibraryDiffResponse.deleted:type_name -> pairedratings.v1.LibraryChange
+	83, // 51: pairedratings.v1.LibraryDiffResponse.rated:type_name -> pairedratings.v1.LibraryChange
+	83, // 52: pairedratings.v1.LibraryDiffResponse.remapped:type_name -> pairedratings.v1.LibraryChange
+	85, // 53: pairedratings.v1.JobsResponse.jobs:type_name -> pairedratings.v1.JobStatus
+	81, // 54: pairedratings.v1.SecurityReport.ips:type_name -> pairedratings.v1.LoginFailureIP
+	82, // 55: pairedratings.v1.SecurityReport.buckets:type_name -> pairedratings.v1.LoginFailureBucket
+	89, // 56: pairedratings.v1.WatchProvidersResponse.providers:type_name -> pairedratings.v1.WatchProvider
+	89, // 57: pairedratings.v1.Subscriptions.providers:type_name -> pairedratings.v1.WatchProvider
+	7,  // 58: pairedratings.v1.ExportPayload.shows:type_name -> pairedratings.v1.Show
+	94, // 59: pairedratings.v1.ExportPayload.manifest:type_name -> pairedratings.v1.ExportManifest
+	44, // 60: pairedratings.v1.CSVImportReview.candidates:type_name -> pairedratings.v1.SearchResult
+	96, // 61: pairedratings.v1.CSVImportResponse.review:type_name -> pairedratings.v1.CSVImportReview
+	44, // 62: pairedratings.v1.PendingImport.candidates:type_name -> pairedratings.v1.SearchResult
+	98, // 63: pairedratings.v1.PendingImportsResponse.pending:type_name -> pairedratings.v1.PendingImport
+	64, // [64:64] is the sub-list for method output_type
+	64, // [64:64] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_paired_ratings_proto_init() }
@@ -1637,17 +8065,31 @@ func file_paired_ratings_proto_init() {
 		return
 	}
 	file_paired_ratings_proto_msgTypes[0].OneofWrappers = []any{}
-	file_paired_ratings_proto_msgTypes[2].OneofWrappers = []any{}
-	file_paired_ratings_proto_msgTypes[3].OneofWrappers = []any{}
-	file_paired_ratings_proto_msgTypes[15].OneofWrappers = []any{}
-	file_paired_ratings_proto_msgTypes[18].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[1].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[7].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[8].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[14].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[19].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[21].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[23].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[34].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[41].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[60].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[61].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[63].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[65].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[69].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[70].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[71].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[83].OneofWrappers = []any{}
+	file_paired_ratings_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paired_ratings_proto_rawDesc), len(file_paired_ratings_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   102,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
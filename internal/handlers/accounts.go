@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// accountPerson returns the person whose account the request's session is
+// signed in to, or "" for sessions opened with the shared password.
+func accountPerson(r *http.Request) string {
+	session, ok := r.Context().Value(sessionKey{}).(store.Session)
+	if !ok || !session.Person.Valid {
+		return ""
+	}
+	return session.Person.V
+}
+
+// checkLogin checks a sign-in password. People with an account must use
+// their own password; everyone else uses the shared one. account reports
+// which of the two matched.
+func (h *Handler) checkLogin(ctx context.Context, person, password string) (ok, account bool, err error) {
+	if person != "" {
+		user, found, err := h.store.GetUser(ctx, person)
+		if err != nil {
+			return false, false, err
+		}
+		if found {
+			match, legacy := verifyPassword(user.Salt, password, user.Hash)
+			if match && legacy && !h.readOnly {
+				if err := h.rehashUser(ctx, person, password); err != nil {
+					slog.Warn("account password rehash failed", slog.String("person", person), slog.Any("err", err))
+				}
+			}
+			return match, true, nil
+		}
+	}
+	ok, err = h.checkPassword(ctx, password)
+	return ok, false, err
+}
+
+// rehashUser stores an account password again in the current hash format,
+// keeping its sessions.
+func (h *Handler) rehashUser(ctx context.Context, person, password string) error {
+	salt, hash, err := newPasswordHash(password)
+	if err != nil {
+		return err
+	}
+	return h.store.UpdateUserHash(ctx, person, salt, hash)
+}
+
+// postAccountPassword creates or updates the signed-in person's own account.
+// The current password is theirs when they already have an account, or the
+// shared one when they don't. The session is bound to the account, and the
+// person's other account sessions and every other shared-password session
+// are signed out, so none of them can keep acting as the person.
+func (h *Handler) postAccountPassword(w http.ResponseWriter, r *http.Request) error {
+	person := requestPerson(r)
+	if person == "" {
+		return badRequest("person required")
+	}
+
+	var req pb.ChangePasswordRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+
+	ok, _, err := h.checkLogin(r.Context(), person, req.CurrentPassword)
+	if err != nil {
+		return internal(err)
+	}
+	if !ok {
+		slog.Warn("account password: invalid password", slog.String("remote", r.RemoteAddr))
+		return unauthorized("invalid password")
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(req.NewPassword)) < passwordMinLen {
+		return badRequest("password too short")
+	}
+
+	salt, hash, err := newPasswordHash(req.NewPassword)
+	if err != nil {
+		return internal(err)
+	}
+
+	session, _ := h.authSession(r)
+	if err := h.store.SetUserPassword(r.Context(), person, salt, hash, session.ID); err != nil {
+		return internal(err)
+	}
+	slog.Info("account password set", slog.String("person", person), slog.String("remote", r.RemoteAddr))
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// checkRatingOwner rejects writes to a person's rating or comment unless
+// the request is signed in to that person's account or the person has no
+// account yet. Shared-password sessions may write both until then.
+func (h *Handler) checkRatingOwner(r *http.Request, bf, gf bool) error {
+	if bf {
+		if err := h.checkOwner(r, store.PersonBf, notYourRating()); err != nil {
+			return err
+		}
+	}
+	if gf {
+		if err := h.checkOwner(r, store.PersonGf, notYourRating()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkOwner returns denied unless the request may act as person: it is
+// signed in to person's account, or it is not signed in to an account and
+// person has none.
+func (h *Handler) checkOwner(r *http.Request, person string, denied error) error {
+	account := accountPerson(r)
+	if account == person {
+		return nil
+	}
+	if account != "" {
+		return denied
+	}
+	_, found, err := h.store.GetUser(r.Context(), person)
+	if err != nil {
+		return internal(err)
+	}
+	if found {
+		return denied
+	}
+	return nil
+}
+
+// errNotOwner is the denial lockedPeople passes to checkOwner.
+var errNotOwner = errors.New("not owner")
+
+// lockedPeople lists the people whose ratings and comments the request may
+// not write (see checkOwner). Imports leave those alone.
+func (h *Handler) lockedPeople(r *http.Request) ([]string, error) {
+	var locked []string
+	for _, person := range []string{store.PersonBf, store.PersonGf} {
+		err := h.checkOwner(r, person, errNotOwner)
+		if errors.Is(err, errNotOwner) {
+			locked = append(locked, person)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return locked, nil
+}
+
+func notYourRating() error {
+	return &Error{Status: http.StatusForbidden, Message: "can only rate as yourself"}
+}
+
+func notYourAccount() error {
+	return &Error{Status: http.StatusForbidden, Message: "not your account"}
+}
+
+// attributeRating moves the request's rating and comment onto the signed-in
+// person's fields, then checks that only their own fields are written.
+func (h *Handler) attributeRating(r *http.Request, req *pb.RatingsRequest) error {
+	if req.Rating != nil || req.Comment != nil {
+		switch requestPerson(r) {
+		case store.PersonBf:
+			req.BfRating = firstNonNil(req.Rating, req.BfRating)
+			req.BfComment = firstNonNil(req.Comment, req.BfComment)
+		case store.PersonGf:
+			req.GfRating = firstNonNil(req.Rating, req.GfRating)
+			req.GfComment = firstNonNil(req.Comment, req.GfComment)
+		default:
+			return badRequest("person required")
+		}
+	}
+	return h.checkRatingOwner(r,
+		req.BfRating != nil || req.BfComment != nil,
+		req.GfRating != nil || req.GfComment != nil,
+	)
+}
+
+// firstNonNil returns the first non-nil pointer.
+func firstNonNil[T any](a, b *T) *T {
+	if a != nil {
+		return a
+	}
+	return b
+}
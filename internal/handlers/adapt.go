@@ -2,12 +2,21 @@ package handlers
 
 import (
 	"errors"
+	"log/slog"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/i18n"
+	"github.com/handsomefox/website-rating/internal/store"
 )
 
+const problemContentType = "application/problem+json"
+
 type HandlerWithErr func(w http.ResponseWriter, r *http.Request) error
 
 type Error struct {
@@ -19,15 +28,92 @@ func (e Error) Error() string {
 	return e.Message + " code=" + strconv.FormatInt(int64(e.Status), 10)
 }
 
+// Adapt turns a handler that returns errors into an http.Handler. *Error
+// values become their status and message, anything else a 500. Server
+// errors are logged with the request's ID, route and session, and the ID is
+// sent back so a user's report can be matched to the log line.
 func Adapt(h HandlerWithErr) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if err := h(w, r); err != nil {
-			var statusErr *Error
-			if errors.As(err, &statusErr) {
-				writeJSON(w, statusErr.Status, &pb.ErrorResponse{Error: statusErr.Message})
-				return
-			}
-			writeJSON(w, http.StatusInternalServerError, &pb.ErrorResponse{Error: err.Error()})
+		err := h(w, r)
+		if err == nil {
+			return
+		}
+		status, message := http.StatusInternalServerError, err.Error()
+		var statusErr *Error
+		if errors.As(err, &statusErr) {
+			status, message = statusErr.Status, statusErr.Message
 		}
+		switch {
+		case status == http.StatusInternalServerError:
+			slog.Error("request failed", append(requestAttrs(r), slog.Any("err", err))...)
+		case status > http.StatusInternalServerError:
+			slog.Warn("request failed", append(requestAttrs(r), slog.Int("status", status), slog.Any("err", err))...)
+		}
+		writeError(w, r, status, message)
 	})
 }
+
+// requestAttrs identifies a request in logs: its ID, method and route
+// pattern, and the session and person making it when known.
+func requestAttrs(r *http.Request) []any {
+	attrs := []any{
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+		slog.String("method", r.Method),
+	}
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		attrs = append(attrs, slog.String("route", rctx.RoutePattern()))
+	}
+	if session, ok := r.Context().Value(sessionKey{}).(store.Session); ok {
+		attrs = append(attrs, slog.Int64("session_id", session.ID))
+	}
+	if person := requestPerson(r); person != "" {
+		attrs = append(attrs, slog.String("person", person))
+	}
+	return attrs
+}
+
+// writeError writes an error body, as RFC 7807 problem details when the client
+// asks for application/problem+json and as pb.ErrorResponse otherwise. Known
+// messages are translated according to Accept-Language.
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	lang := i18n.Match(r.Header.Get("Accept-Language"))
+	if translated := i18n.Translate(lang, message); translated != message {
+		message = translated
+		w.Header().Set("Content-Language", lang)
+	}
+
+	if !wantsProblemJSON(r) {
+		writeJSON(w, status, &pb.ErrorResponse{Error: message, RequestId: middleware.GetReqID(r.Context())})
+		return
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	writeJSONBody(w, status, &pb.ProblemDetails{
+		Type:      problemType(status),
+		Title:     http.StatusText(status),
+		Status:    toInt32(status),
+		Detail:    message,
+		Instance:  r.URL.RequestURI(),
+		RequestId: middleware.GetReqID(r.Context()),
+	})
+}
+
+func wantsProblemJSON(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == problemContentType {
+			return true
+		}
+	}
+	return false
+}
+
+func problemType(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return "about:blank"
+	}
+	slug := strings.ToLower(strings.ReplaceAll(text, " ", "-"))
+	slug = strings.ReplaceAll(slug, "'", "")
+	return "/problems/" + slug
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"sync"
+
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// addGroup coalesces concurrent adds of the same title: callers arriving while
+// an add is in flight wait for it and share its result.
+type addGroup struct {
+	mu       sync.Mutex
+	inflight map[store.TMDBRef]*addCall
+}
+
+type addCall struct {
+	done chan struct{}
+	show store.Show
+	err  error
+}
+
+func (g *addGroup) do(ref store.TMDBRef, fn func() (store.Show, error)) (store.Show, error) {
+	g.mu.Lock()
+	if call, ok := g.inflight[ref]; ok {
+		g.mu.Unlock()
+		<-call.done
+		return call.show, call.err
+	}
+	if g.inflight == nil {
+		g.inflight = make(map[store.TMDBRef]*addCall)
+	}
+	call := &addCall{done: make(chan struct{})}
+	g.inflight[ref] = call
+	g.mu.Unlock()
+
+	call.show, call.err = fn()
+
+	g.mu.Lock()
+	delete(g.inflight, ref)
+	g.mu.Unlock()
+	close(call.done)
+
+	return call.show, call.err
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+// postOptimize runs database maintenance and drops the in-memory TMDB
+// reference caches.
+func (h *Handler) postOptimize(w http.ResponseWriter, r *http.Request) error {
+	res, err := h.store.Optimize(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+	h.resetCaches()
+
+	writeJSON(w, http.StatusOK, &pb.OptimizeResponse{
+		SizeBefore: res.SizeBefore,
+		SizeAfter:  res.SizeAfter,
+	})
+	return nil
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+func TestRequireAuth(t *testing.T) {
+	env := newTestEnv(t)
+	anon := env.newClient()
+
+	anon.expect(http.StatusUnauthorized, http.MethodGet, "/api/shows", nil)
+	anon.expect(http.StatusUnauthorized, http.MethodGet, "/api/search?q=alien", nil)
+	anon.expect(http.StatusUnauthorized, http.MethodPost, "/api/shows", &pb.AddShowRequest{TmdbId: 1, MediaType: "movie"})
+	anon.expect(http.StatusUnauthorized, http.MethodPost, "/api/login", &pb.LoginRequest{Password: "wrong"})
+
+	env.login("").expect(http.StatusOK, http.MethodGet, "/api/shows", nil)
+}
+
+func TestQuickAddAuth(t *testing.T) {
+	env := newTestEnv(t)
+	env.tmdb.addDetail("", tmdb.Detail{MediaType: "movie", TMDBID: 603, Title: "The Matrix"})
+	path := "/api/quick-add?query=" + url.QueryEscape("https://www.themoviedb.org/movie/603")
+
+	// A signed-in browser following a link or posting a form must not add.
+	browser := env.login(store.PersonBf)
+	browser.expect(http.StatusUnauthorized, http.MethodGet, path, nil)
+	browser.expect(http.StatusUnauthorized, http.MethodPost, path, nil)
+	assertNotInLibrary(t, env, 603)
+
+	// The app posts JSON.
+	browser.expect(http.StatusOK, http.MethodPost, "/api/quick-add", &pb.QuickAddRequest{Query: "https://www.themoviedb.org/movie/603"})
+
+	env.tmdb.addDetail("", tmdb.Detail{MediaType: "movie", TMDBID: 604, Title: "The Matrix Reloaded"})
+	script := env.newClient()
+	script.token = testAPIToken
+	script.expect(http.StatusOK, http.MethodGet, "/api/quick-add?query="+url.QueryEscape("https://www.themoviedb.org/movie/604"), nil)
+	if _, err := env.store.GetShowIDByTMDB(context.Background(), 604, "movie"); err != nil {
+		t.Fatalf("token quick-add did not add: %v", err)
+	}
+}
+
+func TestQuickAddReadOnly(t *testing.T) {
+	env := newTestEnv(t, func(cfg *Config) { cfg.ReadOnly = true })
+	env.tmdb.addDetail("", tmdb.Detail{MediaType: "movie", TMDBID: 603, Title: "The Matrix"})
+
+	script := env.newClient()
+	script.token = testAPIToken
+	script.expect(http.StatusForbidden, http.MethodGet, "/api/quick-add?query="+url.QueryEscape("https://www.themoviedb.org/movie/603"), nil)
+	assertNotInLibrary(t, env, 603)
+}
+
+func TestWebhooksNeedToken(t *testing.T) {
+	env := newTestEnv(t)
+
+	browser := env.login(store.PersonBf)
+	browser.expect(http.StatusUnauthorized, http.MethodPost, "/api/webhooks/jellyfin", map[string]string{})
+
+	server := env.newClient()
+	server.token = testAPIToken
+	if status := server.do(http.MethodPost, "/api/webhooks/jellyfin", map[string]string{}, nil); status == http.StatusUnauthorized {
+		t.Fatal("webhook with token: unauthorized")
+	}
+}
+
+func TestRatingOwnership(t *testing.T) {
+	env := newTestEnv(t)
+	bf := env.login(store.PersonBf)
+	show := bf.addMovie(tmdb.Detail{TMDBID: 603, Title: "The Matrix"})
+
+	// Until bf has an account, shared-password sessions rate for both.
+	gf := env.login(store.PersonGf)
+	rating := int32(7)
+	gf.expect(http.StatusOK, http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{BfRating: &rating})
+
+	bf.mustDo(http.MethodPost, "/api/auth/account-password", &pb.ChangePasswordRequest{
+		CurrentPassword: testPassword,
+		NewPassword:     "bf-own-password",
+	}, nil)
+
+	// Setting it signed out every shared-password session.
+	gf.expect(http.StatusUnauthorized, http.MethodGet, "/api/shows", nil)
+
+	gf = env.login(store.PersonGf)
+	gf.expect(http.StatusForbidden, http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{BfRating: &rating})
+	gf.expect(http.StatusForbidden, http.MethodDelete, showPath(show.Id, "ratings/bf"), nil)
+	gf.expect(http.StatusOK, http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{GfRating: &rating})
+
+	// The shared password no longer signs in as bf.
+	env.newClient().expect(http.StatusUnauthorized, http.MethodPost, "/api/login",
+		&pb.LoginRequest{Password: testPassword, Person: store.PersonBf})
+
+	bf.expect(http.StatusOK, http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{BfRating: &rating})
+}
+
+func TestVerifyPassword(t *testing.T) {
+	salt, hash, err := newPasswordHash("correct horse")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if ok, legacy := verifyPassword(salt, "correct horse", hash); !ok || legacy {
+		t.Fatalf("verify = %v %v, want a current match", ok, legacy)
+	}
+	if ok, _ := verifyPassword(salt, "wrong horse", hash); ok {
+		t.Fatal("wrong password verified")
+	}
+
+	old := hashToken(salt + "correct horse")
+	if ok, legacy := verifyPassword(salt, "correct horse", old); !ok || !legacy {
+		t.Fatalf("verify legacy = %v %v, want a legacy match", ok, legacy)
+	}
+}
+
+func assertNotInLibrary(t *testing.T, env *testEnv, tmdbID int64) {
+	t.Helper()
+	if _, err := env.store.GetShowIDByTMDB(context.Background(), tmdbID, "movie"); err == nil {
+		t.Fatalf("tmdb %d was added", tmdbID)
+	}
+}
+
+func TestImportKeepsOtherPersonsRatings(t *testing.T) {
+	env := newTestEnv(t)
+	bf := env.signUp(store.PersonBf, "bf-own-password")
+	gf := env.signUp(store.PersonGf, "gf-own-password")
+
+	show := bf.addMovie(tmdb.Detail{TMDBID: 603, Title: "The Matrix"})
+	rating := int32(9)
+	gf.mustDo(http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{Rating: &rating}, nil)
+
+	bfRating, gfRating, gfComment := int64(7), int64(1), "overwritten"
+	payload := &pb.ExportPayload{Shows: []*pb.Show{{
+		TmdbId:    603,
+		MediaType: "movie",
+		Title:     "The Matrix",
+		Status:    store.StatusWatched,
+		BfRating:  &bfRating,
+		GfRating:  &gfRating,
+		GfComment: &gfComment,
+	}}}
+	bf.mustDo(http.MethodPost, "/api/import?mode=replace", payload, nil)
+
+	stored, err := env.store.GetShow(context.Background(), show.Id)
+	if err != nil {
+		t.Fatalf("get show: %v", err)
+	}
+	if stored.BfRating.V != 7 {
+		t.Fatalf("bf rating = %v, want the imported 7", stored.BfRating)
+	}
+	if stored.GfRating.V != 9 || stored.GfComment.Valid {
+		t.Fatalf("gf rating %v comment %v, want gf's own left alone", stored.GfRating, stored.GfComment)
+	}
+}
+
+func TestReadOnlySessionsStayInMemory(t *testing.T) {
+	env := newTestEnv(t, func(cfg *Config) { cfg.ReadOnly = true })
+
+	env.newClient().expect(http.StatusUnauthorized, http.MethodPost, "/api/login", &pb.LoginRequest{Password: "wrong"})
+	c := env.login(store.PersonBf)
+	c.expect(http.StatusOK, http.MethodGet, "/api/shows", nil)
+
+	var sessions pb.SessionsResponse
+	c.mustDo(http.MethodGet, "/api/sessions", nil, &sessions)
+	if len(sessions.Sessions) != 1 || !sessions.Sessions[0].Current {
+		t.Fatalf("sessions = %v, want the current one", sessions.Sessions)
+	}
+
+	ctx := context.Background()
+	stored, err := env.store.ListSessions(ctx)
+	if err != nil {
+		t.Fatalf("list sessions: %v", err)
+	}
+	if len(stored) != 0 {
+		t.Fatalf("%d sessions written to the database", len(stored))
+	}
+	report, err := env.store.LoginFailures(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("login failures: %v", err)
+	}
+	if report.Total != 0 {
+		t.Fatalf("%d failed sign-ins written to the database", report.Total)
+	}
+
+	c.mustDo(http.MethodPost, "/api/logout", nil, nil)
+	c.expect(http.StatusUnauthorized, http.MethodGet, "/api/shows", nil)
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+// availabilityConcurrency caps parallel watch-provider lookups for one page
+// of search results.
+const availabilityConcurrency = 4
+
+// streamingRegion is where titles' streaming availability is looked up:
+// WATCH_REGION, else the subscriptions region, else "" (no lookups).
+func (h *Handler) streamingRegion(ctx context.Context) string {
+	if h.watchRegion != "" {
+		return h.watchRegion
+	}
+	subs, err := h.store.GetSubscriptions(ctx)
+	if err != nil {
+		slog.Warn("streaming region: subscriptions failed", slog.Any("err", err))
+		return ""
+	}
+	return subs.Region
+}
+
+// titleProviders returns the flat-rate providers a title streams on in
+// region. Lookup failures are logged and leave the title without providers.
+func titleProviders(ctx context.Context, client tmdb.MetadataProvider, id int64, mediaType, region string) []*pb.WatchProvider {
+	providers, err := client.FetchWatchProviders(ctx, id, mediaType, region)
+	if err != nil {
+		slog.Warn("watch providers failed", slog.Int64("tmdb_id", id), slog.String("media_type", mediaType), slog.Any("err", err))
+		return nil
+	}
+	out := make([]*pb.WatchProvider, 0, len(providers))
+	for _, p := range providers {
+		out = append(out, &pb.WatchProvider{Id: toInt32(p.ID), Name: p.Name, LogoPath: p.LogoPath})
+	}
+	return out
+}
+
+// addSearchProviders fills in each result's providers in region, a few
+// lookups at a time, each bounded by searchCallTimeout.
+func addSearchProviders(ctx context.Context, client tmdb.MetadataProvider, region string, results []*pb.SearchResult) {
+	sem := make(chan struct{}, availabilityConcurrency)
+	var wg sync.WaitGroup
+	for _, result := range results {
+		if result.MediaType != "movie" && result.MediaType != "tv" {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			callCtx, cancel := context.WithTimeout(ctx, searchCallTimeout)
+			defer cancel()
+			result.WatchProviders = titleProviders(callCtx, client, result.Id, result.MediaType, region)
+		}()
+	}
+	wg.Wait()
+}
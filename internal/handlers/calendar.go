@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+const (
+	calendarKindRelease = "release"
+	calendarKindEpisode = "episode"
+	calendarKindPlan    = "plan"
+
+	dateLayout  = "2006-01-02"
+	monthLayout = "2006-01"
+)
+
+// getCalendar lists what comes out in a month (?month=YYYY-MM, default the
+// current one): planned titles releasing, TV episodes airing and scheduled
+// movie nights.
+func (h *Handler) getCalendar(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	month := time.Now().UTC()
+	if raw := strings.TrimSpace(r.URL.Query().Get("month")); raw != "" {
+		parsed, err := time.Parse(monthLayout, raw)
+		if err != nil {
+			return badRequest("invalid month")
+		}
+		month = parsed
+	}
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from := start.Format(dateLayout)
+	to := start.AddDate(0, 1, -1).Format(dateLayout)
+
+	shows, err := h.store.ListCalendar(ctx, from, to)
+	if err != nil {
+		return internal(err)
+	}
+
+	inMonth := func(date string) bool { return date >= from && date <= to }
+
+	resp := &pb.CalendarResponse{
+		Month:   start.Format(monthLayout),
+		Entries: make([]*pb.CalendarEntry, 0, len(shows)),
+	}
+	for i := range shows {
+		show := &shows[i]
+		if show.Status == "planned" && show.ReleaseDate.Valid && inMonth(show.ReleaseDate.V) {
+			resp.Entries = append(resp.Entries, &pb.CalendarEntry{
+				Date: show.ReleaseDate.V,
+				Kind: calendarKindRelease,
+				Show: toPBShow(show),
+			})
+		}
+		if show.MediaType == "tv" && show.NextAirDate.Valid && inMonth(show.NextAirDate.V) {
+			resp.Entries = append(resp.Entries, &pb.CalendarEntry{
+				Date: show.NextAirDate.V,
+				Kind: calendarKindEpisode,
+				Show: toPBShow(show),
+			})
+		}
+	}
+
+	plans, err := h.store.ListPlansBetween(ctx, start.Format(time.RFC3339), start.AddDate(0, 1, 0).Format(time.RFC3339))
+	if err != nil {
+		return internal(err)
+	}
+	planned, err := h.toPBPlans(ctx, plans)
+	if err != nil {
+		return internal(err)
+	}
+	for _, plan := range planned {
+		resp.Entries = append(resp.Entries, &pb.CalendarEntry{
+			Date:   plan.ScheduledAt[:len(dateLayout)],
+			Kind:   calendarKindPlan,
+			Show:   plan.Show,
+			PlanId: ptr(plan.Id),
+		})
+	}
+	slices.SortStableFunc(resp.Entries, func(a, b *pb.CalendarEntry) int {
+		return cmp.Compare(a.Date, b.Date)
+	})
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// getUpcoming lists planned titles that are not out yet, soonest first.
+func (h *Handler) getUpcoming(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	shows, err := h.store.ListUpcoming(ctx, today.Format(dateLayout))
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.UpcomingResponse{Items: make([]*pb.UpcomingItem, 0, len(shows))}
+	for i := range shows {
+		show := &shows[i]
+		release, err := time.Parse(dateLayout, show.ReleaseDate.V)
+		if err != nil {
+			continue
+		}
+		resp.Items = append(resp.Items, &pb.UpcomingItem{
+			Show:        toPBShow(show),
+			ReleaseDate: show.ReleaseDate.V,
+			DaysUntil:   toInt32(int(release.Sub(today).Hours() / 24)),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	_ "embed"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+//go:embed console.html
+var consoleHTML []byte
+
+// routeParam matches a chi URL parameter, with or without a regexp.
+var routeParam = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// getConsole serves the interactive API console. It is a single static page
+// that reads /api/openapi.json and sends requests with the session cookie.
+func (h *Handler) getConsole(w http.ResponseWriter, _ *http.Request) error {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	_, err := w.Write(consoleHTML)
+	return err
+}
+
+// getOpenAPI returns an OpenAPI 3 document listing every route mounted on
+// routes. It is generated from the router, so it covers paths, methods and
+// path parameters but not request or response schemas.
+func (h *Handler) getOpenAPI(routes chi.Routes) func(http.ResponseWriter, *http.Request) error {
+	return func(w http.ResponseWriter, _ *http.Request) error {
+		paths := map[string]map[string]any{}
+		err := chi.Walk(routes, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+			route = routeParam.ReplaceAllString(route, "{$1}")
+			if len(route) > 1 {
+				route = strings.TrimSuffix(route, "/")
+			}
+
+			params := []map[string]any{}
+			for _, m := range routeParam.FindAllStringSubmatch(route, -1) {
+				params = append(params, map[string]any{
+					"name":     m[1],
+					"in":       "path",
+					"required": true,
+					"schema":   map[string]string{"type": "string"},
+				})
+			}
+
+			if paths[route] == nil {
+				paths[route] = map[string]any{}
+			}
+			paths[route][strings.ToLower(method)] = map[string]any{
+				"operationId": strings.ToLower(method) + " " + route,
+				"parameters":  params,
+				"responses":   map[string]any{"default": map[string]string{"description": "JSON response"}},
+			}
+			return nil
+		})
+		if err != nil {
+			return internal(err)
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"openapi": "3.0.3",
+			"info":    map[string]string{"title": "paired-ratings API", "version": "1"},
+			"servers": []map[string]string{{"url": "/api"}},
+			"paths":   paths,
+		})
+		return nil
+	}
+}
@@ -1,53 +1,219 @@
 package handlers
 
 import (
+	"context"
+	"crypto/rand"
 	"crypto/subtle"
+	"encoding/hex"
+	"log/slog"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/handsomefox/website-rating/internal/env"
+	"github.com/handsomefox/website-rating/internal/store"
 )
 
 const (
-	authCookieName = "auth"
-	authCookieDays = 90
+	defaultAuthCookieName = "auth"
+	defaultAuthCookieTTL  = 90 * 24 * time.Hour
+	// sessionOnlyTTL is the server-side lifetime of sign-ins whose cookie
+	// ends with the browser session.
+	sessionOnlyTTL   = 24 * time.Hour
+	personCookieName = "person"
 )
 
-func (h *Handler) isAuthenticated(r *http.Request) bool {
-	c, err := r.Cookie(authCookieName)
+// CookieConfig controls the sign-in cookie. Zero values pick the defaults.
+type CookieConfig struct {
+	// Name of the sign-in cookie; "auth" when empty.
+	Name string
+	// TTL is how long a sign-in lasts; 90 days when zero.
+	TTL time.Duration
+	// SessionOnly makes the browser drop the cookie when it closes; the
+	// server keeps the session for a day.
+	SessionOnly bool
+	// SameSite and Secure override the defaults picked from ENV: None and
+	// secure in production, Lax otherwise.
+	SameSite http.SameSite
+	Secure   *bool
+}
+
+func (c CookieConfig) withDefaults() CookieConfig {
+	if strings.TrimSpace(c.Name) == "" {
+		c.Name = defaultAuthCookieName
+	}
+	if c.TTL <= 0 {
+		c.TTL = defaultAuthCookieTTL
+	}
+	if c.SessionOnly {
+		c.TTL = sessionOnlyTTL
+	}
+	if c.SameSite == 0 {
+		c.SameSite = sameSite()
+	}
+	if c.Secure == nil {
+		c.Secure = ptr(secure())
+	}
+	return c
+}
+
+type sessionKey struct{}
+
+// authSession looks up the server-side session named by the auth cookie.
+func (h *Handler) authSession(r *http.Request) (store.Session, bool) {
+	if session, ok := r.Context().Value(sessionKey{}).(store.Session); ok {
+		return session, true
+	}
+	c, err := r.Cookie(h.cookies.Name)
+	if err != nil || c.Value == "" {
+		return store.Session{}, false
+	}
+	if h.readOnly {
+		return h.memSessions.byToken(hashToken(c.Value))
+	}
+	session, err := h.store.GetSessionByToken(r.Context(), hashToken(c.Value))
 	if err != nil {
+		if !isNoRows(err) {
+			slog.Warn("session lookup failed", slog.Any("err", err))
+		}
+		return store.Session{}, false
+	}
+	return session, true
+}
+
+func (h *Handler) isAuthenticated(r *http.Request) bool {
+	_, ok := h.authSession(r)
+	return ok
+}
+
+// withSession stores the request's session for later authSession calls.
+func withSession(ctx context.Context, session store.Session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, session)
+}
+
+// startSession creates a session for the request's device and returns the
+// cookie token for it. Unremembered sessions get a session-only cookie and a
+// short server-side lifetime. account is the person whose own password
+// signed in, or "" for the shared password. Read-only instances keep the
+// session in memory.
+func (h *Handler) startSession(r *http.Request, remember bool, account string) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(raw)
+
+	session := store.Session{
+		TokenHash: hashToken(token),
+		UserAgent: r.UserAgent(),
+		IP:        clientIP(r),
+		Person:    toSQLNullString(account),
+	}
+	ttl := h.cookies.TTL
+	if !remember {
+		ttl = sessionOnlyTTL
+	}
+	if h.readOnly {
+		h.memSessions.create(&session, ttl)
+		return token, nil
+	}
+	if err := h.store.CreateSession(r.Context(), &session, ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// remembers reports whether a sign-in gets a persistent cookie.
+func (h *Handler) remembers(remember *bool) bool {
+	return !h.cookies.SessionOnly && (remember == nil || *remember)
+}
+
+// clientIP is the request's remote address without the port.
+// MiddlewareRealIP may already have replaced it with the bare address a
+// trusted proxy forwarded.
+func clientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// hasAPIToken reports whether the request carries the configured API token,
+// either as a bearer token or a "token" query parameter.
+func (h *Handler) hasAPIToken(r *http.Request) bool {
+	if h.apiToken == "" {
 		return false
 	}
-	if c.Value == "" || h.passHash == "" {
+	token := strings.TrimSpace(r.URL.Query().Get("token"))
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if bearer, ok := strings.CutPrefix(auth, "Bearer "); ok {
+			token = strings.TrimSpace(bearer)
+		}
+	}
+	if token == "" {
 		return false
 	}
-	return subtle.ConstantTimeCompare([]byte(c.Value), []byte(h.passHash)) == 1
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.apiToken)) == 1
 }
 
-func setAuthCookie(w http.ResponseWriter, r *http.Request, value string) {
-	expiration := time.Now().Add(time.Hour * 24 * authCookieDays)
-	http.SetCookie(w, &http.Cookie{
-		Name:     authCookieName,
+// newCookie fills in the attributes shared by the app's cookies. A zero
+// maxAge makes a session-only cookie and a negative one deletes it.
+func (h *Handler) newCookie(name, value string, maxAge time.Duration) *http.Cookie {
+	c := &http.Cookie{
+		Name:     name,
 		Value:    value,
 		Path:     "/",
-		Expires:  expiration,
-		MaxAge:   int((time.Hour * 24 * authCookieDays).Seconds()),
 		HttpOnly: true,
-		SameSite: sameSite(),
-		Secure:   secure(),
-	})
+		SameSite: h.cookies.SameSite,
+		Secure:   *h.cookies.Secure,
+	}
+	switch {
+	case maxAge < 0:
+		c.MaxAge = -1
+	case maxAge > 0:
+		c.Expires = time.Now().Add(maxAge)
+		c.MaxAge = int(maxAge.Seconds())
+	}
+	return c
 }
 
-func clearAuthCookie(w http.ResponseWriter, r *http.Request) {
-	http.SetCookie(w, &http.Cookie{
-		Name:     authCookieName,
-		Value:    "",
-		Path:     "/",
-		MaxAge:   -1,
-		HttpOnly: true,
-		SameSite: sameSite(),
-		Secure:   secure(),
-	})
+// cookieMaxAge is the lifetime of persistent cookies, or zero when they
+// should end with the browser session.
+func (h *Handler) cookieMaxAge(persistent bool) time.Duration {
+	if !persistent {
+		return 0
+	}
+	return h.cookies.TTL
+}
+
+func (h *Handler) setAuthCookie(w http.ResponseWriter, value string, persistent bool) {
+	http.SetCookie(w, h.newCookie(h.cookies.Name, value, h.cookieMaxAge(persistent)))
+}
+
+func (h *Handler) clearAuthCookie(w http.ResponseWriter) {
+	http.SetCookie(w, h.newCookie(h.cookies.Name, "", -1))
+}
+
+// requestPerson returns which person ("bf"/"gf") is using this session, or ""
+// when none was picked. Account sessions are always their account's person.
+func requestPerson(r *http.Request) string {
+	if person := accountPerson(r); person != "" {
+		return person
+	}
+	c, err := r.Cookie(personCookieName)
+	if err != nil || !store.ValidPerson(c.Value) {
+		return ""
+	}
+	return c.Value
+}
+
+func (h *Handler) setPersonCookie(w http.ResponseWriter, person string) {
+	http.SetCookie(w, h.newCookie(personCookieName, person, h.cookieMaxAge(!h.cookies.SessionOnly)))
+}
+
+func (h *Handler) clearPersonCookie(w http.ResponseWriter) {
+	http.SetCookie(w, h.newCookie(personCookieName, "", -1))
 }
 
 func sameSite() http.SameSite {
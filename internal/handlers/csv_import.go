@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+const (
+	maxCSVImportRows = 1000
+	// csvReviewCandidates is how many matches an ambiguous row offers.
+	csvReviewCandidates = 3
+)
+
+// csvTemplateColumns is the spreadsheet template's header. Only title is
+// required; tmdb_id (with media_type) skips the title search.
+var csvTemplateColumns = []string{
+	"title", "year", "media_type", "tmdb_id", "status",
+	"bf_rating", "gf_rating", "bf_comment", "gf_comment",
+}
+
+var csvTemplateExample = [][]string{
+	{"Arrival", "2016", "", "", "watched", "9", "8", "", "the ending!"},
+	{"Severance", "", "tv", "", "planned", "", "", "", ""},
+}
+
+// csvImportRow is one validated spreadsheet row.
+type csvImportRow struct {
+	line      int
+	title     string
+	year      string
+	mediaType string
+	tmdbID    int64
+	status    string
+	bfRating  *int64
+	gfRating  *int64
+	bfComment string
+	gfComment string
+}
+
+// getImportCSVTemplate serves an empty spreadsheet template with two example
+// rows.
+func (h *Handler) getImportCSVTemplate(w http.ResponseWriter, _ *http.Request) error {
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	if err := cw.Write(csvTemplateColumns); err != nil {
+		return internal(err)
+	}
+	if err := cw.WriteAll(csvTemplateExample); err != nil {
+		return internal(err)
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename=ratings-template.csv")
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		slog.Warn("csv template write failed", slog.Any("err", err))
+	}
+	return nil
+}
+
+// postImportCSV imports a ratings spreadsheet in the template's format.
+// Titles are resolved through TMDB search like quick-add; rows with more
+// than one plausible match are queued as pending imports and come back under
+// review instead of being guessed.
+// Ratings and comments only fill in what the library is missing, and never
+// for someone the session may not rate for.
+func (h *Handler) postImportCSV(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportBytes))
+	if err != nil {
+		return badRequest("bad request")
+	}
+	rows, rowErrs, err := parseImportCSV(data)
+	if err != nil {
+		return err
+	}
+
+	resp := &pb.CSVImportResponse{
+		Skipped: toInt32(len(rowErrs)),
+		Review:  []*pb.CSVImportReview{},
+		Errors:  rowErrs,
+	}
+	skip := func(row *csvImportRow, msg string) {
+		resp.Skipped++
+		resp.Errors = append(resp.Errors, fmt.Sprintf("line %d: %s", row.line, msg))
+	}
+
+	locked, err := h.lockedPeople(r)
+	if err != nil {
+		return err
+	}
+
+	client := h.metadataClient(r)
+	shows := make([]store.Show, 0, len(rows))
+	var watched []int64
+	var pending []store.PendingImport
+	for i := range rows {
+		row := &rows[i]
+
+		tmdbID, mediaType := row.tmdbID, row.mediaType
+		if tmdbID == 0 {
+			query := strings.TrimSpace(row.title + " " + row.year)
+			candidates, err := h.quickAddCandidates(ctx, client, query, row.mediaType)
+			if err != nil {
+				var apiErr *Error
+				if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+					skip(row, "no TMDB match for "+strconv.Quote(row.title))
+					continue
+				}
+				return err
+			}
+			if len(candidates) != 1 || !candidates[0].confident {
+				pending = append(pending, pendingFromCSVRow(row, candidates))
+				continue
+			}
+			tmdbID, mediaType = candidates[0].ID, candidates[0].MediaType
+		}
+
+		// Added as planned and marked watched once the ratings are in, so
+		// the watched-ratings rule sees them.
+		stored, err := h.addShow(ctx, tmdbID, mediaType, store.StatusPlanned)
+		if err != nil {
+			skip(row, err.Error())
+			continue
+		}
+		stored.BfRating = toSQLNull(row.bfRating)
+		stored.GfRating = toSQLNull(row.gfRating)
+		stored.BfComment = toSQLNullString(row.bfComment)
+		stored.GfComment = toSQLNullString(row.gfComment)
+		shows = append(shows, stored)
+		if row.status == store.StatusWatched && stored.Status != store.StatusWatched {
+			watched = append(watched, stored.ID)
+		}
+	}
+
+	if _, err := h.store.ImportShows(ctx, shows, false, locked...); err != nil {
+		return internal(err)
+	}
+	for _, id := range watched {
+		if err := h.markImportedWatched(ctx, id); err != nil {
+			return internal(err)
+		}
+	}
+	resp.Imported = toInt32(len(shows))
+
+	if err := h.store.AddPendingImports(ctx, pending); err != nil {
+		return internal(err)
+	}
+	for i := range pending {
+		results, err := h.toPBPendingCandidates(r, pending[i].Candidates)
+		if err != nil {
+			return err
+		}
+		resp.Review = append(resp.Review, &pb.CSVImportReview{
+			Id:         pending[i].ID,
+			Line:       toInt32(pending[i].Line),
+			Title:      pending[i].Title,
+			Year:       pending[i].Year,
+			Candidates: results,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// markImportedWatched moves an imported show to watched. Under the "block"
+// watched-ratings rule a show someone hasn't rated stays planned.
+func (h *Handler) markImportedWatched(ctx context.Context, id int64) error {
+	err := h.store.UpdateStatus(ctx, id, store.StatusWatched)
+	if errors.Is(err, store.ErrRatingsRequired) {
+		return nil
+	}
+	return err
+}
+
+func pendingFromCSVRow(row *csvImportRow, candidates []quickAddCandidate) store.PendingImport {
+	suggested := make([]store.PendingCandidate, 0, csvReviewCandidates)
+	for _, c := range candidates[:min(len(candidates), csvReviewCandidates)] {
+		suggested = append(suggested, store.PendingCandidate(c.SearchResult))
+	}
+	return store.PendingImport{
+		Source:     "csv",
+		Line:       row.line,
+		Title:      row.title,
+		Year:       row.year,
+		MediaType:  row.mediaType,
+		Status:     row.status,
+		BfRating:   toSQLNull(row.bfRating),
+		GfRating:   toSQLNull(row.gfRating),
+		BfComment:  toSQLNullString(row.bfComment),
+		GfComment:  toSQLNullString(row.gfComment),
+		Candidates: suggested,
+	}
+}
+
+// parseImportCSV reads the header and validates every row. Invalid rows are
+// reported as messages; a missing title column or an unreadable file fails
+// the whole import.
+func parseImportCSV(data []byte) ([]csvImportRow, []string, error) {
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, badRequest("invalid csv")
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := cols["title"]; !ok {
+		return nil, nil, badRequest("title column required")
+	}
+
+	var rows []csvImportRow
+	errs := []string{}
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, badRequest("invalid csv")
+		}
+		if len(rows)+len(errs) >= maxCSVImportRows {
+			return nil, nil, badRequest("too many rows")
+		}
+
+		field := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		row, err := parseImportCSVRow(line, field)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, errs, nil
+}
+
+func parseImportCSVRow(line int, field func(string) string) (csvImportRow, error) {
+	row := csvImportRow{
+		line:      line,
+		title:     field("title"),
+		year:      field("year"),
+		mediaType: strings.ToLower(field("media_type")),
+		status:    strings.ToLower(field("status")),
+		bfComment: field("bf_comment"),
+		gfComment: field("gf_comment"),
+	}
+	if row.title == "" {
+		return row, errors.New("missing title")
+	}
+	if row.year != "" {
+		if y, err := strconv.Atoi(row.year); err != nil || y < 1870 || y > 2200 {
+			return row, fmt.Errorf("invalid year %q", row.year)
+		}
+	}
+	if row.mediaType != "" && row.mediaType != "movie" && row.mediaType != "tv" {
+		return row, fmt.Errorf("invalid media_type %q", row.mediaType)
+	}
+	if raw := field("tmdb_id"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || id <= 0 {
+			return row, fmt.Errorf("invalid tmdb_id %q", raw)
+		}
+		if row.mediaType == "" {
+			return row, errors.New("tmdb_id needs media_type")
+		}
+		row.tmdbID = id
+	}
+
+	var err error
+	if row.bfRating, err = parseCSVRating(field("bf_rating")); err != nil {
+		return row, err
+	}
+	if row.gfRating, err = parseCSVRating(field("gf_rating")); err != nil {
+		return row, err
+	}
+
+	switch row.status {
+	case "":
+		row.status = store.StatusPlanned
+		if row.bfRating != nil || row.gfRating != nil {
+			row.status = store.StatusWatched
+		}
+	case store.StatusPlanned, store.StatusWatched:
+	default:
+		return row, fmt.Errorf("invalid status %q", row.status)
+	}
+	return row, nil
+}
+
+// parseCSVRating accepts 1-10, rounding spreadsheet decimals such as 7.5.
+// Blank and 0 mean unrated.
+func parseCSVRating(raw string) (*int64, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	val, err := strconv.ParseFloat(strings.ReplaceAll(raw, ",", "."), 64)
+	if err != nil || val < 0 || val > 10 {
+		return nil, fmt.Errorf("invalid rating %q", raw)
+	}
+	rating := int64(math.Round(val))
+	if rating == 0 {
+		return nil, nil
+	}
+	return &rating, nil
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// getLibraryDiff summarizes what changed in the library between ?since and
+// ?until: shows added and deleted, and shows whose ratings moved. since
+// defaults to the start of the current month and until to now; a date-only
+// until includes that whole day. History starts when the change log was
+// added, so earlier periods come back empty.
+func (h *Handler) getLibraryDiff(w http.ResponseWriter, r *http.Request) error {
+	now := time.Now().UTC()
+	query := r.URL.Query()
+
+	since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if raw := strings.TrimSpace(query.Get("since")); raw != "" {
+		t, _, ok := parseDiffTime(raw)
+		if !ok {
+			return badRequest("invalid since")
+		}
+		since = t
+	}
+	until := now
+	if raw := strings.TrimSpace(query.Get("until")); raw != "" {
+		t, dateOnly, ok := parseDiffTime(raw)
+		if !ok {
+			return badRequest("invalid until")
+		}
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		until = t
+	}
+	if !until.After(since) {
+		return badRequest("invalid until")
+	}
+
+	diff, err := h.store.LibraryDiff(r.Context(), since.Format(time.RFC3339), until.Format(time.RFC3339))
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, &pb.LibraryDiffResponse{
+		Since:    since.Format(time.RFC3339),
+		Until:    until.Format(time.RFC3339),
+		Added:    toPBLibraryChanges(diff.Added),
+		Deleted:  toPBLibraryChanges(diff.Deleted),
+		Rated:    toPBLibraryChanges(diff.Rated),
+		Remapped: toPBLibraryChanges(diff.Remapped),
+	})
+	return nil
+}
+
+// parseDiffTime accepts RFC 3339 or YYYY-MM-DD, reporting which it got.
+func parseDiffTime(raw string) (t time.Time, dateOnly, ok bool) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t.UTC(), false, true
+	}
+	if t, err := time.Parse(dateLayout, raw); err == nil {
+		return t, true, true
+	}
+	return time.Time{}, false, false
+}
+
+func toPBLibraryChanges(events []store.LibraryEvent) []*pb.LibraryChange {
+	out := make([]*pb.LibraryChange, 0, len(events))
+	for _, event := range events {
+		change := &pb.LibraryChange{
+			ShowId:      event.ShowID,
+			TmdbId:      event.TMDBID,
+			MediaType:   event.MediaType,
+			Title:       event.Title,
+			OldBfRating: fromSQLNull(event.OldBfRating),
+			OldGfRating: fromSQLNull(event.OldGfRating),
+			NewBfRating: fromSQLNull(event.NewBfRating),
+			NewGfRating: fromSQLNull(event.NewGfRating),
+			ChangedAt:   event.CreatedAt,
+			OldTmdbId:   fromSQLNull(event.OldTMDBID),
+		}
+		if event.Kind == store.EventRemapped || event.Kind == store.EventRemapSkipped {
+			change.Kind = event.Kind
+		}
+		out = append(out, change)
+	}
+	return out
+}
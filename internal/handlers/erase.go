@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+// erasePhrase must be typed exactly to confirm an erase.
+const erasePhrase = "erase everything"
+
+// postErase wipes all data after checking the password again and the typed
+// confirmation phrase. Sessions, personal accounts and a password changed
+// from the app go with it, so every device is signed out and APP_PASSWORD
+// applies again.
+func (h *Handler) postErase(w http.ResponseWriter, r *http.Request) error {
+	var req pb.EraseRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	ok, _, err := h.checkLogin(r.Context(), accountPerson(r), req.Password)
+	if err != nil {
+		return internal(err)
+	}
+	if !ok {
+		return unauthorized("invalid password")
+	}
+	if req.Confirm != erasePhrase {
+		return badRequest("confirmation mismatch")
+	}
+
+	if err := h.store.EraseAll(r.Context()); err != nil {
+		return internal(err)
+	}
+	h.resetCaches()
+	slog.Warn("erase: all data deleted", slog.String("remote", r.RemoteAddr))
+
+	h.clearAuthCookie(w)
+	h.clearPersonCookie(w)
+	writeJSON(w, http.StatusOK, h.sessionResponse(false))
+	return nil
+}
+
+func (h *Handler) resetCaches() {
+	h.genres.mu.Lock()
+	h.genres.byLang = nil
+	h.genres.mu.Unlock()
+
+	h.countries.mu.Lock()
+	h.countries.byLang = nil
+	h.countries.mu.Unlock()
+
+	h.languages.mu.Lock()
+	h.languages.items = nil
+	h.languages.fetchedAt = time.Time{}
+	h.languages.mu.Unlock()
+}
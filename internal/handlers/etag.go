@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// etagCache holds content-hash ETags for the frontend build. Entries are
+// computed up front and recomputed when a file's size or mod time changes,
+// which only happens when serving from STATIC_DIR.
+type etagCache struct {
+	fsys   fs.FS
+	mu     sync.Mutex
+	byName map[string]etagEntry
+}
+
+type etagEntry struct {
+	size    int64
+	modTime time.Time
+	tag     string
+}
+
+func newETagCache(fsys fs.FS) *etagCache {
+	c := &etagCache{fsys: fsys, byName: make(map[string]etagEntry)}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		c.tag(name, info)
+		return nil
+	})
+	if err != nil {
+		slog.Warn("static: failed to hash frontend build", slog.Any("err", err))
+	}
+	return c
+}
+
+// tag returns the quoted ETag for name, or "" when the file cannot be read.
+func (c *etagCache) tag(name string, info fs.FileInfo) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.byName[name]; ok && e.size == info.Size() && e.modTime.Equal(info.ModTime()) {
+		return e.tag
+	}
+	data, err := fs.ReadFile(c.fsys, name)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	tag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	c.byName[name] = etagEntry{size: info.Size(), modTime: info.ModTime(), tag: tag}
+	return tag
+}
+
+// variantETag derives the ETag of an encoded representation, since a gzip
+// body must not validate against the identity one.
+func variantETag(tag, encoding string) string {
+	if tag == "" {
+		return ""
+	}
+	return tag[:len(tag)-1] + "-" + encoding + `"`
+}
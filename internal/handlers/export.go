@@ -0,0 +1,318 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/handsomefox/website-rating/internal/backup"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+var letterboxdHeader = []string{"tmdbID", "Title", "Year", "Rating10", "WatchedDate", "Review"}
+
+// anonymizeShows strips personal notes for exports meant to be shared.
+// Ratings stay; exports only ever label people by role (bf/gf), never by
+// their configured names.
+func anonymizeShows(shows []store.Show) {
+	for i := range shows {
+		shows[i].BfComment = sql.Null[string]{}
+		shows[i].GfComment = sql.Null[string]{}
+	}
+}
+
+// exportArtifact is a finished export file, before optional encryption.
+type exportArtifact struct {
+	body        []byte
+	filename    string
+	contentType string
+}
+
+// writeExport sends an export as a download, encrypted with the backup
+// passphrase when one is configured.
+func (h *Handler) writeExport(w http.ResponseWriter, artifact exportArtifact) error {
+	if h.backupPassphrase != "" {
+		encrypted, err := backup.Encrypt(artifact.body, h.backupPassphrase)
+		if err != nil {
+			return internal(err)
+		}
+		artifact = exportArtifact{
+			body:        encrypted,
+			filename:    artifact.filename + backup.Extension,
+			contentType: "application/octet-stream",
+		}
+	}
+
+	w.Header().Set("Content-Type", artifact.contentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+artifact.filename)
+	if _, err := w.Write(artifact.body); err != nil {
+		slog.Warn("export write failed", slog.Any("err", err))
+	}
+	return nil
+}
+
+// personExport builds one person's export file, or a zip with one file per
+// person when person is empty.
+func personExport(
+	shows []store.Show,
+	person, name, ext, contentType string,
+	write func(io.Writer, []store.Show, string) error,
+) (exportArtifact, error) {
+	if person != "" {
+		if !store.ValidPerson(person) {
+			return exportArtifact{}, badRequest("invalid person")
+		}
+		var buf bytes.Buffer
+		if err := write(&buf, shows, person); err != nil {
+			return exportArtifact{}, internal(err)
+		}
+		return exportArtifact{
+			body:        buf.Bytes(),
+			filename:    name + "-" + person + ext,
+			contentType: contentType,
+		}, nil
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	manifest := backup.ArchiveManifest{SchemaVersion: backup.SchemaVersion}
+	for _, p := range []string{store.PersonBf, store.PersonGf} {
+		var file bytes.Buffer
+		if err := write(&file, shows, p); err != nil {
+			return exportArtifact{}, internal(err)
+		}
+		filename := name + "-" + p + ext
+		if err := writeZipFile(zw, filename, file.Bytes()); err != nil {
+			return exportArtifact{}, internal(err)
+		}
+		manifest.Files = append(manifest.Files, backup.ArchiveFile{
+			Name:   filename,
+			Size:   file.Len(),
+			SHA256: backup.Checksum(file.Bytes()),
+		})
+	}
+	raw, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return exportArtifact{}, internal(err)
+	}
+	if err := writeZipFile(zw, backup.ManifestName, raw); err != nil {
+		return exportArtifact{}, internal(err)
+	}
+	if err := zw.Close(); err != nil {
+		return exportArtifact{}, internal(err)
+	}
+	return exportArtifact{
+		body:        buf.Bytes(),
+		filename:    name + ".zip",
+		contentType: "application/zip",
+	}, nil
+}
+
+func writeZipFile(zw *zip.Writer, name string, body []byte) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(body)
+	return err
+}
+
+// watchedAt falls back to the last update for shows marked watched before
+// watch dates were recorded.
+func watchedAt(show *store.Show) string {
+	if show.WatchedAt.Valid {
+		return show.WatchedAt.V
+	}
+	return show.UpdatedAt
+}
+
+// personRating picks one person's rating and comment.
+func personRating(show *store.Show, person string) (sql.Null[int64], sql.Null[string]) {
+	if person == store.PersonGf {
+		return show.GfRating, show.GfComment
+	}
+	return show.BfRating, show.BfComment
+}
+
+// writeLetterboxdCSV writes a Letterboxd import CSV. Letterboxd only knows
+// films, so TV shows are left out.
+func writeLetterboxdCSV(out io.Writer, shows []store.Show, person string) error {
+	cw := csv.NewWriter(out)
+	if err := cw.Write(letterboxdHeader); err != nil {
+		return err
+	}
+
+	for i := range shows {
+		show := &shows[i]
+		if show.MediaType != "movie" || show.Status != "watched" {
+			continue
+		}
+
+		rating, comment := personRating(show, person)
+		if !rating.Valid && !comment.Valid {
+			continue
+		}
+
+		year := ""
+		if show.Year.Valid {
+			year = strconv.FormatInt(show.Year.V, 10)
+		}
+		ratingCol := ""
+		if rating.Valid {
+			ratingCol = strconv.FormatInt(rating.V, 10)
+		}
+		watched := watchedAt(show)
+		if len(watched) >= len(dateLayout) {
+			watched = watched[:len(dateLayout)]
+		}
+
+		if err := cw.Write([]string{
+			strconv.FormatInt(show.TMDBID, 10),
+			show.Title,
+			year,
+			ratingCol,
+			watched,
+			comment.V,
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvExportColumns extend the import template's, so the file can be
+// imported again.
+var csvExportColumns = append(slices.Clone(csvTemplateColumns),
+	"imdb_id", "genres", "tmdb_rating", "couple_score", "picked_by", "watched_at", "added_at",
+)
+
+// csvExport writes the whole library as a spreadsheet, one row per show with
+// both people's ratings and comments.
+func csvExport(shows []store.Show) (exportArtifact, error) {
+	var buf bytes.Buffer
+	buf.WriteString("\ufeff") // Excel needs the BOM to read UTF-8.
+	cw := csv.NewWriter(&buf)
+	if err := cw.Write(csvExportColumns); err != nil {
+		return exportArtifact{}, internal(err)
+	}
+
+	for i := range shows {
+		show := &shows[i]
+		watched := ""
+		if show.Status == store.StatusWatched {
+			watched = show.WatchedAt.V
+		}
+		if err := cw.Write([]string{
+			show.Title,
+			csvInt(show.Year),
+			show.MediaType,
+			strconv.FormatInt(show.TMDBID, 10),
+			show.Status,
+			csvInt(show.BfRating),
+			csvInt(show.GfRating),
+			show.BfComment.V,
+			show.GfComment.V,
+			show.IMDbID.V,
+			show.Genres.V,
+			csvFloat(show.TMDBRating),
+			csvFloat(show.CoupleScore),
+			show.PickedBy.V,
+			watched,
+			show.CreatedAt,
+		}); err != nil {
+			return exportArtifact{}, internal(err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return exportArtifact{}, internal(err)
+	}
+	return exportArtifact{
+		body:        buf.Bytes(),
+		filename:    "show-ratings.csv",
+		contentType: "text/csv; charset=utf-8",
+	}, nil
+}
+
+func csvInt(v sql.Null[int64]) string {
+	if !v.Valid {
+		return ""
+	}
+	return strconv.FormatInt(v.V, 10)
+}
+
+func csvFloat(v sql.Null[float64]) string {
+	if !v.Valid {
+		return ""
+	}
+	return strconv.FormatFloat(v.V, 'f', -1, 64)
+}
+
+// Trakt's JSON import format. Trakt IDs are unknown here; Trakt matches on
+// the TMDB/IMDb IDs.
+type traktIDs struct {
+	TMDB int64  `json:"tmdb"`
+	IMDb string `json:"imdb,omitempty"`
+	TVDB int64  `json:"tvdb,omitempty"`
+}
+
+type traktItem struct {
+	Title     string   `json:"title"`
+	Year      int64    `json:"year,omitempty"`
+	IDs       traktIDs `json:"ids"`
+	Rating    int64    `json:"rating,omitempty"`
+	RatedAt   string   `json:"rated_at,omitempty"`
+	WatchedAt string   `json:"watched_at,omitempty"`
+}
+
+type traktExport struct {
+	Movies []traktItem `json:"movies"`
+	Shows  []traktItem `json:"shows"`
+}
+
+// writeTraktJSON writes one person's watched history and ratings in Trakt's
+// import format.
+func writeTraktJSON(out io.Writer, shows []store.Show, person string) error {
+	export := traktExport{Movies: []traktItem{}, Shows: []traktItem{}}
+	for i := range shows {
+		show := &shows[i]
+		if show.Status != "watched" {
+			continue
+		}
+
+		item := traktItem{
+			Title: show.Title,
+			Year:  show.Year.V,
+			IDs: traktIDs{
+				TMDB: show.TMDBID,
+				IMDb: show.IMDbID.V,
+				TVDB: show.TVDBID.V,
+			},
+			WatchedAt: watchedAt(show),
+		}
+		if rating, _ := personRating(show, person); rating.Valid {
+			item.Rating = rating.V
+			item.RatedAt = show.UpdatedAt
+		}
+
+		if show.MediaType == "movie" {
+			export.Movies = append(export.Movies, item)
+		} else {
+			export.Shows = append(export.Shows, item)
+		}
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(export)
+}
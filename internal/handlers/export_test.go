@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/handsomefox/website-rating/internal/backup"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+func TestEmptyExportImports(t *testing.T) {
+	artifact, err := jsonExport(nil)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if err := backup.Verify(artifact.body); err != nil {
+		t.Fatalf("verify empty export: %v", err)
+	}
+
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+	var resp pb.ImportResponse
+	c.mustDo(http.MethodPost, "/api/import", json.RawMessage(artifact.body), &resp)
+	if resp.Created != 0 || resp.Skipped != 0 {
+		t.Fatalf("import = %+v, want nothing", &resp)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/ratings"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// ratingProviders returns the configured ratings providers; TMDB's own vote
+// average is always included.
+func ratingProviders(cfg *Config) []ratings.Provider {
+	providers := []ratings.Provider{ratings.TMDB{}}
+	if cfg.OMDb != nil {
+		providers = append(providers, ratings.OMDb{Client: cfg.OMDb})
+	}
+	return providers
+}
+
+// refreshExternalRatings asks every ratings provider about a show after a
+// TMDB write. Failures are only logged: the provider's older ratings stay,
+// with their older fetched_at, and the TMDB refresh still succeeds.
+func (h *Handler) refreshExternalRatings(ctx context.Context, show *store.Show) {
+	for _, provider := range h.ratingProviders {
+		found, err := provider.Ratings(ctx, show)
+		if err != nil {
+			slog.Warn("ratings provider failed",
+				slog.String("provider", provider.Name()),
+				slog.Int64("show_id", show.ID),
+				slog.Any("err", err))
+			continue
+		}
+		if err := h.store.SetExternalRatings(ctx, show.ID, provider.Name(), found); err != nil {
+			slog.Warn("ratings store failed",
+				slog.String("provider", provider.Name()),
+				slog.Int64("show_id", show.ID),
+				slog.Any("err", err))
+		}
+	}
+}
+
+func toPBExternalRatings(ratings []store.ExternalRating) []*pb.ExternalRating {
+	out := make([]*pb.ExternalRating, 0, len(ratings))
+	for _, r := range ratings {
+		out = append(out, &pb.ExternalRating{
+			Source:    r.Source,
+			Value:     r.Value,
+			Max:       r.Max,
+			Votes:     r.Votes,
+			FetchedAt: r.FetchedAt,
+		})
+	}
+	return out
+}
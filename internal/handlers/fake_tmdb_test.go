@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+// fakeTMDB is an in-memory tmdb.MetadataProvider. Details are keyed by media
+// type and ID; an entry missing from details answers tmdb.ErrNotFound, like
+// a title TMDB has merged away. It records the calls tests assert on.
+type fakeTMDB struct {
+	language string
+	state    *fakeTMDBState
+}
+
+type fakeTMDBState struct {
+	mu sync.Mutex
+	// details holds each title per language; "" is the default language.
+	details map[fakeKey]map[string]tmdb.Detail
+	// search answers text searches, by media type ("all" for multi-search).
+	search map[string][]tmdb.SearchResult
+	// discover answers discover calls, by media type.
+	discover map[string][]tmdb.SearchResult
+	// imdb answers FindByIMDbID.
+	imdb map[string][]tmdb.SearchResult
+
+	discoverCalls []tmdb.DiscoverFilters
+	detailCalls   []fakeDetailCall
+}
+
+type fakeKey struct {
+	mediaType string
+	id        int64
+}
+
+type fakeDetailCall struct {
+	key      fakeKey
+	language string
+}
+
+var _ tmdb.MetadataProvider = (*fakeTMDB)(nil)
+
+func newFakeTMDB() *fakeTMDB {
+	return &fakeTMDB{state: &fakeTMDBState{
+		details:  map[fakeKey]map[string]tmdb.Detail{},
+		search:   map[string][]tmdb.SearchResult{},
+		discover: map[string][]tmdb.SearchResult{},
+		imdb:     map[string][]tmdb.SearchResult{},
+	}}
+}
+
+// addDetail registers a title in language ("" for the default).
+func (f *fakeTMDB) addDetail(language string, detail tmdb.Detail) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	key := fakeKey{mediaType: detail.MediaType, id: detail.TMDBID}
+	if f.state.details[key] == nil {
+		f.state.details[key] = map[string]tmdb.Detail{}
+	}
+	f.state.details[key][language] = detail
+}
+
+// removeDetail makes TMDB forget a title, as when it is merged into another.
+func (f *fakeTMDB) removeDetail(mediaType string, id int64) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	delete(f.state.details, fakeKey{mediaType: mediaType, id: id})
+}
+
+func (f *fakeTMDB) setSearch(mediaType string, results ...tmdb.SearchResult) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.search[mediaType] = results
+}
+
+func (f *fakeTMDB) setDiscover(mediaType string, results ...tmdb.SearchResult) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.discover[mediaType] = results
+}
+
+func (f *fakeTMDB) setIMDb(imdbID string, results ...tmdb.SearchResult) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.imdb[imdbID] = results
+}
+
+func (f *fakeTMDB) discoverFilters() []tmdb.DiscoverFilters {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	return append([]tmdb.DiscoverFilters(nil), f.state.discoverCalls...)
+}
+
+func (f *fakeTMDB) detailLanguages() []string {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	out := make([]string, 0, len(f.state.detailCalls))
+	for _, call := range f.state.detailCalls {
+		out = append(out, call.language)
+	}
+	return out
+}
+
+func (f *fakeTMDB) WithLanguage(language string) tmdb.MetadataProvider {
+	return &fakeTMDB{language: strings.TrimSpace(language), state: f.state}
+}
+
+func (f *fakeTMDB) Language() string {
+	return f.language
+}
+
+func (f *fakeTMDB) SearchPage(_ context.Context, query, mediaType string, page int) (tmdb.SearchPage, error) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	return fakePage(f.state.search[mediaType], page), nil
+}
+
+func (f *fakeTMDB) MultiSearchPage(_ context.Context, query string, page int) (tmdb.SearchPage, error) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	return fakePage(f.state.search["all"], page), nil
+}
+
+func (f *fakeTMDB) DiscoverPage(_ context.Context, mediaType string, filters tmdb.DiscoverFilters, page int) (tmdb.SearchPage, error) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.discoverCalls = append(f.state.discoverCalls, filters)
+	return fakePage(f.state.discover[mediaType], page), nil
+}
+
+func (f *fakeTMDB) FindByIMDbID(_ context.Context, imdbID string) ([]tmdb.SearchResult, error) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	return f.state.imdb[imdbID], nil
+}
+
+func (f *fakeTMDB) SearchKeywords(context.Context, string) ([]tmdb.Keyword, error) {
+	return nil, nil
+}
+
+func (f *fakeTMDB) FetchRecommendations(context.Context, int64, string) (tmdb.SearchPage, error) {
+	return tmdb.SearchPage{Page: 1, TotalPages: 1}, nil
+}
+
+func (f *fakeTMDB) FetchDetails(_ context.Context, id int64, mediaType string) (*tmdb.Detail, error) {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	key := fakeKey{mediaType: mediaType, id: id}
+	f.state.detailCalls = append(f.state.detailCalls, fakeDetailCall{key: key, language: f.language})
+	byLang, ok := f.state.details[key]
+	if !ok {
+		return nil, tmdb.ErrNotFound
+	}
+	detail, ok := byLang[f.language]
+	if !ok {
+		detail = byLang[""]
+	}
+	return &detail, nil
+}
+
+func (f *fakeTMDB) FetchGenres(context.Context, string) ([]tmdb.Genre, error) {
+	return nil, nil
+}
+
+func (f *fakeTMDB) FetchCountries(context.Context) ([]tmdb.Country, error) {
+	return nil, nil
+}
+
+func (f *fakeTMDB) FetchLanguages(context.Context) ([]tmdb.Language, error) {
+	return nil, nil
+}
+
+func (f *fakeTMDB) FetchWatchProviders(context.Context, int64, string, string) ([]tmdb.WatchProvider, error) {
+	return nil, nil
+}
+
+func (f *fakeTMDB) ListWatchProviders(context.Context, string, string) ([]tmdb.WatchProvider, error) {
+	return nil, nil
+}
+
+// fakePage serves every result on page one.
+func fakePage(results []tmdb.SearchResult, page int) tmdb.SearchPage {
+	if page > 1 {
+		return tmdb.SearchPage{Page: page, TotalPages: 1, TotalResults: len(results)}
+	}
+	return tmdb.SearchPage{
+		Results:      append([]tmdb.SearchResult(nil), results...),
+		Page:         1,
+		TotalPages:   1,
+		TotalResults: len(results),
+	}
+}
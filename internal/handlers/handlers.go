@@ -15,38 +15,117 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/handsomefox/website-rating/internal/arr"
+	"github.com/handsomefox/website-rating/internal/backup"
+	"github.com/handsomefox/website-rating/internal/ddtd"
 	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/jobs"
+	"github.com/handsomefox/website-rating/internal/omdb"
+	"github.com/handsomefox/website-rating/internal/overseerr"
+	"github.com/handsomefox/website-rating/internal/ratings"
 	"github.com/handsomefox/website-rating/internal/store"
 	"github.com/handsomefox/website-rating/internal/tmdb"
+	"github.com/handsomefox/website-rating/internal/wikipedia"
 )
 
 type Handler struct {
 	store     *store.Store
-	tmdb      *tmdb.Client
+	tmdb      tmdb.MetadataProvider
 	password  string
-	passHash  string
+	apiToken  string
 	imageBase string
 	bfName    string
 	gfName    string
 	genres    genreCache
 	countries countryCache
 	languages languageCache
+	adds      addGroup
+	// backupPassphrase encrypts exports when set.
+	backupPassphrase string
+	// radarr/sonarr/overseerr are nil unless configured.
+	radarr    *arr.Client
+	sonarr    *arr.Client
+	overseerr *overseerr.Client
+	// ddtd adds content warnings to show details when set.
+	ddtd *ddtd.Client
+	// wikipedia adds article summaries to show details when set.
+	wikipedia *wikipedia.Client
+	// ratingProviders fill the external ratings panel on TMDB writes.
+	ratingProviders []ratings.Provider
+	readOnly        bool
+	// memSessions holds sign-ins instead of the database when readOnly.
+	memSessions *memorySessions
+	// cookies has the defaults filled in.
+	cookies CookieConfig
+	// reminderAfter is how long after watching a missing rating is due.
+	reminderAfter time.Duration
+	// vetoLimit is how many active vetoes each person may hold.
+	vetoLimit int
+	// jobs reports the background jobs; nil when none run.
+	jobs *jobs.Runner
+	// watchRegion is WATCH_REGION; "" falls back to the subscriptions region.
+	watchRegion string
+	// warming is set until Warmup finishes; health reports "warming".
+	warming atomic.Bool
+	// longRequestTimeout replaces the server timeouts on long-running routes.
+	longRequestTimeout time.Duration
 }
 
 type Config struct {
 	Store     *store.Store
-	TMDB      *tmdb.Client
+	TMDB      tmdb.MetadataProvider
 	Password  string
+	APIToken  string
 	ImageBase string
 	BfName    string
 	GfName    string
+	// BackupPassphrase, when set, encrypts every export (see internal/backup).
+	BackupPassphrase string
+	// Radarr, Sonarr and Overseerr enable POST /shows/{id}/request; nil
+	// disables them. Overseerr takes precedence when set.
+	Radarr    *arr.Client
+	Sonarr    *arr.Client
+	Overseerr *overseerr.Client
+	// DDTD, when set, adds DoesTheDogDie content warnings to show details.
+	DDTD *ddtd.Client
+	// OMDb, when set, adds IMDb, Rotten Tomatoes and Metacritic scores to
+	// the external ratings fetched alongside TMDB refreshes.
+	OMDb *omdb.Client
+	// Wikipedia, when set, adds a Wikipedia summary to show details.
+	Wikipedia *wikipedia.Client
+	// ReadOnly rejects every mutating request (see MiddlewareReadOnly).
+	ReadOnly bool
+	// Cookies controls the sign-in cookie's name, lifetime and attributes.
+	Cookies CookieConfig
+	// ReminderAfter is how long after watching a show counts as unrated in
+	// rating reminders.
+	ReminderAfter time.Duration
+	// VetoLimit is how many planned shows each person may veto at once.
+	VetoLimit int
+	// Jobs, when set, is reported by GET /admin/jobs.
+	Jobs *jobs.Runner
+	// WatchRegion is the ISO 3166-1 region for streaming availability.
+	WatchRegion string
+	// Warmup makes health report "warming" until Warmup has run.
+	Warmup bool
+	// LongRequestTimeout is the read/write deadline for long-running routes
+	// (see MiddlewareLongRunning); zero keeps the server-wide timeouts.
+	LongRequestTimeout time.Duration
 }
 
+// genreCache and countryCache hold TMDB reference data per request language
+// ("" is TMDB's English default), in front of the copies kept in the
+// database (see loadReference).
 type genreCache struct {
-	mu        sync.RWMutex
+	mu     sync.RWMutex
+	byLang map[string]*genreLists
+}
+
+type genreLists struct {
 	movie     map[int]string
 	tv        map[int]string
 	movieList []tmdb.Genre
@@ -55,7 +134,11 @@ type genreCache struct {
 }
 
 type countryCache struct {
-	mu        sync.RWMutex
+	mu     sync.RWMutex
+	byLang map[string]*countryList
+}
+
+type countryList struct {
 	items     []tmdb.Country
 	fetchedAt time.Time
 }
@@ -77,17 +160,48 @@ type searchFilters struct {
 	GenreIDs         []int
 	GenreMode        string
 	GenreRaw         string
+	ExcludeGenreIDs  []int
+	Companies        string
+	Networks         string
 	OriginCountry    string
 	OriginalLanguage string
+	WatchRegion      string
+	WatchProviders   string
+	// CertificationCountry and MaxCertification form the age-rating filter.
+	CertificationCountry string
+	MaxCertification     string
+	ExcludeLibrary       bool
+	HideWatched          bool
 }
 
 type searchPage struct {
 	Results      []tmdb.SearchResult
+	People       []tmdb.Person
 	Page         int
 	TotalPages   int
 	TotalResults int
+	// Truncated is set when the walk stopped at searchMaxPages or ran out of
+	// time before it could fill the page; totals then only count what was
+	// read.
+	Truncated bool
+	// HasMore reports whether there is anything past this page.
+	HasMore bool
+	// PagesScanned counts the TMDB pages read for the request.
+	PagesScanned int
 }
 
+const (
+	// searchBudget bounds all TMDB calls behind one search request, well
+	// under the default WRITE_TIMEOUT; searchCallTimeout bounds each page.
+	searchBudget      = 7 * time.Second
+	searchCallTimeout = 4 * time.Second
+	// searchMaxPages caps the TMDB pages one filtered search may walk.
+	searchMaxPages = 10
+	// addTimeout bounds a coalesced add, which outlives the request that
+	// started it when that one is canceled.
+	addTimeout = 30 * time.Second
+)
+
 func New(cfg *Config) (*Handler, error) {
 	if cfg.Store == nil {
 		return nil, errors.New("store is required")
@@ -108,31 +222,102 @@ func New(cfg *Config) (*Handler, error) {
 		gfName = "Girlfriend"
 	}
 
-	return &Handler{
+	h := &Handler{
 		store:     cfg.Store,
 		tmdb:      cfg.TMDB,
 		password:  cfg.Password,
-		passHash:  hashPassword(cfg.Password),
+		apiToken:  strings.TrimSpace(cfg.APIToken),
 		imageBase: cfg.ImageBase,
 		bfName:    bfName,
 		gfName:    gfName,
-	}, nil
+
+		backupPassphrase: cfg.BackupPassphrase,
+		radarr:           cfg.Radarr,
+		sonarr:           cfg.Sonarr,
+		overseerr:        cfg.Overseerr,
+		ddtd:             cfg.DDTD,
+		wikipedia:        cfg.Wikipedia,
+		ratingProviders:  ratingProviders(cfg),
+		readOnly:         cfg.ReadOnly,
+		cookies:          cfg.Cookies.withDefaults(),
+		reminderAfter:    cfg.ReminderAfter,
+		vetoLimit:        cfg.VetoLimit,
+		jobs:             cfg.Jobs,
+
+		longRequestTimeout: cfg.LongRequestTimeout,
+	}
+	h.watchRegion = cfg.WatchRegion
+	if h.readOnly {
+		h.memSessions = newMemorySessions()
+	}
+	h.warming.Store(cfg.Warmup)
+	return h, nil
 }
 
 func (h *Handler) RegisterRoutes(r chi.Router) {
+	// api is the whole tree, for the generated OpenAPI document.
+	api := r
+	r.Use(h.MiddlewareReadOnly)
+
+	r.Method(http.MethodGet, "/health", Adapt(h.getHealth))
 	r.Method(http.MethodGet, "/session", Adapt(h.getSession))
 	r.Method(http.MethodPost, "/login", Adapt(h.postLogin))
 
+	r.Group(func(r chi.Router) {
+		r.Use(h.MiddlewareQuickAddAuth)
+
+		r.Method(http.MethodGet, "/quick-add", Adapt(h.handleQuickAdd))
+		r.Method(http.MethodPost, "/quick-add", Adapt(h.handleQuickAdd))
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(h.MiddlewareRequireAPIToken)
+
+		r.Method(http.MethodPost, "/webhooks/plex", Adapt(h.postPlexWebhook))
+		r.Method(http.MethodPost, "/webhooks/jellyfin", Adapt(h.postJellyfinWebhook))
+	})
+
 	r.Group(func(r chi.Router) {
 		r.Use(h.MiddlewareRequireAuth)
 
 		r.Method(http.MethodPost, "/logout", Adapt(h.postLogout))
+		r.Method(http.MethodPost, "/auth/change-password", Adapt(h.postChangePassword))
+		r.Method(http.MethodPost, "/auth/account-password", Adapt(h.postAccountPassword))
+		r.Method(http.MethodPost, "/session/person", Adapt(h.postSessionPerson))
+		r.Method(http.MethodGet, "/preferences", Adapt(h.getPreferences))
+		r.Method(http.MethodPut, "/preferences/{person}", Adapt(h.putPreferences))
 		r.Method(http.MethodGet, "/search", Adapt(h.getSearch))
 		r.Method(http.MethodGet, "/search/genres", Adapt(h.getSearchGenres))
 		r.Method(http.MethodGet, "/search/countries", Adapt(h.getSearchCountries))
 		r.Method(http.MethodGet, "/search/languages", Adapt(h.getSearchLanguages))
 		r.Method(http.MethodGet, "/search/resolve", Adapt(h.getSearchResolve))
+		r.Method(http.MethodGet, "/search/history", Adapt(h.getSearchHistory))
+		r.Method(http.MethodDelete, "/search/history", Adapt(h.deleteSearchHistory))
+		r.Method(http.MethodGet, "/search/suggest", Adapt(h.getSearchSuggest))
 		r.Method(http.MethodGet, "/genres", Adapt(h.getGenres))
+		r.Method(http.MethodGet, "/calendar", Adapt(h.getCalendar))
+		r.Method(http.MethodGet, "/upcoming", Adapt(h.getUpcoming))
+		r.Method(http.MethodGet, "/stats/taste/{person}", Adapt(h.getTasteProfile))
+		r.Method(http.MethodGet, "/stats/compatibility", Adapt(h.getCompatibility))
+		r.Method(http.MethodGet, "/stats/backlog", Adapt(h.getBacklog))
+		r.Method(http.MethodGet, "/stats/fairness", Adapt(h.getFairness))
+		r.Method(http.MethodGet, "/stats/vetoes", Adapt(h.getVetoStats))
+		r.Method(http.MethodGet, "/stats/activity", Adapt(h.getActivity))
+		r.Method(http.MethodGet, "/recommendations", Adapt(h.getRecommendations))
+		r.Method(http.MethodGet, "/tonight", Adapt(h.getTonight))
+		r.Method(http.MethodGet, "/reminders/{person}", Adapt(h.getReminders))
+		r.Method(http.MethodPost, "/reminders/{person}/snooze", Adapt(h.postSnoozeReminders))
+		r.Method(http.MethodGet, "/sync", Adapt(h.getSync))
+		r.Method(http.MethodGet, "/watch-providers", Adapt(h.getWatchProviders))
+		r.Method(http.MethodGet, "/settings/subscriptions", Adapt(h.getSubscriptions))
+		r.Method(http.MethodPut, "/settings/subscriptions", Adapt(h.putSubscriptions))
+		r.Method(http.MethodGet, "/settings/watched-ratings", Adapt(h.getWatchedRatings))
+		r.Method(http.MethodPut, "/settings/watched-ratings", Adapt(h.putWatchedRatings))
+		r.Method(http.MethodGet, "/console", Adapt(h.getConsole))
+		r.Method(http.MethodGet, "/sessions", Adapt(h.getSessions))
+		r.Method(http.MethodPatch, "/sessions/{id:[0-9]+}", Adapt(h.patchSession))
+		r.Method(http.MethodDelete, "/sessions/{id:[0-9]+}", Adapt(h.deleteSession))
+		r.Method(http.MethodGet, "/openapi.json", Adapt(h.getOpenAPI(api)))
 
 		r.Route("/shows", func(r chi.Router) {
 			r.Method(http.MethodGet, "/", Adapt(h.getShows))
@@ -140,64 +325,202 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 
 			r.Route("/{id:[0-9]+}", func(r chi.Router) {
 				r.Method(http.MethodGet, "/", Adapt(h.getShow))
+				r.Method(http.MethodPatch, "/", Adapt(h.patchShow))
 				r.Method(http.MethodDelete, "/", Adapt(h.deleteShow))
 
 				r.Method(http.MethodPost, "/ratings", Adapt(h.postShowRatings))
+				r.Method(http.MethodDelete, "/ratings/{person}", Adapt(h.deleteShowPersonRating))
+				r.Method(http.MethodPost, "/status", Adapt(h.postShowStatus))
 				r.Method(http.MethodPost, "/toggle-status", Adapt(h.postShowToggleStatus))
 				r.Method(http.MethodPost, "/clear-ratings", Adapt(h.postShowClearRatings))
+				r.Method(http.MethodPost, "/pin", Adapt(h.postShowPin))
+				r.Method(http.MethodPost, "/veto", Adapt(h.postShowVeto))
+				r.Method(http.MethodDelete, "/veto", Adapt(h.deleteShowVeto))
+				r.Method(http.MethodGet, "/watches", Adapt(h.getShowWatches))
+				r.Method(http.MethodPost, "/watches", Adapt(h.postShowWatch))
+				r.Method(http.MethodDelete, "/watches/{watchID:[0-9]+}", Adapt(h.deleteShowWatch))
 				r.Method(http.MethodPost, "/refresh-tmdb", Adapt(h.postShowRefreshTMDB))
+				r.Method(http.MethodPost, "/request", Adapt(h.postShowRequest))
 			})
 		})
 
-		r.Method(http.MethodPost, "/export", Adapt(h.postExport))
-		r.Method(http.MethodPost, "/refresh-tmdb", Adapt(h.postRefreshTMDBAll))
+		r.Route("/plans", func(r chi.Router) {
+			r.Method(http.MethodGet, "/", Adapt(h.getPlans))
+			r.Method(http.MethodPost, "/", Adapt(h.postPlans))
+
+			r.Route("/{id:[0-9]+}", func(r chi.Router) {
+				r.Method(http.MethodGet, "/", Adapt(h.getPlan))
+				r.Method(http.MethodPatch, "/", Adapt(h.patchPlan))
+				r.Method(http.MethodDelete, "/", Adapt(h.deletePlan))
+				r.Method(http.MethodPost, "/rsvp", Adapt(h.postPlanRSVP))
+				r.Method(http.MethodPost, "/complete", Adapt(h.postPlanComplete))
+			})
+		})
+
+		r.Route("/lists", func(r chi.Router) {
+			r.Method(http.MethodGet, "/", Adapt(h.getLists))
+			r.Method(http.MethodPost, "/", Adapt(h.postLists))
+
+			r.Route("/{id:[0-9]+}", func(r chi.Router) {
+				r.Method(http.MethodGet, "/", Adapt(h.getList))
+				r.Method(http.MethodPut, "/", Adapt(h.putList))
+				r.Method(http.MethodDelete, "/", Adapt(h.deleteList))
+			})
+		})
+
+		r.Method(http.MethodGet, "/admin/security", Adapt(h.getSecurityReport))
+		r.Method(http.MethodGet, "/admin/diff", Adapt(h.getLibraryDiff))
+		r.Method(http.MethodGet, "/admin/jobs", Adapt(h.getJobs))
+		r.Method(http.MethodGet, "/admin/health", Adapt(h.getAdminHealth))
+		r.Method(http.MethodPost, "/admin/shows/{id:[0-9]+}/unfreeze-ratings", Adapt(h.postUnfreezeRatings))
+		r.Method(http.MethodPost, "/erase", Adapt(h.postErase))
+		r.Method(http.MethodGet, "/import/csv-template", Adapt(h.getImportCSVTemplate))
+		r.Method(http.MethodGet, "/import/pending", Adapt(h.getPendingImports))
+		r.Method(http.MethodPost, "/import/pending/{id:[0-9]+}/confirm", Adapt(h.postPendingImportConfirm))
+		r.Method(http.MethodDelete, "/import/pending/{id:[0-9]+}", Adapt(h.deletePendingImport))
+
+		r.Group(func(r chi.Router) {
+			r.Use(h.MiddlewareLongRunning)
+
+			r.Method(http.MethodPost, "/export", Adapt(h.postExport))
+			r.Method(http.MethodPost, "/import", Adapt(h.postImport))
+			r.Method(http.MethodPost, "/import/csv", Adapt(h.postImportCSV))
+			r.Method(http.MethodPost, "/refresh-tmdb", Adapt(h.postRefreshTMDBAll))
+			r.Method(http.MethodPost, "/admin/optimize", Adapt(h.postOptimize))
+		})
 	})
 }
 
 func (h *Handler) getSession(w http.ResponseWriter, r *http.Request) error {
-	authed := h.isAuthenticated(r)
+	session, authed := h.authSession(r)
 
-	resp := &pb.SessionResponse{Authenticated: ptr(authed)}
+	resp := h.sessionResponse(authed)
 	if authed {
-		resp.ImageBase = ptr(h.imageBase)
-		resp.BfName = ptr(h.bfName)
-		resp.GfName = ptr(h.gfName)
+		r = r.WithContext(withSession(r.Context(), session))
+		resp.Person = optionalString(requestPerson(r))
+		resp.Account = accountPerson(r) != ""
 	}
 
 	writeJSON(w, http.StatusOK, resp)
 	return nil
 }
 
+func (h *Handler) sessionResponse(authed bool) *pb.SessionResponse {
+	resp := &pb.SessionResponse{Authenticated: ptr(authed), ReadOnly: h.readOnly}
+	if authed {
+		resp.ImageBase = ptr(h.imageBase)
+		resp.BfName = ptr(h.bfName)
+		resp.GfName = ptr(h.gfName)
+	}
+	return resp
+}
+
 func (h *Handler) postLogin(w http.ResponseWriter, r *http.Request) error {
 	var req pb.LoginRequest
 	if err := decodeJSON(r, &req); err != nil {
 		return badRequest("bad request")
 	}
 
-	if req.Password != h.password {
+	locked, err := h.loginLocked(r.Context(), clientIP(r))
+	if err != nil {
+		return internal(err)
+	}
+	if locked {
+		slog.Warn("login: too many failures", slog.String("remote", r.RemoteAddr))
+		return tooManyAttempts()
+	}
+
+	person := strings.TrimSpace(req.Person)
+	if person != "" && !store.ValidPerson(person) {
+		return badRequest("invalid person")
+	}
+	if person == "" {
+		// With personal accounts around, the shared password only signs in
+		// someone who has none.
+		hasUsers, err := h.store.HasUsers(r.Context())
+		if err != nil {
+			return internal(err)
+		}
+		if hasUsers {
+			return badRequest("person required")
+		}
+	}
+
+	ok, account, err := h.checkLogin(r.Context(), person, req.Password)
+	if err != nil {
+		return internal(err)
+	}
+	if !ok {
 		slog.Warn("login: invalid password", slog.String("remote", r.RemoteAddr))
+		h.recordLoginFailure(r)
 		return unauthorized("invalid password")
 	}
+	accountOf := ""
+	if account {
+		accountOf = person
+	}
 
-	setAuthCookie(w, r, h.passHash)
-	writeJSON(w, http.StatusOK, &pb.SessionResponse{
-		Authenticated: ptr(true),
-		ImageBase:     ptr(h.imageBase),
-		BfName:        ptr(h.bfName),
-		GfName:        ptr(h.gfName),
-	})
+	known, err := h.store.KnownDevice(r.Context(), clientIP(r), r.UserAgent())
+	if err != nil {
+		slog.Warn("login: device lookup failed", slog.Any("err", err))
+		known = true
+	}
+	remember := h.remembers(req.Remember)
+	token, err := h.startSession(r, remember, accountOf)
+	if err != nil {
+		return internal(err)
+	}
+	if !known {
+		slog.Warn("login: new device",
+			slog.String("ip", clientIP(r)),
+			slog.String("user_agent", r.UserAgent()),
+		)
+	}
+	if !h.readOnly {
+		if err := h.store.DeleteExpiredSessions(r.Context()); err != nil {
+			slog.Warn("login: expired session cleanup failed", slog.Any("err", err))
+		}
+	}
+
+	h.setAuthCookie(w, token, remember)
+	resp := h.sessionResponse(true)
+	resp.Account = account
+	if person != "" {
+		h.setPersonCookie(w, person)
+		resp.Person = ptr(person)
+	} else {
+		resp.Person = optionalString(requestPerson(r))
+	}
+	writeJSON(w, http.StatusOK, resp)
 	return nil
 }
 
 func (h *Handler) postLogout(w http.ResponseWriter, r *http.Request) error {
-	clearAuthCookie(w, r)
-	writeJSON(w, http.StatusOK, &pb.SessionResponse{Authenticated: ptr(false)})
+	if session, ok := h.authSession(r); ok {
+		if h.readOnly {
+			h.memSessions.delete(session.ID)
+		} else if err := h.store.DeleteSession(r.Context(), session.ID); err != nil && !isNoRows(err) {
+			return internal(err)
+		}
+	}
+	h.clearAuthCookie(w)
+	writeJSON(w, http.StatusOK, h.sessionResponse(false))
 	return nil
 }
 
 func (h *Handler) getShows(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	filters := parseListFilters(r)
+	if parseBoolParam(r.URL.Query().Get("our_services")) {
+		subs, err := h.store.GetSubscriptions(ctx)
+		if err != nil {
+			return internal(err)
+		}
+		if !subs.Configured() {
+			return badRequest("no subscriptions set")
+		}
+		filters.StreamingOn = subs.ProviderIDs()
+	}
 
 	shows, err := h.store.ListShows(ctx, filters)
 	if err != nil {
@@ -217,10 +540,31 @@ func (h *Handler) getShows(w http.ResponseWriter, r *http.Request) error {
 		return internal(err)
 	}
 
+	decades, err := h.store.ListDecades(ctx)
+	if err != nil {
+		slog.Warn("list decades failed", slog.Any("err", err))
+		return internal(err)
+	}
+
+	companies, err := h.store.ListCompanies(ctx)
+	if err != nil {
+		slog.Warn("list companies failed", slog.Any("err", err))
+		return internal(err)
+	}
+
+	networks, err := h.store.ListNetworks(ctx)
+	if err != nil {
+		slog.Warn("list networks failed", slog.Any("err", err))
+		return internal(err)
+	}
+
 	writeJSON(w, http.StatusOK, &pb.ListResponse{
 		Shows:     toPBShows(shows),
 		Genres:    genres,
 		Countries: countries,
+		Decades:   decades,
+		Companies: toPBCompanies(companies),
+		Networks:  toPBNetworks(networks),
 	})
 	return nil
 }
@@ -232,13 +576,9 @@ func (h *Handler) postShows(w http.ResponseWriter, r *http.Request) error {
 	if err := decodeJSON(r, &req); err != nil {
 		return badRequest("bad request")
 	}
-	if req.TmdbId == 0 {
-		return badRequest("tmdb_id required")
-	}
-
-	mediaType := strings.TrimSpace(req.MediaType)
-	if mediaType != "movie" && mediaType != "tv" {
-		return badRequest("invalid media_type")
+	tmdbID, mediaType, err := h.resolveAddTarget(ctx, &req)
+	if err != nil {
+		return err
 	}
 
 	status := strings.TrimSpace(req.Status)
@@ -246,30 +586,117 @@ func (h *Handler) postShows(w http.ResponseWriter, r *http.Request) error {
 		status = "planned"
 	}
 
-	detail, err := h.tmdb.FetchDetails(ctx, req.TmdbId, mediaType)
+	stored, err := h.addShow(ctx, tmdbID, mediaType, status)
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, toPBShowDetail(&stored))
+	return nil
+}
+
+// addShow adds (or refreshes) a title. Concurrent adds of the same title are
+// coalesced, and "watched" wins over "planned" whichever request came first.
+// Under the "block" watched-ratings rule a title added as watched stays
+// planned until both have rated it.
+// Metadata is stored in the instance language (TMDB_LANGUAGE), never a
+// person's preference, so the shared library reads the same for both.
+func (h *Handler) addShow(ctx context.Context, tmdbID int64, mediaType, status string) (store.Show, error) {
+	ref := store.TMDBRef{ID: tmdbID, MediaType: mediaType}
+	stored, err := h.adds.do(ref, func() (store.Show, error) {
+		// Other callers may be waiting on this add; the first caller
+		// going away must not cancel it for them.
+		addCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), addTimeout)
+		defer cancel()
+		return h.upsertFromTMDB(addCtx, tmdbID, mediaType, status)
+	})
+	if err != nil {
+		return store.Show{}, err
+	}
+
+	if status == "watched" && stored.Status != status {
+		err := h.store.UpdateStatus(ctx, stored.ID, status)
+		if errors.Is(err, store.ErrRatingsRequired) {
+			return stored, nil
+		}
+		if err != nil {
+			return store.Show{}, showWriteError(err)
+		}
+		stored.Status = status
+		if updated, err := h.store.GetShow(ctx, stored.ID); err == nil {
+			stored = updated
+		}
+	}
+	return stored, nil
+}
+
+func (h *Handler) upsertFromTMDB(ctx context.Context, tmdbID int64, mediaType, status string) (store.Show, error) {
+	detail, err := h.tmdb.FetchDetails(ctx, tmdbID, mediaType)
 	if err != nil {
 		slog.Warn("add show: tmdb fetch failed", slog.Any("err", err))
-		return &Error{Status: http.StatusBadGateway, Message: err.Error()}
+		return store.Show{}, &Error{Status: http.StatusBadGateway, Message: err.Error()}
 	}
 
 	show := showFromDetail(detail, status)
 	id, err := h.store.UpsertShow(ctx, &show)
 	if err != nil {
 		slog.Warn("add show: upsert failed", slog.Any("err", err))
-		return internal(err)
+		return store.Show{}, internal(err)
 	}
+	show.ID = id
+	h.refreshExternalRatings(ctx, &show)
 
 	stored, err := h.store.GetShow(ctx, id)
 	if err != nil {
 		stored = show
 		stored.ID = id
 	}
+	return stored, nil
+}
 
-	writeJSON(w, http.StatusOK, &pb.ShowDetail{
-		Show:    toPBShow(&stored),
-		ImdbUrl: optionalString(imdbURL(stored.IMDbID)),
-	})
-	return nil
+// resolveAddTarget works out which TMDB entry an add request refers to, either
+// directly by tmdb_id, from a pasted TMDB URL, or via an IMDb ID/URL.
+func (h *Handler) resolveAddTarget(ctx context.Context, req *pb.AddShowRequest) (int64, string, error) {
+	mediaType := strings.TrimSpace(req.MediaType)
+
+	if req.TmdbId != 0 {
+		if mediaType != "movie" && mediaType != "tv" {
+			return 0, "", badRequest("invalid media_type")
+		}
+		return req.TmdbId, mediaType, nil
+	}
+
+	if strings.TrimSpace(req.TmdbUrl) != "" {
+		tmdbID, urlMediaType, ok := parseTMDBURL(req.TmdbUrl)
+		if !ok {
+			return 0, "", badRequest("invalid tmdb_url")
+		}
+		return tmdbID, urlMediaType, nil
+	}
+
+	if strings.TrimSpace(req.ImdbId) == "" {
+		return 0, "", badRequest("tmdb_id required")
+	}
+
+	imdbID, ok := parseIMDbID(req.ImdbId)
+	if !ok {
+		return 0, "", badRequest("invalid imdb_id")
+	}
+
+	found, err := h.tmdb.FindByIMDbID(ctx, imdbID)
+	if err != nil {
+		slog.Warn("add show: imdb lookup failed", slog.Any("err", err))
+		return 0, "", &Error{Status: http.StatusBadGateway, Message: err.Error()}
+	}
+	for _, item := range found {
+		if mediaType == "movie" || mediaType == "tv" {
+			if item.MediaType != mediaType {
+				continue
+			}
+		}
+		return item.ID, item.MediaType, nil
+	}
+	return 0, "", notFound("no tmdb entry for imdb_id")
 }
 
 func (h *Handler) getShow(w http.ResponseWriter, r *http.Request) error {
@@ -288,13 +715,51 @@ func (h *Handler) getShow(w http.ResponseWriter, r *http.Request) error {
 		return internal(err)
 	}
 
-	writeJSON(w, http.StatusOK, &pb.ShowDetail{
-		Show:    toPBShow(&show),
-		ImdbUrl: optionalString(imdbURL(show.IMDbID)),
-	})
+	detail, err := h.showDetail(ctx, &show)
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, detail)
 	return nil
 }
 
+// showDetail adds the stored external ratings and, when configured, content
+// warnings, streaming providers and the Wikipedia summary to a show's
+// details.
+func (h *Handler) showDetail(ctx context.Context, show *store.Show) (*pb.ShowDetail, error) {
+	detail := toPBShowDetail(show)
+
+	external, err := h.store.ListExternalRatings(ctx, show.ID)
+	if err != nil {
+		return nil, err
+	}
+	detail.ExternalRatings = toPBExternalRatings(external)
+
+	if h.ddtd != nil {
+		if detail.Warnings, err = h.contentWarnings(ctx, show); err != nil {
+			return nil, err
+		}
+	}
+
+	if region := h.streamingRegion(ctx); region != "" && show.TMDBID != 0 {
+		detail.WatchRegion = region
+		detail.WatchProviders = titleProviders(ctx, h.tmdb, show.TMDBID, show.MediaType, region)
+	}
+
+	if h.wikipedia != nil {
+		summary, err := h.wikipediaSummary(ctx, show)
+		if err != nil {
+			return nil, err
+		}
+		if summary.Extract != "" {
+			detail.WikipediaSummary = ptr(summary.Extract)
+			detail.WikipediaUrl = ptr(summary.URL)
+		}
+	}
+	return detail, nil
+}
+
 func (h *Handler) deleteShow(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
@@ -326,6 +791,9 @@ func (h *Handler) postShowRatings(w http.ResponseWriter, r *http.Request) error
 	if err := decodeJSON(r, &req); err != nil {
 		return badRequest("bad request")
 	}
+	if err := h.attributeRating(r, &req); err != nil {
+		return err
+	}
 
 	update := store.RatingsUpdate{
 		BfRating:  nil,
@@ -354,15 +822,242 @@ func (h *Handler) postShowRatings(w http.ResponseWriter, r *http.Request) error
 		}
 	}
 
-	if err := h.store.UpdateRatings(ctx, id, update); err != nil {
-		if isNoRows(err) {
-			return notFound("not found")
-		}
-		slog.Warn("show: update ratings failed", slog.Any("err", err))
-		return internal(err)
+	if err := h.store.UpdateRatings(ctx, id, update); err != nil {
+		if !isNoRows(err) && !errors.Is(err, store.ErrRatingsFrozen) {
+			slog.Warn("show: update ratings failed", slog.Any("err", err))
+		}
+		return showWriteError(err)
+	}
+
+	show, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, toPBShowDetail(&show))
+	return nil
+}
+
+// patchShow applies a sparse update. Every field is validated before anything
+// is written.
+func (h *Handler) patchShow(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	var req pb.ShowPatch
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	if err := h.checkRatingOwner(r,
+		req.BfRating != nil || req.BfComment != nil,
+		req.GfRating != nil || req.GfComment != nil,
+	); err != nil {
+		return err
+	}
+
+	patch, err := showPatchFromPB(&req)
+	if err != nil {
+		return err
+	}
+	if patch.Empty() {
+		return badRequest("nothing to update")
+	}
+
+	if err := h.store.PatchShow(ctx, id, patch); err != nil {
+		return showWriteError(err)
+	}
+
+	show, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, toPBShowDetail(&show))
+	return nil
+}
+
+func showPatchFromPB(req *pb.ShowPatch) (store.ShowPatch, error) {
+	var patch store.ShowPatch
+
+	if req.Status != nil {
+		status := strings.TrimSpace(*req.Status)
+		if !store.ValidStatus(status) {
+			return store.ShowPatch{}, badRequest("invalid status")
+		}
+		patch.Status = &status
+	}
+
+	for _, field := range []struct {
+		val *int32
+		dst **sql.Null[int64]
+	}{
+		{req.BfRating, &patch.Ratings.BfRating},
+		{req.GfRating, &patch.Ratings.GfRating},
+	} {
+		if field.val == nil {
+			continue
+		}
+		if *field.val < 0 || *field.val > 10 {
+			return store.ShowPatch{}, badRequest("invalid rating")
+		}
+		rating := sql.Null[int64]{}
+		if *field.val > 0 {
+			rating = sql.Null[int64]{V: int64(*field.val), Valid: true}
+		}
+		*field.dst = &rating
+	}
+
+	if req.BfComment != nil {
+		patch.Ratings.BfComment = &sql.Null[string]{V: *req.BfComment, Valid: true}
+	}
+	if req.GfComment != nil {
+		patch.Ratings.GfComment = &sql.Null[string]{V: *req.GfComment, Valid: true}
+	}
+
+	if req.WatchedAt != nil {
+		watchedAt := sql.Null[string]{}
+		if raw := strings.TrimSpace(*req.WatchedAt); raw != "" {
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				if t, err = time.Parse(dateLayout, raw); err != nil {
+					return store.ShowPatch{}, badRequest("invalid watched_at")
+				}
+			}
+			watchedAt = sql.Null[string]{V: t.UTC().Format(time.RFC3339), Valid: true}
+		}
+		patch.WatchedAt = &watchedAt
+	}
+
+	if req.PickedBy != nil {
+		pickedBy := sql.Null[string]{}
+		if person := strings.TrimSpace(*req.PickedBy); person != "" {
+			if !store.ValidPerson(person) {
+				return store.ShowPatch{}, badRequest("invalid person")
+			}
+			pickedBy = sql.Null[string]{V: person, Valid: true}
+		}
+		patch.PickedBy = &pickedBy
+	}
+
+	patch.Pinned = req.Pinned
+	return patch, nil
+}
+
+// postShowStatus sets the status given in the body. Unlike toggle-status,
+// repeating the request is harmless.
+func (h *Handler) postShowStatus(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	var req pb.StatusRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	status := strings.TrimSpace(req.Status)
+	if !store.ValidStatus(status) {
+		return badRequest("invalid status")
+	}
+
+	show, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	missing, err := h.checkWatchedRatings(ctx, &show, status)
+	if err != nil {
+		return err
+	}
+
+	if err := h.store.UpdateStatus(ctx, id, status); err != nil {
+		return showWriteError(err)
+	}
+
+	updated, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	resp := toPBShowDetail(&updated)
+	resp.MissingRatings = missing
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// postShowToggleStatus is kept for older clients; use postShowStatus.
+func (h *Handler) postShowToggleStatus(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	show, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	next := nextStatus(show.Status)
+	missing, err := h.checkWatchedRatings(ctx, &show, next)
+	if err != nil {
+		return err
+	}
+	if err := h.store.UpdateStatus(ctx, id, next); err != nil {
+		return showWriteError(err)
+	}
+
+	updated, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	resp := toPBShowDetail(&updated)
+	resp.MissingRatings = missing
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *Handler) postShowClearRatings(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	if err := h.checkRatingOwner(r, true, true); err != nil {
+		return err
+	}
+	if err := h.store.ClearRatings(ctx, id); err != nil {
+		return showWriteError(err)
 	}
 
-	show, err := h.store.GetShow(ctx, id)
+	updated, err := h.store.GetShow(ctx, id)
 	if err != nil {
 		if isNoRows(err) {
 			return notFound("not found")
@@ -370,35 +1065,28 @@ func (h *Handler) postShowRatings(w http.ResponseWriter, r *http.Request) error
 		return internal(err)
 	}
 
-	writeJSON(w, http.StatusOK, &pb.ShowDetail{
-		Show:    toPBShow(&show),
-		ImdbUrl: optionalString(imdbURL(show.IMDbID)),
-	})
+	writeJSON(w, http.StatusOK, toPBShowDetail(&updated))
 	return nil
 }
 
-func (h *Handler) postShowToggleStatus(w http.ResponseWriter, r *http.Request) error {
+// deleteShowPersonRating clears one person's rating and comment.
+func (h *Handler) deleteShowPersonRating(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
 	id, err := idParam(r, "id")
 	if err != nil {
 		return notFound("not found")
 	}
-
-	show, err := h.store.GetShow(ctx, id)
-	if err != nil {
-		if isNoRows(err) {
-			return notFound("not found")
-		}
-		return internal(err)
+	person := chi.URLParam(r, "person")
+	if !store.ValidPerson(person) {
+		return badRequest("invalid person")
+	}
+	if err := h.checkRatingOwner(r, person == store.PersonBf, person == store.PersonGf); err != nil {
+		return err
 	}
 
-	next := nextStatus(show.Status)
-	if err := h.store.UpdateStatus(ctx, id, next); err != nil {
-		if isNoRows(err) {
-			return notFound("not found")
-		}
-		return internal(err)
+	if err := h.store.ClearPersonRating(ctx, id, person); err != nil {
+		return showWriteError(err)
 	}
 
 	updated, err := h.store.GetShow(ctx, id)
@@ -409,14 +1097,11 @@ func (h *Handler) postShowToggleStatus(w http.ResponseWriter, r *http.Request) e
 		return internal(err)
 	}
 
-	writeJSON(w, http.StatusOK, &pb.ShowDetail{
-		Show:    toPBShow(&updated),
-		ImdbUrl: optionalString(imdbURL(updated.IMDbID)),
-	})
+	writeJSON(w, http.StatusOK, toPBShowDetail(&updated))
 	return nil
 }
 
-func (h *Handler) postShowClearRatings(w http.ResponseWriter, r *http.Request) error {
+func (h *Handler) postShowPin(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
 	id, err := idParam(r, "id")
@@ -424,7 +1109,12 @@ func (h *Handler) postShowClearRatings(w http.ResponseWriter, r *http.Request) e
 		return notFound("not found")
 	}
 
-	if err := h.store.ClearRatings(ctx, id); err != nil {
+	var req pb.PinRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+
+	if err := h.store.SetPinned(ctx, id, req.Pinned); err != nil {
 		if isNoRows(err) {
 			return notFound("not found")
 		}
@@ -439,10 +1129,7 @@ func (h *Handler) postShowClearRatings(w http.ResponseWriter, r *http.Request) e
 		return internal(err)
 	}
 
-	writeJSON(w, http.StatusOK, &pb.ShowDetail{
-		Show:    toPBShow(&updated),
-		ImdbUrl: optionalString(imdbURL(updated.IMDbID)),
-	})
+	writeJSON(w, http.StatusOK, toPBShowDetail(&updated))
 	return nil
 }
 
@@ -462,7 +1149,10 @@ func (h *Handler) postShowRefreshTMDB(w http.ResponseWriter, r *http.Request) er
 		return internal(err)
 	}
 
-	detail, err := h.tmdb.FetchDetails(ctx, show.TMDBID, show.MediaType)
+	detail, err := h.fetchDetailsResolving(ctx, h.tmdb, show.ID, show.TMDBID, show.MediaType, show.IMDbID)
+	if errors.Is(err, store.ErrTMDBIDTaken) {
+		return &Error{Status: http.StatusConflict, Message: "tmdb id already in use"}
+	}
 	if err != nil {
 		slog.Warn("show: tmdb refresh failed", slog.Any("err", err))
 		return &Error{Status: http.StatusBadGateway, Message: err.Error()}
@@ -475,16 +1165,19 @@ func (h *Handler) postShowRefreshTMDB(w http.ResponseWriter, r *http.Request) er
 		slog.Warn("show: tmdb upsert failed", slog.Any("err", err))
 		return internal(err)
 	}
+	h.refreshExternalRatings(ctx, &updated)
 
 	stored, err := h.store.GetShow(ctx, id)
 	if err != nil {
 		stored = updated
 	}
 
-	writeJSON(w, http.StatusOK, &pb.ShowDetail{
-		Show:    toPBShow(&stored),
-		ImdbUrl: optionalString(imdbURL(stored.IMDbID)),
-	})
+	resp, err := h.showDetail(ctx, &stored)
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 	return nil
 }
 
@@ -502,7 +1195,7 @@ func (h *Handler) getGenres(w http.ResponseWriter, r *http.Request) error {
 func (h *Handler) getSearchGenres(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
-	movieGenres, tvGenres, err := h.fetchGenreLists(ctx)
+	movieGenres, tvGenres, err := h.fetchGenreLists(ctx, h.requestLanguage(r))
 	if err != nil {
 		return &Error{Status: http.StatusBadGateway, Message: err.Error()}
 	}
@@ -519,7 +1212,7 @@ func (h *Handler) getSearchGenres(w http.ResponseWriter, r *http.Request) error
 func (h *Handler) getSearchCountries(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
-	countries, err := h.fetchCountryList(ctx)
+	countries, err := h.fetchCountryList(ctx, h.requestLanguage(r))
 	if err != nil {
 		return &Error{Status: http.StatusBadGateway, Message: err.Error()}
 	}
@@ -562,7 +1255,7 @@ func (h *Handler) getSearchResolve(w http.ResponseWriter, r *http.Request) error
 		return badRequest("invalid media_type")
 	}
 
-	detail, err := h.tmdb.FetchDetails(ctx, tmdbID, mediaType)
+	detail, err := h.metadataClient(r).FetchDetails(ctx, tmdbID, mediaType)
 	if err != nil {
 		slog.Warn("search resolve failed", slog.Any("err", err))
 		return internal(err)
@@ -589,6 +1282,31 @@ func (h *Handler) postExport(w http.ResponseWriter, r *http.Request) error {
 		return internal(err)
 	}
 
+	person := strings.TrimSpace(r.URL.Query().Get("person"))
+	if parseBoolParam(r.URL.Query().Get("anonymize")) {
+		anonymizeShows(shows)
+	}
+
+	var artifact exportArtifact
+	switch format := strings.TrimSpace(r.URL.Query().Get("format")); format {
+	case "", "json":
+		artifact, err = jsonExport(shows)
+	case "csv":
+		artifact, err = csvExport(shows)
+	case "letterboxd":
+		artifact, err = personExport(shows, person, "letterboxd", ".csv", "text/csv; charset=utf-8", writeLetterboxdCSV)
+	case "trakt":
+		artifact, err = personExport(shows, person, "trakt", ".json", "application/json; charset=utf-8", writeTraktJSON)
+	default:
+		return badRequest("invalid format")
+	}
+	if err != nil {
+		return err
+	}
+	return h.writeExport(w, artifact)
+}
+
+func jsonExport(shows []store.Show) (exportArtifact, error) {
 	payload := &pb.ExportPayload{
 		ExportedAt: time.Now().UTC().Format(time.RFC3339),
 		Shows:      make([]*pb.Show, 0, len(shows)),
@@ -597,19 +1315,29 @@ func (h *Handler) postExport(w http.ResponseWriter, r *http.Request) error {
 		payload.Shows = append(payload.Shows, toPBShow(&shows[i]))
 	}
 
+	// The checksum covers the compact encoding of the shows array, which is
+	// what backup.Verify recomputes from the indented file.
+	compact, err := json.Marshal(payload.Shows)
+	if err != nil {
+		return exportArtifact{}, internal(err)
+	}
+	payload.Manifest = &pb.ExportManifest{
+		SchemaVersion: backup.SchemaVersion,
+		ShowCount:     int32(len(payload.Shows)),
+		Sha256:        backup.Checksum(compact),
+	}
+
 	var buf bytes.Buffer
 	enc := json.NewEncoder(&buf)
 	enc.SetIndent("", "  ")
 	if err := enc.Encode(payload); err != nil {
-		return internal(err)
-	}
-
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.Header().Set("Content-Disposition", "attachment; filename=show-ratings.json")
-	if _, err := w.Write(buf.Bytes()); err != nil {
-		slog.Warn("export write failed", slog.Any("err", err))
+		return exportArtifact{}, internal(err)
 	}
-	return nil
+	return exportArtifact{
+		body:        buf.Bytes(),
+		filename:    "show-ratings.json",
+		contentType: "application/json; charset=utf-8",
+	}, nil
 }
 
 func (h *Handler) postRefreshTMDBAll(w http.ResponseWriter, r *http.Request) error {
@@ -620,49 +1348,195 @@ func (h *Handler) postRefreshTMDBAll(w http.ResponseWriter, r *http.Request) err
 		return internal(err)
 	}
 
+	updated := 0
 	for _, item := range items {
-		detail, err := h.tmdb.FetchDetails(ctx, item.TMDBID, item.MediaType)
-		if err != nil {
-			return &Error{Status: http.StatusBadGateway, Message: err.Error()}
+		if err := h.refreshShowTMDB(ctx, item); err != nil {
+			// A show merged upstream into one already in the library can't
+			// be refreshed; it is logged and the rest carry on.
+			if errors.Is(err, store.ErrTMDBIDTaken) {
+				continue
+			}
+			return err
 		}
+		updated++
+	}
 
-		show := showFromDetail(detail, item.Status)
-		if _, err := h.store.UpsertShow(ctx, &show); err != nil {
-			return internal(err)
+	writeJSON(w, http.StatusOK, &pb.RefreshResponse{Updated: toInt32(updated)})
+	return nil
+}
+
+// refreshShowTMDB stores fresh TMDB details, in the instance language, and
+// external ratings for a show.
+func (h *Handler) refreshShowTMDB(ctx context.Context, item store.TMDBRefresh) error {
+	detail, err := h.fetchDetailsResolving(ctx, h.tmdb, item.ID, item.TMDBID, item.MediaType, item.IMDbID)
+	if err != nil {
+		if errors.Is(err, store.ErrTMDBIDTaken) {
+			return err
 		}
+		return &Error{Status: http.StatusBadGateway, Message: err.Error()}
 	}
 
-	writeJSON(w, http.StatusOK, &pb.RefreshResponse{Updated: toInt32(len(items))})
+	show := showFromDetail(detail, item.Status)
+	id, err := h.store.UpsertShow(ctx, &show)
+	if err != nil {
+		return internal(err)
+	}
+	show.ID = id
+	h.refreshExternalRatings(ctx, &show)
 	return nil
 }
 
+// fetchDetailsResolving fetches TMDB details for a stored show. When TMDB no
+// longer knows the ID (entries get merged/renumbered), it re-resolves the show
+// through its IMDb ID and re-points the row at the new TMDB ID. If another show
+// already has that ID, the row is left alone and store.ErrTMDBIDTaken is
+// returned; either way the remap is logged to library_events.
+func (h *Handler) fetchDetailsResolving(
+	ctx context.Context,
+	client tmdb.MetadataProvider,
+	showID int64,
+	tmdbID int64,
+	mediaType string,
+	imdbID sql.Null[string],
+) (*tmdb.Detail, error) {
+	detail, err := client.FetchDetails(ctx, tmdbID, mediaType)
+	if err == nil || !errors.Is(err, tmdb.ErrNotFound) {
+		return detail, err
+	}
+	if !imdbID.Valid || strings.TrimSpace(imdbID.V) == "" {
+		return nil, err
+	}
+
+	found, ferr := client.FindByIMDbID(ctx, imdbID.V)
+	if ferr != nil {
+		return nil, fmt.Errorf("%w; imdb lookup failed: %w", err, ferr)
+	}
+
+	var newID int64
+	for _, item := range found {
+		if item.MediaType == mediaType {
+			newID = item.ID
+			break
+		}
+	}
+	if newID == 0 || newID == tmdbID {
+		return nil, err
+	}
+
+	detail, err = client.FetchDetails(ctx, newID, mediaType)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.store.RemapTMDBID(ctx, showID, newID); err != nil {
+		if errors.Is(err, store.ErrTMDBIDTaken) {
+			slog.Warn("tmdb id remap skipped: new id already in library",
+				slog.Int64("show_id", showID),
+				slog.String("media_type", mediaType),
+				slog.Int64("old_tmdb_id", tmdbID),
+				slog.Int64("new_tmdb_id", newID),
+			)
+		}
+		return nil, err
+	}
+
+	slog.Info("tmdb id remapped",
+		slog.Int64("show_id", showID),
+		slog.String("media_type", mediaType),
+		slog.Int64("old_tmdb_id", tmdbID),
+		slog.Int64("new_tmdb_id", newID),
+		slog.String("imdb_id", imdbID.V),
+	)
+	return detail, nil
+}
+
 func (h *Handler) getSearch(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
 	req := parseSearchRequest(r)
 	query := strings.TrimSpace(req.Q)
 	filters := searchFiltersFromRequest(req)
-
-	if query != "" {
-		if filters.MediaType != "movie" && filters.MediaType != "tv" {
-			return badRequest("media_type required")
+	if parseBoolParam(req.OurServices) {
+		// TMDB only filters discover by provider; text results would come
+		// back unfiltered.
+		if query != "" {
+			return badRequest("discover-only filter")
 		}
+		region, providers, err := h.ourServices(ctx)
+		if err != nil {
+			return err
+		}
+		filters.WatchRegion, filters.WatchProviders = region, providers
 	}
 
-	pageData, err := h.searchTMDB(ctx, query, filters)
+	pageData, err := h.searchTMDB(ctx, h.metadataClient(r), query, filters)
 	if err != nil {
 		return &Error{Status: http.StatusBadGateway, Message: err.Error()}
 	}
 
-	inLibrary, err := h.lookupInLibrary(ctx, pageData.Results)
+	if query != "" && filters.Page == 1 && !h.readOnly {
+		if err := h.store.RecordSearch(ctx, requestPerson(r), query); err != nil {
+			slog.Warn("record search failed", slog.Any("err", err))
+		}
+	}
+
+	results, err := h.toPBSearchResults(ctx, h.requestLanguage(r), pageData.Results)
+	if err != nil {
+		return internal(err)
+	}
+	people, err := h.toPBPeople(ctx, h.requestLanguage(r), pageData.People)
 	if err != nil {
 		return internal(err)
 	}
+	var region string
+	if parseBoolParam(req.WithProviders) {
+		if region = h.streamingRegion(ctx); region != "" {
+			addSearchProviders(ctx, h.metadataClient(r), region, results)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, &pb.SearchResponse{
+		Results:      results,
+		Page:         toInt32(pageData.Page),
+		TotalPages:   toInt32(pageData.TotalPages),
+		TotalResults: toInt32(pageData.TotalResults),
+		People:       people,
+		Truncated:    pageData.Truncated,
+		HasMore:      pageData.HasMore,
+		PagesScanned: toInt32(pageData.PagesScanned),
+		WatchRegion:  region,
+	})
+	return nil
+}
+
+func (h *Handler) toPBPeople(ctx context.Context, lang string, people []tmdb.Person) ([]*pb.PersonResult, error) {
+	out := make([]*pb.PersonResult, 0, len(people))
+	for _, p := range people {
+		knownFor, err := h.toPBSearchResults(ctx, lang, p.KnownFor)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &pb.PersonResult{
+			Id:                 p.ID,
+			Name:               p.Name,
+			ProfilePath:        p.ProfilePath,
+			KnownForDepartment: p.KnownForDepartment,
+			KnownFor:           knownFor,
+			TmdbUrl:            fmt.Sprintf("https://www.themoviedb.org/person/%d", p.ID),
+		})
+	}
+	return out, nil
+}
+
+func (h *Handler) toPBSearchResults(ctx context.Context, lang string, items []tmdb.SearchResult) ([]*pb.SearchResult, error) {
+	inLibrary, err := h.lookupInLibrary(ctx, items)
+	if err != nil {
+		return nil, err
+	}
 
-	movieGenres, tvGenres := h.genreMaps(ctx)
+	movieGenres, tvGenres := h.genreMaps(ctx, lang)
 
-	results := make([]*pb.SearchResult, 0, len(pageData.Results))
-	for _, item := range pageData.Results {
+	results := make([]*pb.SearchResult, 0, len(items))
+	for _, item := range items {
 		results = append(results, &pb.SearchResult{
 			Id:               item.ID,
 			MediaType:        item.MediaType,
@@ -677,17 +1551,13 @@ func (h *Handler) getSearch(w http.ResponseWriter, r *http.Request) error {
 			OriginalLanguage: item.OriginalLanguage,
 		})
 	}
-
-	writeJSON(w, http.StatusOK, &pb.SearchResponse{
-		Results:      results,
-		Page:         toInt32(pageData.Page),
-		TotalPages:   toInt32(pageData.TotalPages),
-		TotalResults: toInt32(pageData.TotalResults),
-	})
-	return nil
+	return results, nil
 }
 
-func (h *Handler) searchTMDB(ctx context.Context, query string, filters searchFilters) (searchPage, error) {
+// searchTMDB runs a text search or discover query. Filtered searches that
+// walk several TMDB pages return what they have, marked truncated, once
+// searchBudget runs out.
+func (h *Handler) searchTMDB(ctx context.Context, client tmdb.MetadataProvider, query string, filters searchFilters) (searchPage, error) {
 	const perPage = 20
 	const tmdbPageSize = 20
 
@@ -697,11 +1567,13 @@ func (h *Handler) searchTMDB(ctx context.Context, query string, filters searchFi
 
 	if query != "" {
 		mediaType := strings.TrimSpace(filters.MediaType)
-		type pageFetcher func(page int) (tmdb.SearchPage, error)
-		fetch := func(page int) (tmdb.SearchPage, error) {
-			return h.tmdb.SearchPage(ctx, query, mediaType, page)
+		fetch := func(ctx context.Context, page int) (tmdb.SearchPage, error) {
+			if mediaType == "all" {
+				return client.MultiSearchPage(ctx, query, page)
+			}
+			return client.SearchPage(ctx, query, mediaType, page)
 		}
-		startFromFirst := !filters.isEmpty() || filters.Sort != "relevance"
+		startFromFirst := !filters.isEmpty() || filters.Sort != "relevance" || filters.ExcludeLibrary || filters.HideWatched
 		return h.searchWithFilterPaging(ctx, fetch, filters, perPage, tmdbPageSize, startFromFirst, true)
 	}
 
@@ -710,55 +1582,93 @@ func (h *Handler) searchTMDB(ctx context.Context, query string, filters searchFi
 	}
 
 	discoverFilters := tmdb.DiscoverFilters{
-		YearFrom:         filters.YearFrom,
-		YearTo:           filters.YearTo,
-		MinRating:        filters.MinRating,
-		MinVotes:         filters.MinVotes,
-		Genres:           filters.GenreRaw,
-		OriginCountry:    filters.OriginCountry,
-		OriginalLanguage: filters.OriginalLanguage,
+		YearFrom:             filters.YearFrom,
+		YearTo:               filters.YearTo,
+		MinRating:            filters.MinRating,
+		MinVotes:             filters.MinVotes,
+		Genres:               filters.GenreRaw,
+		WithoutGenres:        joinInts(filters.ExcludeGenreIDs, "|"),
+		Companies:            filters.Companies,
+		Networks:             filters.Networks,
+		OriginCountry:        filters.OriginCountry,
+		OriginalLanguage:     filters.OriginalLanguage,
+		WatchRegion:          filters.WatchRegion,
+		WatchProviders:       filters.WatchProviders,
+		CertificationCountry: filters.CertificationCountry,
+		MaxCertification:     filters.MaxCertification,
+	}
+
+	fetch := func(ctx context.Context, page int) (tmdb.SearchPage, error) {
+		return discoverPage(ctx, client, filters.MediaType, discoverFilters, filters.Sort, page)
+	}
+
+	if filters.ExcludeLibrary || filters.HideWatched {
+		// Dropping library items shifts TMDB's page boundaries, so walk from the
+		// first page like filtered text searches do.
+		return h.searchWithFilterPaging(ctx, fetch, filters, perPage, tmdbPageSize, true, false)
+	}
+
+	callCtx, cancelCall := context.WithTimeout(ctx, searchCallTimeout)
+	defer cancelCall()
+	pageData, err := fetch(callCtx, filters.Page)
+	if err != nil {
+		return searchPage{}, err
 	}
+	return searchPage{
+		Results:      pageData.Results,
+		Page:         filters.Page,
+		TotalPages:   pageData.TotalPages,
+		TotalResults: pageData.TotalResults,
+		HasMore:      filters.Page < pageData.TotalPages,
+		PagesScanned: 1,
+	}, nil
+}
 
-	switch filters.MediaType {
+// discoverPage fetches one discover page for a media type, merging the movie
+// and TV pages when mediaType is "all".
+func discoverPage(
+	ctx context.Context,
+	client tmdb.MetadataProvider,
+	mediaType string,
+	filters tmdb.DiscoverFilters,
+	sort string,
+	page int,
+) (tmdb.SearchPage, error) {
+	switch mediaType {
 	case "movie", "tv":
-		discoverFilters.Sort = tmdbSort(filters.Sort, filters.MediaType)
-		pageData, err := h.tmdb.DiscoverPage(ctx, filters.MediaType, discoverFilters, filters.Page)
-		if err != nil {
-			return searchPage{}, err
-		}
-		return searchPage{
-			Results:      pageData.Results,
-			Page:         filters.Page,
-			TotalPages:   pageData.TotalPages,
-			TotalResults: pageData.TotalResults,
-		}, nil
+		filters.Sort = tmdbSort(sort, mediaType)
+		return client.DiscoverPage(ctx, mediaType, filters, page)
 	default:
-		discoverFilters.Sort = tmdbSort(filters.Sort, "movie")
-		movies, err := h.tmdb.DiscoverPage(ctx, "movie", discoverFilters, filters.Page)
+		filters.Sort = tmdbSort(sort, "movie")
+		movies, err := client.DiscoverPage(ctx, "movie", filters, page)
 		if err != nil {
-			return searchPage{}, err
+			return tmdb.SearchPage{}, err
 		}
-		discoverFilters.Sort = tmdbSort(filters.Sort, "tv")
-		tv, err := h.tmdb.DiscoverPage(ctx, "tv", discoverFilters, filters.Page)
+		filters.Sort = tmdbSort(sort, "tv")
+		tv, err := client.DiscoverPage(ctx, "tv", filters, page)
 		if err != nil {
-			return searchPage{}, err
+			return tmdb.SearchPage{}, err
 		}
 		found := make([]tmdb.SearchResult, 0, len(movies.Results)+len(tv.Results))
 		found = append(found, movies.Results...)
 		found = append(found, tv.Results...)
 
-		return searchPage{
+		return tmdb.SearchPage{
 			Results:      found,
-			Page:         filters.Page,
+			Page:         page,
 			TotalPages:   max(movies.TotalPages, tv.TotalPages),
 			TotalResults: movies.TotalResults + tv.TotalResults,
 		}, nil
 	}
 }
 
+// searchWithFilterPaging reads TMDB pages until it has filled the requested
+// page, reading at most searchMaxPages within searchBudget and giving each
+// read searchCallTimeout. When it hits either limit after the first read it
+// stops with what it has.
 func (h *Handler) searchWithFilterPaging(
 	ctx context.Context,
-	fetch func(page int) (tmdb.SearchPage, error),
+	fetch func(ctx context.Context, page int) (tmdb.SearchPage, error),
 	filters searchFilters,
 	perPage int,
 	remotePageSize int,
@@ -776,13 +1686,38 @@ func (h *Handler) searchWithFilterPaging(
 	}
 
 	collected := make([]tmdb.SearchResult, 0, perPage*2)
+	var people []tmdb.Person
 	totalResults := 0
 	totalPages := 1
 	exhausted := false
-
-	for len(collected) < offset+perPage {
-		pageData, err := fetch(tmdbPage)
+	truncated := false
+
+	budget, cancel := context.WithTimeout(ctx, searchBudget)
+	defer cancel()
+
+	pagesRead := 0
+	for ; len(collected) < offset+perPage; pagesRead++ {
+		if pagesRead >= searchMaxPages {
+			slog.Info("search: page cap reached, returning partial results",
+				slog.Int("pages_scanned", pagesRead),
+				slog.Int("collected", len(collected)),
+			)
+			truncated = true
+			break
+		}
+		if pagesRead > 0 && budget.Err() != nil {
+			truncated = true
+			break
+		}
+		callCtx, cancelCall := context.WithTimeout(budget, searchCallTimeout)
+		pageData, err := fetch(callCtx, tmdbPage)
+		cancelCall()
 		if err != nil {
+			if pagesRead > 0 && errors.Is(err, context.DeadlineExceeded) {
+				slog.Warn("search: tmdb too slow, returning partial results", slog.Int("tmdb_page", tmdbPage))
+				truncated = true
+				break
+			}
 			return searchPage{}, err
 		}
 		if pageData.TotalPages > 0 {
@@ -791,15 +1726,22 @@ func (h *Handler) searchWithFilterPaging(
 		if pageData.TotalResults > 0 {
 			totalResults = pageData.TotalResults
 		}
+		if tmdbPage == 1 && filters.Page == 1 {
+			people = pageData.People
+		}
 
+		results := pageData.Results
 		if applyFilters {
-			filtered := applySearchFilters(pageData.Results, filters)
-			collected = append(collected, filtered...)
-		} else {
-			collected = append(collected, pageData.Results...)
+			results = applySearchFilters(results, filters)
+		}
+		results, err = h.excludeLibraryItems(ctx, results, filters)
+		if err != nil {
+			return searchPage{}, err
 		}
+		collected = append(collected, results...)
 
 		if tmdbPage >= pageData.TotalPages || pageData.TotalPages == 0 {
+			pagesRead++
 			exhausted = true
 			break
 		}
@@ -811,7 +1753,7 @@ func (h *Handler) searchWithFilterPaging(
 	}
 	paged := paginateSearchResults(collected, offset, perPage)
 
-	if exhausted {
+	if exhausted || truncated {
 		filteredTotal := len(collected)
 		if filters.Page > 1 {
 			filteredTotal = max(filteredTotal, (filters.Page-1)*perPage+len(paged))
@@ -825,25 +1767,41 @@ func (h *Handler) searchWithFilterPaging(
 
 	return searchPage{
 		Results:      paged,
+		People:       people,
 		Page:         filters.Page,
 		TotalPages:   totalPages,
 		TotalResults: totalResults,
+		Truncated:    truncated,
+		HasMore:      !exhausted || len(collected) > offset+perPage,
+		PagesScanned: pagesRead,
 	}, nil
 }
 
 func parseSearchRequest(r *http.Request) *pb.SearchRequest {
 	query := r.URL.Query()
 	req := &pb.SearchRequest{
-		Q:                strings.TrimSpace(query.Get("q")),
-		MediaType:        strings.TrimSpace(query.Get("media_type")),
-		YearFrom:         strings.TrimSpace(query.Get("year_from")),
-		YearTo:           strings.TrimSpace(query.Get("year_to")),
-		MinRating:        strings.TrimSpace(query.Get("min_rating")),
-		MinVotes:         strings.TrimSpace(query.Get("min_votes")),
-		Sort:             strings.TrimSpace(query.Get("sort")),
-		Genres:           strings.TrimSpace(query.Get("genres")),
-		OriginCountry:    strings.TrimSpace(query.Get("origin_country")),
-		OriginalLanguage: strings.TrimSpace(query.Get("original_language")),
+		Q:                    strings.TrimSpace(query.Get("q")),
+		MediaType:            strings.TrimSpace(query.Get("media_type")),
+		YearFrom:             strings.TrimSpace(query.Get("year_from")),
+		YearTo:               strings.TrimSpace(query.Get("year_to")),
+		MinRating:            strings.TrimSpace(query.Get("min_rating")),
+		MinVotes:             strings.TrimSpace(query.Get("min_votes")),
+		Sort:                 strings.TrimSpace(query.Get("sort")),
+		Genres:               strings.TrimSpace(query.Get("genres")),
+		OriginCountry:        strings.TrimSpace(query.Get("origin_country")),
+		OriginalLanguage:     strings.TrimSpace(query.Get("original_language")),
+		WatchRegion:          strings.TrimSpace(query.Get("watch_region")),
+		WatchProviders:       strings.TrimSpace(query.Get("watch_providers")),
+		CertificationCountry: strings.TrimSpace(query.Get("certification_country")),
+		Certification:        strings.TrimSpace(query.Get("certification")),
+		ExcludeLibrary:       strings.TrimSpace(query.Get("exclude_library")),
+		HideWatched:          strings.TrimSpace(query.Get("hide_watched")),
+		OurServices:          strings.TrimSpace(query.Get("our_services")),
+		Decade:               strings.TrimSpace(query.Get("decade")),
+		GenresExclude:        strings.TrimSpace(query.Get("genres_exclude")),
+		Companies:            strings.TrimSpace(query.Get("companies")),
+		Networks:             strings.TrimSpace(query.Get("networks")),
+		WithProviders:        strings.TrimSpace(query.Get("with_providers")),
 	}
 
 	if val := strings.TrimSpace(query.Get("page")); val != "" {
@@ -875,6 +1833,10 @@ func searchFiltersFromRequest(req *pb.SearchRequest) searchFilters {
 		}
 	}
 
+	if from, to, ok := store.ParseDecade(req.Decade); ok {
+		yearFrom, yearTo = from, to
+	}
+
 	var minRating *float64
 	if val := strings.TrimSpace(req.MinRating); val != "" {
 		if parsed, err := strconv.ParseFloat(val, 64); err == nil && parsed > 0 {
@@ -901,6 +1863,19 @@ func searchFiltersFromRequest(req *pb.SearchRequest) searchFilters {
 
 	genreRaw := strings.TrimSpace(req.Genres)
 	genreIDs, genreMode, genreQuery := parseGenreFilter(genreRaw)
+	excludeGenreIDs, _, _ := parseGenreFilter(strings.ReplaceAll(req.GenresExclude, "|", ","))
+
+	certificationCountry := strings.ToUpper(strings.TrimSpace(req.CertificationCountry))
+	maxCertification := strings.TrimSpace(req.Certification)
+	if certificationCountry == "" || maxCertification == "" {
+		certificationCountry, maxCertification = "", ""
+	}
+
+	watchRegion := strings.ToUpper(strings.TrimSpace(req.WatchRegion))
+	watchProviders := ""
+	if watchRegion != "" {
+		watchProviders = parseIDList(req.WatchProviders)
+	}
 
 	page := 1
 	if req.Page > 0 {
@@ -915,18 +1890,27 @@ func searchFiltersFromRequest(req *pb.SearchRequest) searchFilters {
 	}
 
 	return searchFilters{
-		MediaType:        mediaType,
-		YearFrom:         yearFrom,
-		YearTo:           yearTo,
-		MinRating:        minRating,
-		MinVotes:         minVotes,
-		Sort:             sort,
-		Page:             page,
-		GenreIDs:         genreIDs,
-		GenreMode:        genreMode,
-		GenreRaw:         genreQuery,
-		OriginCountry:    originCountry,
-		OriginalLanguage: originalLanguage,
+		MediaType:            mediaType,
+		YearFrom:             yearFrom,
+		YearTo:               yearTo,
+		MinRating:            minRating,
+		MinVotes:             minVotes,
+		Sort:                 sort,
+		Page:                 page,
+		GenreIDs:             genreIDs,
+		GenreMode:            genreMode,
+		GenreRaw:             genreQuery,
+		ExcludeGenreIDs:      excludeGenreIDs,
+		Companies:            parseIDList(req.Companies),
+		Networks:             parseIDList(req.Networks),
+		OriginCountry:        originCountry,
+		OriginalLanguage:     originalLanguage,
+		WatchRegion:          watchRegion,
+		WatchProviders:       watchProviders,
+		CertificationCountry: certificationCountry,
+		MaxCertification:     maxCertification,
+		ExcludeLibrary:       parseBoolParam(req.ExcludeLibrary),
+		HideWatched:          parseBoolParam(req.HideWatched),
 	}
 }
 
@@ -937,8 +1921,13 @@ func (f searchFilters) isEmpty() bool {
 		f.MinRating == nil &&
 		f.MinVotes == nil &&
 		len(f.GenreIDs) == 0 &&
+		len(f.ExcludeGenreIDs) == 0 &&
+		f.Companies == "" &&
+		f.Networks == "" &&
 		f.OriginCountry == "" &&
-		f.OriginalLanguage == ""
+		f.OriginalLanguage == "" &&
+		f.WatchProviders == "" &&
+		f.MaxCertification == ""
 }
 
 func applySearchFilters(items []tmdb.SearchResult, filters searchFilters) []tmdb.SearchResult {
@@ -982,6 +1971,9 @@ func applySearchFilters(items []tmdb.SearchResult, filters searchFilters) []tmdb
 				continue
 			}
 		}
+		if slices.ContainsFunc(item.GenreIDs, func(id int) bool { return slices.Contains(filters.ExcludeGenreIDs, id) }) {
+			continue
+		}
 		if filters.YearFrom != nil || filters.YearTo != nil {
 			yearPtr := tmdb.ParseYear(item.Year)
 			if yearPtr == nil {
@@ -1036,6 +2028,25 @@ func parseGenreFilter(raw string) ([]int, string, string) {
 	return ids, mode, strings.Join(rawParts, separator)
 }
 
+// parseIDList normalizes a TMDB ID list, keeping its separator semantics:
+// "|" means any of the IDs, "," means all of them.
+func parseIDList(raw string) string {
+	raw = strings.TrimSpace(raw)
+	separator := ","
+	if strings.Contains(raw, "|") {
+		separator = "|"
+	}
+
+	parts := strings.Split(raw, separator)
+	ids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if val, err := strconv.Atoi(strings.TrimSpace(part)); err == nil && val > 0 {
+			ids = append(ids, strconv.Itoa(val))
+		}
+	}
+	return strings.Join(ids, separator)
+}
+
 func matchesGenres(itemIDs []int, filterIDs []int, mode string) bool {
 	if len(filterIDs) == 0 {
 		return true
@@ -1179,23 +2190,51 @@ func (h *Handler) lookupInLibrary(ctx context.Context, items []tmdb.SearchResult
 	return h.store.InLibraryByTMDB(ctx, refs)
 }
 
-func (h *Handler) fetchGenreLists(ctx context.Context) ([]tmdb.Genre, []tmdb.Genre, error) {
-	const cacheTTL = 24 * time.Hour
+// excludeLibraryItems drops results that are in the library (exclude_library)
+// or already watched (hide_watched).
+func (h *Handler) excludeLibraryItems(ctx context.Context, items []tmdb.SearchResult, filters searchFilters) ([]tmdb.SearchResult, error) {
+	if len(items) == 0 || (!filters.ExcludeLibrary && !filters.HideWatched) {
+		return items, nil
+	}
+
+	refs := make([]store.TMDBRef, 0, len(items))
+	for _, item := range items {
+		refs = append(refs, store.TMDBRef{ID: item.ID, MediaType: item.MediaType})
+	}
+	statuses, err := h.store.LibraryStatusByTMDB(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]tmdb.SearchResult, 0, len(items))
+	for _, item := range items {
+		status, ok := statuses[store.TMDBRef{ID: item.ID, MediaType: item.MediaType}]
+		if ok && filters.ExcludeLibrary {
+			continue
+		}
+		if status == "watched" && filters.HideWatched {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
 
+func (h *Handler) fetchGenreLists(ctx context.Context, lang string) ([]tmdb.Genre, []tmdb.Genre, error) {
 	h.genres.mu.RLock()
-	if h.genres.movieList != nil && h.genres.tvList != nil && time.Since(h.genres.fetchedAt) < cacheTTL {
-		movie := append([]tmdb.Genre(nil), h.genres.movieList...)
-		tv := append([]tmdb.Genre(nil), h.genres.tvList...)
+	if cached := h.genres.byLang[lang]; cached != nil && time.Since(cached.fetchedAt) < referenceTTL {
+		movie := append([]tmdb.Genre(nil), cached.movieList...)
+		tv := append([]tmdb.Genre(nil), cached.tvList...)
 		h.genres.mu.RUnlock()
 		return movie, tv, nil
 	}
 	h.genres.mu.RUnlock()
 
-	movieGenres, err := h.tmdb.FetchGenres(ctx, "movie")
+	movieGenres, movieFetched, err := h.loadRefGenres(ctx, lang, "movie")
 	if err != nil {
 		return nil, nil, err
 	}
-	tvGenres, err := h.tmdb.FetchGenres(ctx, "tv")
+	tvGenres, tvFetched, err := h.loadRefGenres(ctx, lang, "tv")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1216,38 +2255,43 @@ func (h *Handler) fetchGenreLists(ctx context.Context) ([]tmdb.Genre, []tmdb.Gen
 	}
 
 	h.genres.mu.Lock()
-	h.genres.movieList = append([]tmdb.Genre(nil), movieGenres...)
-	h.genres.tvList = append([]tmdb.Genre(nil), tvGenres...)
-	h.genres.movie = movieMap
-	h.genres.tv = tvMap
-	h.genres.fetchedAt = time.Now()
+	if h.genres.byLang == nil {
+		h.genres.byLang = make(map[string]*genreLists)
+	}
+	h.genres.byLang[lang] = &genreLists{
+		movieList: append([]tmdb.Genre(nil), movieGenres...),
+		tvList:    append([]tmdb.Genre(nil), tvGenres...),
+		movie:     movieMap,
+		tv:        tvMap,
+		fetchedAt: earliest(movieFetched, tvFetched),
+	}
 	h.genres.mu.Unlock()
 
 	return movieGenres, tvGenres, nil
 }
 
-func (h *Handler) genreMaps(ctx context.Context) (map[int]string, map[int]string) {
-	const cacheTTL = 24 * time.Hour
-
+func (h *Handler) genreMaps(ctx context.Context, lang string) (map[int]string, map[int]string) {
 	h.genres.mu.RLock()
-	if h.genres.movie != nil && h.genres.tv != nil && time.Since(h.genres.fetchedAt) < cacheTTL {
-		movie := h.genres.movie
-		tv := h.genres.tv
+	if cached := h.genres.byLang[lang]; cached != nil && time.Since(cached.fetchedAt) < referenceTTL {
+		movie := cached.movie
+		tv := cached.tv
 		h.genres.mu.RUnlock()
 		return movie, tv
 	}
 	h.genres.mu.RUnlock()
 
-	_, _, err := h.fetchGenreLists(ctx)
+	_, _, err := h.fetchGenreLists(ctx, lang)
 	if err != nil {
 		return nil, nil
 	}
 
 	h.genres.mu.RLock()
-	movie := h.genres.movie
-	tv := h.genres.tv
-	h.genres.mu.RUnlock()
-	return movie, tv
+	defer h.genres.mu.RUnlock()
+	cached := h.genres.byLang[lang]
+	if cached == nil {
+		return nil, nil
+	}
+	return cached.movie, cached.tv
 }
 
 func genreNamesFor(item tmdb.SearchResult, movieGenres, tvGenres map[int]string) []string {
@@ -1282,18 +2326,16 @@ func toPBGenres(items []tmdb.Genre) []*pb.Genre {
 	return out
 }
 
-func (h *Handler) fetchCountryList(ctx context.Context) ([]tmdb.Country, error) {
-	const cacheTTL = 24 * time.Hour
-
+func (h *Handler) fetchCountryList(ctx context.Context, lang string) ([]tmdb.Country, error) {
 	h.countries.mu.RLock()
-	if h.countries.items != nil && time.Since(h.countries.fetchedAt) < cacheTTL {
-		cached := append([]tmdb.Country(nil), h.countries.items...)
+	if cached := h.countries.byLang[lang]; cached != nil && time.Since(cached.fetchedAt) < referenceTTL {
+		items := append([]tmdb.Country(nil), cached.items...)
 		h.countries.mu.RUnlock()
-		return cached, nil
+		return items, nil
 	}
 	h.countries.mu.RUnlock()
 
-	countries, err := h.tmdb.FetchCountries(ctx)
+	countries, fetchedAt, err := h.loadRefCountries(ctx, lang)
 	if err != nil {
 		return nil, err
 	}
@@ -1307,8 +2349,13 @@ func (h *Handler) fetchCountryList(ctx context.Context) ([]tmdb.Country, error)
 	})
 
 	h.countries.mu.Lock()
-	h.countries.items = append([]tmdb.Country(nil), countries...)
-	h.countries.fetchedAt = time.Now()
+	if h.countries.byLang == nil {
+		h.countries.byLang = make(map[string]*countryList)
+	}
+	h.countries.byLang[lang] = &countryList{
+		items:     append([]tmdb.Country(nil), countries...),
+		fetchedAt: fetchedAt,
+	}
 	h.countries.mu.Unlock()
 
 	return countries, nil
@@ -1326,17 +2373,15 @@ func toPBCountries(items []tmdb.Country) []*pb.Country {
 }
 
 func (h *Handler) fetchLanguageList(ctx context.Context) ([]tmdb.Language, error) {
-	const cacheTTL = 24 * time.Hour
-
 	h.languages.mu.RLock()
-	if h.languages.items != nil && time.Since(h.languages.fetchedAt) < cacheTTL {
+	if h.languages.items != nil && time.Since(h.languages.fetchedAt) < referenceTTL {
 		cached := append([]tmdb.Language(nil), h.languages.items...)
 		h.languages.mu.RUnlock()
 		return cached, nil
 	}
 	h.languages.mu.RUnlock()
 
-	languages, err := h.tmdb.FetchLanguages(ctx)
+	languages, fetchedAt, err := h.loadRefLanguages(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1351,7 +2396,7 @@ func (h *Handler) fetchLanguageList(ctx context.Context) ([]tmdb.Language, error
 
 	h.languages.mu.Lock()
 	h.languages.items = append([]tmdb.Language(nil), languages...)
-	h.languages.fetchedAt = time.Now()
+	h.languages.fetchedAt = fetchedAt
 	h.languages.mu.Unlock()
 
 	return languages, nil
@@ -1369,18 +2414,40 @@ func toPBLanguages(items []tmdb.Language) []*pb.Language {
 }
 
 func parseListFilters(r *http.Request) store.ListFilters {
-	country := strings.TrimSpace(r.URL.Query().Get("origin_country"))
-	if country != "" {
-		country = strings.ToUpper(country)
+	filters := store.ListFilters{
+		Status:      r.URL.Query().Get("status"),
+		Genre:       r.URL.Query().Get("genre"),
+		Countries:   parseCountryCodes(r.URL.Query().Get("origin_country")),
+		AvailableOn: strings.ToLower(strings.TrimSpace(r.URL.Query().Get("available_on"))),
+		Sort:        r.URL.Query().Get("sort"),
 	}
 
-	filters := store.ListFilters{
-		Status:  r.URL.Query().Get("status"),
-		Genre:   r.URL.Query().Get("genre"),
-		Country: country,
-		Sort:    r.URL.Query().Get("sort"),
+	if val := r.URL.Query().Get("company"); val != "" {
+		if v, err := strconv.ParseInt(val, 10, 64); err == nil && v > 0 {
+			filters.CompanyID = v
+		}
+	}
+	if val := r.URL.Query().Get("network"); val != "" {
+		if v, err := strconv.ParseInt(val, 10, 64); err == nil && v > 0 {
+			filters.NetworkID = v
+		}
+	}
+	filters.Language = parseLanguageCode(r.URL.Query().Get("language"))
+	filters.AudioLanguage = parseLanguageCode(r.URL.Query().Get("audio_language"))
+	if val := r.URL.Query().Get("min_rt"); val != "" {
+		if v, err := strconv.Atoi(val); err == nil && v > 0 {
+			filters.MinRTScore = &v
+		}
+	}
+	if val := r.URL.Query().Get("min_metascore"); val != "" {
+		if v, err := strconv.Atoi(val); err == nil && v > 0 {
+			filters.MinMetascore = &v
+		}
 	}
 
+	// Genre names, unlike search which takes TMDB genre IDs.
+	filters.ExcludeGenres = splitCommaValues(toSQLNullString(r.URL.Query().Get("genres_exclude")))
+
 	if r.URL.Query().Get("unrated") == "1" {
 		filters.Unrated = true
 	}
@@ -1397,9 +2464,38 @@ func parseListFilters(r *http.Request) store.ListFilters {
 		}
 	}
 
+	if from, to, ok := store.ParseDecade(r.URL.Query().Get("decade")); ok {
+		filters.YearFrom, filters.YearTo = from, to
+	}
+
+	if val := r.URL.Query().Get("max_runtime"); val != "" {
+		if v, err := strconv.Atoi(val); err == nil && v > 0 {
+			filters.MaxRuntime = &v
+		}
+	}
+
+	if r.URL.Query().Get("airing_this_week") == "1" {
+		today := time.Now().UTC()
+		filters.AiringFrom = today.Format(dateLayout)
+		filters.AiringTo = today.AddDate(0, 0, 6).Format(dateLayout)
+	}
+
 	return filters
 }
 
+// parseCountryCodes splits a comma-separated list of ISO 3166-1 codes.
+func parseCountryCodes(raw string) []string {
+	var out []string
+	for _, code := range strings.Split(raw, ",") {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code == "" {
+			continue
+		}
+		out = append(out, code)
+	}
+	return out
+}
+
 func showFromDetail(detail *tmdb.Detail, status string) store.Show {
 	var year sql.Null[int64]
 	if y := tmdb.ParseYear(detail.Year); y != nil {
@@ -1426,6 +2522,29 @@ func showFromDetail(detail *tmdb.Detail, status string) store.Show {
 		originCountry = sql.Null[string]{Valid: true, V: strings.Join(detail.OriginCountry, ", ")}
 	}
 
+	var companies sql.Null[string]
+	companyRefs := make([]store.Company, 0, len(detail.Companies))
+	names := make([]string, 0, len(detail.Companies))
+	for _, c := range detail.Companies {
+		companyRefs = append(companyRefs, store.Company{ID: c.ID, Name: c.Name})
+		// Names are comma-joined for display, like genres.
+		names = append(names, strings.ReplaceAll(c.Name, ",", ""))
+	}
+	if len(names) > 0 {
+		companies = sql.Null[string]{Valid: true, V: strings.Join(names, ", ")}
+	}
+
+	var networks sql.Null[string]
+	networkRefs := make([]store.Network, 0, len(detail.Networks))
+	names = names[:0]
+	for _, n := range detail.Networks {
+		networkRefs = append(networkRefs, store.Network{ID: n.ID, Name: n.Name})
+		names = append(names, strings.ReplaceAll(n.Name, ",", ""))
+	}
+	if len(names) > 0 {
+		networks = sql.Null[string]{Valid: true, V: strings.Join(names, ", ")}
+	}
+
 	return store.Show{
 		TMDBID:        detail.TMDBID,
 		MediaType:     detail.MediaType,
@@ -1435,34 +2554,100 @@ func showFromDetail(detail *tmdb.Detail, status string) store.Show {
 		Overview:      overview,
 		PosterPath:    poster,
 		IMDbID:        toSQLNullString(detail.IMDbID),
+		TVDBID:        toSQLNullNumeric(detail.TVDBID),
+		WikidataID:    toSQLNullString(detail.WikidataID),
 		TMDBRating:    toSQLNullNumeric(detail.VoteAverage),
 		TMDBVotes:     toSQLNullNumeric(int64(detail.VoteCount)),
 		OriginCountry: originCountry,
+		Runtime:       toSQLNullNumeric(int64(detail.Runtime)),
+		EpisodeCount:  toSQLNullNumeric(int64(detail.Episodes)),
+		ReleaseDate:   toSQLNullString(detail.ReleaseDate),
+		NextAirDate:   toSQLNullString(detail.NextAirDate),
+		NextSeason:    toSQLNullNumeric(int64(detail.NextSeason)),
+		NextEpisode:   toSQLNullNumeric(int64(detail.NextEpisode)),
 		Status:        status,
+		Companies:     companies,
+		CompanyRefs:   companyRefs,
+		Networks:      networks,
+		NetworkRefs:   networkRefs,
+
+		AudioLanguages: toSQLNullString(strings.Join(detail.AudioLanguages, ", ")),
+		Translations:   toSQLNullString(strings.Join(detail.Translations, ", ")),
 	}
 }
 
 func toPBShow(show *store.Show) *pb.Show {
+	delta := ratingDelta(show)
 	return &pb.Show{
-		Id:            show.ID,
-		TmdbId:        show.TMDBID,
-		MediaType:     show.MediaType,
-		Title:         show.Title,
-		Year:          fromSQLNull(show.Year),
-		Genres:        fromSQLNull(show.Genres),
-		Overview:      fromSQLNull(show.Overview),
-		PosterPath:    fromSQLNull(show.PosterPath),
-		ImdbId:        fromSQLNull(show.IMDbID),
-		TmdbRating:    fromSQLNull(show.TMDBRating),
-		TmdbVotes:     fromSQLNull(show.TMDBVotes),
-		Status:        show.Status,
-		BfRating:      fromSQLNull(show.BfRating),
-		GfRating:      fromSQLNull(show.GfRating),
-		BfComment:     fromSQLNull(show.BfComment),
-		GfComment:     fromSQLNull(show.GfComment),
-		CreatedAt:     show.CreatedAt,
-		UpdatedAt:     show.UpdatedAt,
-		OriginCountry: splitCommaValues(show.OriginCountry),
+		Id:                show.ID,
+		TmdbId:            show.TMDBID,
+		MediaType:         show.MediaType,
+		Title:             show.Title,
+		Year:              fromSQLNull(show.Year),
+		Genres:            fromSQLNull(show.Genres),
+		Overview:          fromSQLNull(show.Overview),
+		PosterPath:        fromSQLNull(show.PosterPath),
+		ImdbId:            fromSQLNull(show.IMDbID),
+		TmdbRating:        fromSQLNull(show.TMDBRating),
+		TmdbVotes:         fromSQLNull(show.TMDBVotes),
+		RtScore:           fromSQLNull(show.RTScore),
+		Metascore:         fromSQLNull(show.Metascore),
+		AudioLanguages:    splitCommaValues(show.AudioLanguages),
+		Translations:      splitCommaValues(show.Translations),
+		Status:            show.Status,
+		BfRating:          fromSQLNull(show.BfRating),
+		GfRating:          fromSQLNull(show.GfRating),
+		BfComment:         fromSQLNull(show.BfComment),
+		GfComment:         fromSQLNull(show.GfComment),
+		CreatedAt:         show.CreatedAt,
+		UpdatedAt:         show.UpdatedAt,
+		OriginCountry:     splitCommaValues(show.OriginCountry),
+		TvdbId:            fromSQLNull(show.TVDBID),
+		WikidataId:        fromSQLNull(show.WikidataID),
+		Runtime:           fromSQLNull(show.Runtime),
+		Pinned:            show.Pinned,
+		ReleaseDate:       fromSQLNull(show.ReleaseDate),
+		NextAirDate:       fromSQLNull(show.NextAirDate),
+		NextEpisodeSeason: fromSQLNull(show.NextSeason),
+		NextEpisodeNumber: fromSQLNull(show.NextEpisode),
+		CoupleScore:       fromSQLNull(show.CoupleScore),
+		RatingDelta:       fromSQLNull(delta),
+		Agreement:         agreementBucket(delta),
+		WatchedAt:         fromSQLNull(show.WatchedAt),
+		PickedBy:          fromSQLNull(show.PickedBy),
+		VetoedBy:          fromSQLNull(show.VetoedBy),
+		VetoedAt:          fromSQLNull(show.VetoedAt),
+		AvailableOn:       splitCommaValues(show.AvailableOn),
+		RequestStatus:     fromSQLNull(show.RequestStatus),
+		RequestedAt:       fromSQLNull(show.RequestedAt),
+		Companies:         splitCommaValues(show.Companies),
+		Networks:          splitCommaValues(show.Networks),
+		EpisodeCount:      fromSQLNull(show.EpisodeCount),
+	}
+}
+
+func toPBCompanies(companies []store.Company) []*pb.Company {
+	out := make([]*pb.Company, 0, len(companies))
+	for _, c := range companies {
+		out = append(out, &pb.Company{Id: c.ID, Name: c.Name})
+	}
+	return out
+}
+
+func toPBNetworks(networks []store.Network) []*pb.Network {
+	out := make([]*pb.Network, 0, len(networks))
+	for _, n := range networks {
+		out = append(out, &pb.Network{Id: n.ID, Name: n.Name})
+	}
+	return out
+}
+
+func toPBShowDetail(show *store.Show) *pb.ShowDetail {
+	return &pb.ShowDetail{
+		Show:        toPBShow(show),
+		ImdbUrl:     optionalString(imdbURL(show.IMDbID)),
+		TvdbUrl:     optionalString(tvdbURL(show.TVDBID, show.MediaType)),
+		WikidataUrl: optionalString(wikidataURL(show.WikidataID)),
 	}
 }
 
@@ -1482,6 +2667,33 @@ func parseOptionalRating(val *int32) sql.Null[int64] {
 	return sql.Null[int64]{Valid: true, V: int64(n)}
 }
 
+// ratingDelta is |bf - gf|, set only when both people rated the show.
+func ratingDelta(show *store.Show) sql.Null[int64] {
+	if !show.BfRating.Valid || !show.GfRating.Valid {
+		return sql.Null[int64]{}
+	}
+	delta := show.BfRating.V - show.GfRating.V
+	if delta < 0 {
+		delta = -delta
+	}
+	return sql.Null[int64]{Valid: true, V: delta}
+}
+
+// agreementBucket groups a rating delta: "agree" (0-1), "mixed" (2-3) or
+// "disagree" (4+). It is "" until both people rated.
+func agreementBucket(delta sql.Null[int64]) string {
+	switch {
+	case !delta.Valid:
+		return ""
+	case delta.V <= 1:
+		return "agree"
+	case delta.V <= 3:
+		return "mixed"
+	default:
+		return "disagree"
+	}
+}
+
 func nextStatus(current string) string {
 	switch strings.ToLower(strings.TrimSpace(current)) {
 	case "planned":
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+const (
+	testPassword = "shared-secret"
+	testAPIToken = "test-token"
+)
+
+func TestMain(m *testing.M) {
+	// Full-cost PBKDF2 makes every sign-in take a noticeable fraction of a
+	// second.
+	passwordIterations = 1_000
+	os.Exit(m.Run())
+}
+
+// testEnv is a handler over a fresh database and a fake TMDB, served the way
+// main mounts it under /api.
+type testEnv struct {
+	t      *testing.T
+	store  *store.Store
+	tmdb   *fakeTMDB
+	server *httptest.Server
+}
+
+func newTestEnv(t *testing.T, configure ...func(*Config)) *testEnv {
+	t.Helper()
+
+	st, err := store.Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	t.Cleanup(func() { _ = st.Close() })
+
+	fake := newFakeTMDB()
+	cfg := &Config{
+		Store:    st,
+		TMDB:     fake,
+		Password: testPassword,
+		APIToken: testAPIToken,
+	}
+	for _, fn := range configure {
+		fn(cfg)
+	}
+	h, err := New(cfg)
+	if err != nil {
+		t.Fatalf("new handler: %v", err)
+	}
+
+	r := chi.NewRouter()
+	r.Route("/api", func(api chi.Router) {
+		h.RegisterRoutes(api)
+	})
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+
+	return &testEnv{t: t, store: st, tmdb: fake, server: server}
+}
+
+// testClient is one browser: it keeps its own cookies.
+type testClient struct {
+	env    *testEnv
+	client *http.Client
+	// token, when set, is sent as a bearer token.
+	token string
+}
+
+func (e *testEnv) newClient() *testClient {
+	e.t.Helper()
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		e.t.Fatalf("cookie jar: %v", err)
+	}
+	return &testClient{env: e, client: &http.Client{Jar: jar}}
+}
+
+// login signs the client in with the shared password, as person ("" for
+// nobody in particular).
+func (e *testEnv) login(person string) *testClient {
+	e.t.Helper()
+	c := e.newClient()
+	c.mustDo(http.MethodPost, "/api/login", &pb.LoginRequest{Password: testPassword, Person: person}, nil)
+	return c
+}
+
+// signUp gives person an account with password and returns a client signed
+// in to it. Other shared-password sessions are signed out.
+func (e *testEnv) signUp(person, password string) *testClient {
+	e.t.Helper()
+	c := e.login(person)
+	c.mustDo(http.MethodPost, "/api/auth/account-password", &pb.ChangePasswordRequest{
+		CurrentPassword: testPassword,
+		NewPassword:     password,
+	}, nil)
+	return c
+}
+
+// do sends body as JSON (when not nil) and decodes a successful answer into
+// out (when not nil). It returns the status code.
+func (c *testClient) do(method, path string, body, out any) int {
+	c.env.t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			c.env.t.Fatalf("marshal %s %s: %v", method, path, err)
+		}
+		reader = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequest(method, c.env.server.URL+path, reader)
+	if err != nil {
+		c.env.t.Fatalf("new request %s %s: %v", method, path, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		c.env.t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.env.t.Fatalf("read %s %s: %v", method, path, err)
+	}
+	if out != nil && resp.StatusCode < 300 && len(raw) > 0 {
+		if err := json.Unmarshal(raw, out); err != nil {
+			c.env.t.Fatalf("decode %s %s: %v (%s)", method, path, err, raw)
+		}
+	}
+	return resp.StatusCode
+}
+
+// mustDo is do for requests that have to succeed.
+func (c *testClient) mustDo(method, path string, body, out any) {
+	c.env.t.Helper()
+	if status := c.do(method, path, body, out); status >= 300 {
+		c.env.t.Fatalf("%s %s: status %d", method, path, status)
+	}
+}
+
+// expect fails the test unless the request answers want.
+func (c *testClient) expect(want int, method, path string, body any) {
+	c.env.t.Helper()
+	if got := c.do(method, path, body, nil); got != want {
+		c.env.t.Fatalf("%s %s: status %d, want %d", method, path, got, want)
+	}
+}
+
+// addMovie registers a movie with the fake TMDB and adds it to the library.
+func (c *testClient) addMovie(detail tmdb.Detail) *pb.Show {
+	c.env.t.Helper()
+	detail.MediaType = "movie"
+	c.env.tmdb.addDetail("", detail)
+
+	var resp pb.ShowDetail
+	c.mustDo(http.MethodPost, "/api/shows", &pb.AddShowRequest{TmdbId: detail.TMDBID, MediaType: "movie"}, &resp)
+	if resp.Show == nil {
+		c.env.t.Fatalf("add %d: no show in response", detail.TMDBID)
+	}
+	return resp.Show
+}
+
+func showPath(id int64, suffix string) string {
+	return fmt.Sprintf("/api/shows/%d/%s", id, suffix)
+}
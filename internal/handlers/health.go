@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+// getHealth reports whether the server is healthy, for uptime monitors: 503
+// when the database is unreachable or the last integrity check failed. It
+// needs no login, so it answers with the status alone; the details are at
+// getAdminHealth.
+func (h *Handler) getHealth(w http.ResponseWriter, r *http.Request) error {
+	resp, status := h.health(r.Context())
+	writeJSON(w, status, &pb.HealthResponse{Status: resp.Status})
+	return nil
+}
+
+// getAdminHealth is getHealth with the integrity problems and TMDB
+// credential state.
+func (h *Handler) getAdminHealth(w http.ResponseWriter, r *http.Request) error {
+	resp, status := h.health(r.Context())
+	writeJSON(w, status, resp)
+	return nil
+}
+
+// health checks database reachability and the latest integrity check, and
+// picks the HTTP status for them. TMDB credential rotation and a running
+// warm-up are reported for information only.
+func (h *Handler) health(ctx context.Context) (*pb.HealthResponse, int) {
+	resp := &pb.HealthResponse{Status: "ok", IntegrityProblems: []string{}}
+	status := http.StatusOK
+
+	if err := h.store.Ping(ctx); err != nil {
+		resp.Status = "unavailable"
+		status = http.StatusServiceUnavailable
+	}
+
+	if report, ok := h.store.LastIntegrity(); ok {
+		resp.IntegrityCheckedAt = report.CheckedAt
+		resp.IntegrityProblems = report.Problems
+		if len(report.Problems) > 0 {
+			resp.Status = "degraded"
+			status = http.StatusServiceUnavailable
+		}
+	}
+
+	if resp.Status == "ok" && h.warming.Load() {
+		resp.Status = "warming"
+	}
+
+	if client, ok := tmdbCredentialReporter(h.tmdb); ok {
+		creds := client.CredentialStatus()
+		resp.Tmdb = &pb.TMDBCredentials{
+			Count:     toInt32(creds.Count),
+			Active:    toInt32(creds.Active),
+			Failures:  toInt32(creds.Failures),
+			Rotations: toInt32(creds.Rotations),
+		}
+		if !creds.RotatedAt.IsZero() {
+			resp.Tmdb.RotatedAt = creds.RotatedAt.UTC().Format(time.RFC3339)
+		}
+	}
+
+	return resp, status
+}
+
+// tmdbCredentialReporter finds the provider that reports credential rotation,
+// looking through wrappers such as the response cache.
+func tmdbCredentialReporter(provider tmdb.MetadataProvider) (interface{ CredentialStatus() tmdb.CredentialStatus }, bool) {
+	for provider != nil {
+		if client, ok := provider.(interface{ CredentialStatus() tmdb.CredentialStatus }); ok {
+			return client, true
+		}
+		wrapper, ok := provider.(interface{ Unwrap() tmdb.MetadataProvider })
+		if !ok {
+			break
+		}
+		provider = wrapper.Unwrap()
+	}
+	return nil, false
+}
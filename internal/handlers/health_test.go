@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+func TestHealthDetailsNeedLogin(t *testing.T) {
+	env := newTestEnv(t)
+	if _, err := env.store.CheckIntegrity(context.Background()); err != nil {
+		t.Fatalf("integrity check: %v", err)
+	}
+
+	anon := env.newClient()
+	var public pb.HealthResponse
+	anon.mustDo(http.MethodGet, "/api/health", nil, &public)
+	if public.Status != "ok" || public.IntegrityCheckedAt != "" || public.IntegrityProblems != nil {
+		t.Fatalf("public health = %+v, want the status alone", &public)
+	}
+	anon.expect(http.StatusUnauthorized, http.MethodGet, "/api/admin/health", nil)
+
+	var admin pb.HealthResponse
+	env.login("").mustDo(http.MethodGet, "/api/admin/health", nil, &admin)
+	if admin.Status != "ok" || admin.IntegrityCheckedAt == "" {
+		t.Fatalf("admin health = %+v, want the integrity check", &admin)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"log/slog"
 	"math"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -22,13 +24,21 @@ type Number interface {
 		~float32 | ~float64
 }
 
-func hashPassword(password string) string {
-	sum := sha256.Sum256([]byte(password))
+var imdbIDPattern = regexp.MustCompile(`\btt\d{7,}\b`)
+
+// hashToken hashes a random session token for lookup. Passwords go through
+// derivePassword instead.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
 	return hex.EncodeToString(sum[:])
 }
 
 func writeJSON(w http.ResponseWriter, status int, payload any) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writeJSONBody(w, status, payload)
+}
+
+func writeJSONBody(w http.ResponseWriter, status int, payload any) {
 	w.WriteHeader(status)
 
 	if payload == nil {
@@ -67,6 +77,11 @@ func idParam(r *http.Request, name string) (int64, error) {
 	return id, nil
 }
 
+func parseBoolParam(raw string) bool {
+	val, err := strconv.ParseBool(strings.TrimSpace(raw))
+	return err == nil && val
+}
+
 func isNoRows(err error) bool {
 	return errors.Is(err, sql.ErrNoRows)
 }
@@ -83,6 +98,62 @@ func imdbURL(id sql.Null[string]) string {
 	return "https://www.imdb.com/title/" + strings.TrimSpace(id.V) + "/"
 }
 
+// parseIMDbID extracts an IMDb title ID from either a bare ID or an IMDb URL.
+func parseIMDbID(raw string) (string, bool) {
+	id := imdbIDPattern.FindString(strings.TrimSpace(raw))
+	return id, id != ""
+}
+
+// parseTMDBURL extracts the media type and ID from a themoviedb.org URL such as
+// https://www.themoviedb.org/movie/603-the-matrix or .../tv/1399.
+func parseTMDBURL(raw string) (int64, string, bool) {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return 0, "", false
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if host != "themoviedb.org" {
+		return 0, "", false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(parts); i++ {
+		mediaType := parts[i]
+		if mediaType != "movie" && mediaType != "tv" {
+			continue
+		}
+		idPart, _, _ := strings.Cut(parts[i+1], "-")
+		id, err := strconv.ParseInt(idPart, 10, 64)
+		if err != nil || id <= 0 {
+			return 0, "", false
+		}
+		return id, mediaType, true
+	}
+	return 0, "", false
+}
+
+func tvdbURL(id sql.Null[int64], mediaType string) string {
+	if !id.Valid || id.V <= 0 {
+		return ""
+	}
+	kind := "series"
+	if mediaType == "movie" {
+		kind = "movie"
+	}
+	return "https://thetvdb.com/dereferrer/" + kind + "/" + strconv.FormatInt(id.V, 10)
+}
+
+func wikidataURL(id sql.Null[string]) string {
+	if !id.Valid || strings.TrimSpace(id.V) == "" {
+		return ""
+	}
+	return "https://www.wikidata.org/wiki/" + strings.TrimSpace(id.V)
+}
+
 func valueOrDefault[T any](val *T) T {
 	if val == nil {
 		var v T
@@ -118,6 +189,31 @@ func fromSQLNull[T any](v sql.Null[T]) *T {
 	return nil
 }
 
+func toSQLNull[T any](v *T) sql.Null[T] {
+	if v == nil {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{Valid: true, V: *v}
+}
+
+// parseLanguageCode returns a lowercase ISO 639-1 code, or "" when raw is
+// not one.
+func parseLanguageCode(raw string) string {
+	code := strings.ToLower(strings.TrimSpace(raw))
+	if len(code) != 2 || code[0] < 'a' || code[0] > 'z' || code[1] < 'a' || code[1] > 'z' {
+		return ""
+	}
+	return code
+}
+
+func joinInts(vals []int, sep string) string {
+	parts := make([]string, 0, len(vals))
+	for _, v := range vals {
+		parts = append(parts, strconv.Itoa(v))
+	}
+	return strings.Join(parts, sep)
+}
+
 func splitCommaValues(v sql.Null[string]) []string {
 	if !v.Valid {
 		return nil
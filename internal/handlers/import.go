@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/backup"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// maxImportBytes caps uploaded exports; a large library is a few MB.
+const maxImportBytes = 64 << 20
+
+// postImport loads a JSON export (format=json, optionally encrypted) back
+// into the library. mode=merge (the default) only fills in what the library
+// is missing; mode=replace overwrites status, ratings and comments of shows
+// that already exist. Ratings and comments of someone the session may not
+// rate for (see checkOwner) are left alone.
+func (h *Handler) postImport(w http.ResponseWriter, r *http.Request) error {
+	var replace bool
+	switch mode := strings.TrimSpace(r.URL.Query().Get("mode")); mode {
+	case "", "merge":
+	case "replace":
+		replace = true
+	default:
+		return badRequest("invalid mode")
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportBytes))
+	if err != nil {
+		return badRequest("bad request")
+	}
+	if backup.IsEncrypted(data) {
+		if h.backupPassphrase == "" {
+			return badRequest("export is encrypted")
+		}
+		data, err = backup.Decrypt(data, h.backupPassphrase)
+		if err != nil {
+			return badRequest("wrong backup passphrase")
+		}
+	}
+	if err := backup.Verify(data); err != nil && !errors.Is(err, backup.ErrNoManifest) {
+		return badRequest("export is damaged")
+	}
+
+	var payload pb.ExportPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return badRequest("bad request")
+	}
+
+	locked, err := h.lockedPeople(r)
+	if err != nil {
+		return err
+	}
+
+	resp := &pb.ImportResponse{Errors: []string{}}
+	shows := make([]store.Show, 0, len(payload.Shows))
+	for i, show := range payload.Shows {
+		if err := validateImportedShow(show); err != nil {
+			resp.Skipped++
+			resp.Errors = append(resp.Errors, fmt.Sprintf("show %d: %v", i+1, err))
+			continue
+		}
+		shows = append(shows, fromPBShow(show))
+	}
+
+	result, err := h.store.ImportShows(r.Context(), shows, replace, locked...)
+	if err != nil {
+		return internal(err)
+	}
+	resp.Created = toInt32(result.Created)
+	resp.Updated = toInt32(result.Updated)
+	resp.Skipped += toInt32(result.Skipped)
+	resp.Frozen = toInt32(result.Frozen)
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func validateImportedShow(show *pb.Show) error {
+	switch {
+	case show == nil:
+		return errors.New("empty entry")
+	case show.TmdbId <= 0:
+		return errors.New("missing tmdb_id")
+	case show.MediaType != "movie" && show.MediaType != "tv":
+		return fmt.Errorf("invalid media_type %q", show.MediaType)
+	case strings.TrimSpace(show.Title) == "":
+		return errors.New("missing title")
+	case !store.ValidStatus(show.Status):
+		return fmt.Errorf("invalid status %q", show.Status)
+	}
+	for _, rating := range []*int64{show.BfRating, show.GfRating} {
+		if rating != nil && (*rating < 1 || *rating > 10) {
+			return fmt.Errorf("rating %d out of range", *rating)
+		}
+	}
+	return nil
+}
+
+// fromPBShow is the inverse of toPBShow for the stored columns; derived
+// fields (couple score, agreement) are recomputed by the store.
+func fromPBShow(show *pb.Show) store.Show {
+	return store.Show{
+		TMDBID:         show.TmdbId,
+		MediaType:      show.MediaType,
+		Title:          strings.TrimSpace(show.Title),
+		Year:           toSQLNull(show.Year),
+		Genres:         toSQLNull(show.Genres),
+		Overview:       toSQLNull(show.Overview),
+		PosterPath:     toSQLNull(show.PosterPath),
+		IMDbID:         toSQLNull(show.ImdbId),
+		TVDBID:         toSQLNull(show.TvdbId),
+		WikidataID:     toSQLNull(show.WikidataId),
+		TMDBRating:     toSQLNull(show.TmdbRating),
+		TMDBVotes:      toSQLNull(show.TmdbVotes),
+		RTScore:        toSQLNull(show.RtScore),
+		Metascore:      toSQLNull(show.Metascore),
+		OriginCountry:  joinCommaValues(show.OriginCountry),
+		Companies:      joinCommaValues(show.Companies),
+		Networks:       joinCommaValues(show.Networks),
+		Runtime:        toSQLNull(show.Runtime),
+		EpisodeCount:   toSQLNull(show.EpisodeCount),
+		ReleaseDate:    toSQLNull(show.ReleaseDate),
+		NextAirDate:    toSQLNull(show.NextAirDate),
+		NextSeason:     toSQLNull(show.NextEpisodeSeason),
+		NextEpisode:    toSQLNull(show.NextEpisodeNumber),
+		Status:         show.Status,
+		Pinned:         show.Pinned,
+		WatchedAt:      toSQLNull(show.WatchedAt),
+		PickedBy:       toSQLNull(show.PickedBy),
+		AvailableOn:    joinCommaValues(show.AvailableOn),
+		RequestStatus:  toSQLNull(show.RequestStatus),
+		RequestedAt:    toSQLNull(show.RequestedAt),
+		AudioLanguages: joinCommaValues(show.AudioLanguages),
+		Translations:   joinCommaValues(show.Translations),
+		BfRating:       toSQLNull(show.BfRating),
+		GfRating:       toSQLNull(show.GfRating),
+		BfComment:      toSQLNull(show.BfComment),
+		GfComment:      toSQLNull(show.GfComment),
+		CreatedAt:      show.CreatedAt,
+	}
+}
+
+func joinCommaValues(vals []string) sql.Null[string] {
+	return toSQLNullString(strings.Join(vals, ","))
+}
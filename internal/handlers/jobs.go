@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// RefreshStaleTMDB refreshes up to batch shows whose TMDB details are older
+// than staleAfter, oldest first, waiting delay between shows to stay under
+// TMDB's rate limit. It keeps going past failed shows and reports them in
+// the returned error.
+func (h *Handler) RefreshStaleTMDB(ctx context.Context, staleAfter time.Duration, batch int, delay time.Duration) error {
+	before := time.Now().UTC().Add(-staleAfter).Format(time.RFC3339)
+	items, err := h.store.ListTMDBStale(ctx, before, batch)
+	if err != nil {
+		return err
+	}
+
+	failed, skipped := 0, 0
+	var lastErr error
+	for i, item := range items {
+		if i > 0 && delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+		if err := h.refreshShowTMDB(ctx, item); err != nil {
+			if errors.Is(err, store.ErrTMDBIDTaken) {
+				skipped++
+				continue
+			}
+			slog.Warn("tmdb refresh: show failed", slog.Int64("show_id", item.ID), slog.Any("err", err))
+			failed++
+			lastErr = err
+		}
+	}
+
+	slog.Info("tmdb refresh done", slog.Int("refreshed", len(items)-failed-skipped), slog.Int("skipped", skipped), slog.Int("failed", failed))
+	if failed > 0 {
+		return fmt.Errorf("%d of %d shows failed, last: %w", failed, len(items), lastErr)
+	}
+	return nil
+}
+
+// getJobs reports each background job's schedule, last run and last error.
+func (h *Handler) getJobs(w http.ResponseWriter, _ *http.Request) error {
+	resp := &pb.JobsResponse{Jobs: []*pb.JobStatus{}}
+	if h.jobs != nil {
+		for _, status := range h.jobs.Statuses() {
+			resp.Jobs = append(resp.Jobs, &pb.JobStatus{
+				Name:           status.Name,
+				Interval:       status.Interval.String(),
+				Runs:           toInt32(status.Runs),
+				Failures:       toInt32(status.Failures),
+				LastStartedAt:  formatJobTime(status.LastStarted),
+				LastFinishedAt: formatJobTime(status.LastFinished),
+				LastError:      status.LastError,
+				NextRunAt:      formatJobTime(status.NextRun),
+			})
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func formatJobTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+const listKindSmart = "smart"
+
+func (h *Handler) getLists(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	lists, err := h.store.ListSmartLists(ctx)
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.SavedListsResponse{Lists: make([]*pb.SavedList, 0, len(lists))}
+	for i := range lists {
+		list, shows, err := h.materializeList(ctx, &lists[i])
+		if err != nil {
+			return internal(err)
+		}
+		list.ShowCount = toInt32(len(shows))
+		resp.Lists = append(resp.Lists, list)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *Handler) postLists(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	name, criteria, err := decodeSavedListRequest(r)
+	if err != nil {
+		return err
+	}
+
+	id, err := h.store.CreateSmartList(ctx, name, criteria)
+	if err != nil {
+		return internal(err)
+	}
+	return h.writeListDetail(ctx, w, id)
+}
+
+func (h *Handler) getList(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	return h.writeListDetail(r.Context(), w, id)
+}
+
+func (h *Handler) putList(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	name, criteria, err := decodeSavedListRequest(r)
+	if err != nil {
+		return err
+	}
+
+	if err := h.store.UpdateSmartList(ctx, id, name, criteria); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	return h.writeListDetail(ctx, w, id)
+}
+
+func (h *Handler) deleteList(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	if err := h.store.DeleteSmartList(r.Context(), id); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) writeListDetail(ctx context.Context, w http.ResponseWriter, id int64) error {
+	stored, err := h.store.GetSmartList(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	list, shows, err := h.materializeList(ctx, &stored)
+	if err != nil {
+		return internal(err)
+	}
+	list.ShowCount = toInt32(len(shows))
+
+	writeJSON(w, http.StatusOK, &pb.SavedListDetail{
+		List:  list,
+		Shows: toPBShows(shows),
+	})
+	return nil
+}
+
+// materializeList runs a smart list's criteria against the library.
+func (h *Handler) materializeList(ctx context.Context, stored *store.SmartList) (*pb.SavedList, []store.Show, error) {
+	var criteria pb.ListCriteria
+	if err := json.Unmarshal([]byte(stored.Criteria), &criteria); err != nil {
+		return nil, nil, err
+	}
+
+	shows, err := h.store.ListShows(ctx, listFiltersFromCriteria(&criteria))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &pb.SavedList{
+		Id:        stored.ID,
+		Name:      stored.Name,
+		Kind:      listKindSmart,
+		Criteria:  &criteria,
+		CreatedAt: stored.CreatedAt,
+		UpdatedAt: stored.UpdatedAt,
+	}, shows, nil
+}
+
+func decodeSavedListRequest(r *http.Request) (string, string, error) {
+	var req pb.SavedListRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return "", "", badRequest("bad request")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return "", "", badRequest("name required")
+	}
+
+	criteria := req.Criteria
+	if criteria == nil {
+		criteria = &pb.ListCriteria{}
+	}
+	criteria.Status = strings.TrimSpace(criteria.Status)
+	criteria.Genre = strings.TrimSpace(criteria.Genre)
+	criteria.OriginCountry = strings.ToUpper(strings.TrimSpace(criteria.OriginCountry))
+	criteria.Sort = strings.TrimSpace(criteria.Sort)
+
+	encoded, err := json.Marshal(criteria)
+	if err != nil {
+		return "", "", internal(err)
+	}
+	return name, string(encoded), nil
+}
+
+func listFiltersFromCriteria(c *pb.ListCriteria) store.ListFilters {
+	filters := store.ListFilters{
+		Status:    c.Status,
+		Genre:     c.Genre,
+		Countries: parseCountryCodes(c.OriginCountry),
+		Unrated:   c.Unrated,
+		Sort:      c.Sort,
+	}
+	if c.YearFrom != nil {
+		filters.YearFrom = ptr(int(*c.YearFrom))
+	}
+	if c.YearTo != nil {
+		filters.YearTo = ptr(int(*c.YearTo))
+	}
+	if c.MaxRuntime != nil && *c.MaxRuntime > 0 {
+		filters.MaxRuntime = ptr(int(*c.MaxRuntime))
+	}
+	return filters
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// memorySessions keeps the sign-ins and failed sign-ins of a read-only
+// instance, which never writes to the database. Both are lost on restart.
+type memorySessions struct {
+	mu       sync.Mutex
+	nextID   int64
+	sessions map[string]store.Session // by token hash
+	failures map[string][]time.Time   // by IP
+}
+
+func newMemorySessions() *memorySessions {
+	return &memorySessions{
+		sessions: map[string]store.Session{},
+		failures: map[string][]time.Time{},
+	}
+}
+
+// create stores a session that expires after ttl and sets its ID, like
+// store.CreateSession. Expired sessions are dropped on the way.
+func (m *memorySessions) create(session *store.Session, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now().UTC()
+	m.prune(now)
+
+	m.nextID++
+	session.ID = m.nextID
+	session.CreatedAt = now.Format(time.RFC3339)
+	session.LastUsedAt = session.CreatedAt
+	session.ExpiresAt = now.Add(ttl).Format(time.RFC3339)
+	m.sessions[session.TokenHash] = *session
+}
+
+// byToken returns the unexpired session with the token hash.
+func (m *memorySessions) byToken(tokenHash string) (store.Session, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session, ok := m.sessions[tokenHash]
+	if !ok || !unexpired(session, time.Now().UTC()) {
+		return store.Session{}, false
+	}
+	return session, true
+}
+
+// list returns unexpired sessions, newest first.
+func (m *memorySessions) list() []store.Session {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now().UTC()
+	out := []store.Session{}
+	for _, session := range m.sessions {
+		if unexpired(session, now) {
+			out = append(out, session)
+		}
+	}
+	slices.SortFunc(out, func(a, b store.Session) int { return int(b.ID - a.ID) })
+	return out
+}
+
+func (m *memorySessions) delete(id int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for hash, session := range m.sessions {
+		if session.ID == id {
+			delete(m.sessions, hash)
+		}
+	}
+}
+
+func (m *memorySessions) recordFailure(ip string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures[ip] = append(m.failures[ip], time.Now())
+}
+
+// failureCount returns the failed sign-ins from ip since since, forgetting
+// older ones.
+func (m *memorySessions) failureCount(ip string, since time.Time) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	recent := slices.DeleteFunc(m.failures[ip], func(at time.Time) bool { return at.Before(since) })
+	if len(recent) == 0 {
+		delete(m.failures, ip)
+		return 0
+	}
+	m.failures[ip] = recent
+	return int64(len(recent))
+}
+
+func (m *memorySessions) prune(now time.Time) {
+	for hash, session := range m.sessions {
+		if !unexpired(session, now) {
+			delete(m.sessions, hash)
+		}
+	}
+}
+
+// unexpired compares RFC 3339 UTC timestamps as strings, as the store does.
+func unexpired(session store.Session, now time.Time) bool {
+	return session.ExpiresAt > now.Format(time.RFC3339)
+}
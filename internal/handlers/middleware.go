@@ -1,17 +1,122 @@
 package handlers
 
 import (
+	"log/slog"
 	"net/http"
+	"time"
 
-	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/go-chi/chi/v5"
 )
 
+// readOnlyAllowed are mutating routes that only sign in and out, which
+// read-only instances keep in memory (see memorySessions), or set the person
+// cookie.
+var readOnlyAllowed = map[string]bool{
+	"/login":          true,
+	"/logout":         true,
+	"/session/person": true,
+}
+
+// readOnlyDenied are routes that write even on GET.
+var readOnlyDenied = map[string]bool{
+	"/quick-add": true,
+}
+
 func (h *Handler) MiddlewareRequireAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if !h.isAuthenticated(r) {
-			writeJSON(w, http.StatusUnauthorized, &pb.ErrorResponse{Error: "unauthorized"})
+		session, ok := h.authSession(r)
+		if !ok {
+			writeError(w, r, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		if !h.readOnly {
+			if err := h.store.TouchSession(r.Context(), session.ID, clientIP(r)); err != nil {
+				slog.Warn("session touch failed", slog.Any("err", err))
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(withSession(r.Context(), session)))
+	})
+}
+
+// MiddlewareReadOnly rejects mutating requests when the instance runs in
+// read-only mode. Paths are matched relative to where the routes are mounted.
+func (h *Handler) MiddlewareReadOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.readOnly {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		path := r.URL.Path
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+			path = rctx.RoutePath
+		}
+		if readOnlyDenied[path] {
+			writeError(w, r, http.StatusForbidden, "read-only mode")
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if readOnlyAllowed[path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		writeError(w, r, http.StatusForbidden, "read-only mode")
+	})
+}
+
+// MiddlewareLongRunning extends the connection's read and write deadlines for
+// slow endpoints (bulk refresh, export, maintenance), which would otherwise be
+// cut off by the server-wide timeouts. Zero leaves the server defaults alone.
+func (h *Handler) MiddlewareLongRunning(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.longRequestTimeout > 0 {
+			rc := http.NewResponseController(w)
+			deadline := time.Now().Add(h.longRequestTimeout)
+			if err := rc.SetReadDeadline(deadline); err != nil {
+				slog.Debug("long request: read deadline not supported", slog.Any("err", err))
+			}
+			if err := rc.SetWriteDeadline(deadline); err != nil {
+				slog.Debug("long request: write deadline not supported", slog.Any("err", err))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MiddlewareRequireAPIToken accepts only the API token, for media servers.
+// The session cookie doesn't count: a browser would send it on a forged
+// cross-site request.
+func (h *Handler) MiddlewareRequireAPIToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.hasAPIToken(r) {
+			writeError(w, r, http.StatusUnauthorized, "unauthorized")
 			return
 		}
 		next.ServeHTTP(w, r)
 	})
 }
+
+// MiddlewareQuickAddAuth guards quick-add, which adds titles. The API token
+// works for every method; the session cookie only for a POST with a JSON
+// body, so another site can't add titles through a signed-in browser with a
+// link or a form.
+func (h *Handler) MiddlewareQuickAddAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.hasAPIToken(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method == http.MethodPost && isJSONRequest(r) && h.isAuthenticated(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		writeError(w, r, http.StatusUnauthorized, "unauthorized")
+	})
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+const passwordMinLen = 8
+
+// Passwords are stored as PBKDF2-HMAC-SHA256 keys in the form
+// "pbkdf2-sha256$<iterations>$<hex key>". Hashes without the prefix are the
+// older single SHA-256 of salt+password; they still verify and are replaced
+// on the next successful sign-in.
+const (
+	passwordHashPrefix = "pbkdf2-sha256$"
+	passwordKeyLen     = 32
+)
+
+// passwordIterations is the PBKDF2 cost of new hashes; stored hashes carry
+// their own. Tests lower it.
+var passwordIterations = 600_000
+
+// derivePassword hashes password with salt for storage.
+func derivePassword(salt, password string) (string, error) {
+	key, err := pbkdf2.Key(sha256.New, password, []byte(salt), passwordIterations, passwordKeyLen)
+	if err != nil {
+		return "", err
+	}
+	return passwordHashPrefix + strconv.Itoa(passwordIterations) + "$" + hex.EncodeToString(key), nil
+}
+
+// verifyPassword checks password against a stored hash. legacy reports a
+// match against an old SHA-256 hash, which the caller should replace.
+func verifyPassword(salt, password, stored string) (ok, legacy bool) {
+	rest, found := strings.CutPrefix(stored, passwordHashPrefix)
+	if !found {
+		got := hashToken(salt + password)
+		ok = subtle.ConstantTimeCompare([]byte(got), []byte(stored)) == 1
+		return ok, ok
+	}
+	rawIter, rawKey, found := strings.Cut(rest, "$")
+	iterations, err := strconv.Atoi(rawIter)
+	if !found || err != nil || iterations <= 0 {
+		return false, false
+	}
+	want, err := hex.DecodeString(rawKey)
+	if err != nil || len(want) == 0 {
+		return false, false
+	}
+	got, err := pbkdf2.Key(sha256.New, password, []byte(salt), iterations, len(want))
+	if err != nil {
+		return false, false
+	}
+	return subtle.ConstantTimeCompare(got, want) == 1, false
+}
+
+// newPasswordHash returns a fresh salt and the hash of password with it.
+func newPasswordHash(password string) (salt, hash string, err error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	salt = hex.EncodeToString(raw)
+	hash, err = derivePassword(salt, password)
+	return salt, hash, err
+}
+
+// checkPassword compares against the password changed from the app, or
+// APP_PASSWORD when there is none or APP_PASSWORD changed since. A match
+// against a legacy hash stores it again in the current format, except on
+// read-only instances.
+func (h *Handler) checkPassword(ctx context.Context, password string) (bool, error) {
+	stored, ok, err := h.store.GetPassword(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return subtle.ConstantTimeCompare([]byte(password), []byte(h.password)) == 1, nil
+	}
+	// Legacy EnvHash values were unsalted.
+	envSalt := stored.Salt
+	if !strings.HasPrefix(stored.EnvHash, passwordHashPrefix) {
+		envSalt = ""
+	}
+	if envOK, _ := verifyPassword(envSalt, h.password, stored.EnvHash); !envOK {
+		return subtle.ConstantTimeCompare([]byte(password), []byte(h.password)) == 1, nil
+	}
+
+	match, legacy := verifyPassword(stored.Salt, password, stored.Hash)
+	if match && (legacy || envSalt == "") && !h.readOnly {
+		if err := h.savePassword(ctx, password, 0, false); err != nil {
+			slog.Warn("password rehash failed", slog.Any("err", err))
+		}
+	}
+	return match, nil
+}
+
+// savePassword stores password as the shared password. With signOut, every
+// session except keepSessionID is signed out.
+func (h *Handler) savePassword(ctx context.Context, password string, keepSessionID int64, signOut bool) error {
+	salt, hash, err := newPasswordHash(password)
+	if err != nil {
+		return err
+	}
+	envHash, err := derivePassword(salt, h.password)
+	if err != nil {
+		return err
+	}
+	stored := store.StoredPassword{Salt: salt, Hash: hash, EnvHash: envHash}
+	if !signOut {
+		return h.store.SavePassword(ctx, stored)
+	}
+	return h.store.ChangePassword(ctx, stored, keepSessionID)
+}
+
+// postChangePassword replaces the shared password and signs out every other
+// device.
+func (h *Handler) postChangePassword(w http.ResponseWriter, r *http.Request) error {
+	var req pb.ChangePasswordRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+
+	ok, err := h.checkPassword(r.Context(), req.CurrentPassword)
+	if err != nil {
+		return internal(err)
+	}
+	if !ok {
+		slog.Warn("change password: invalid password", slog.String("remote", r.RemoteAddr))
+		return unauthorized("invalid password")
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(req.NewPassword)) < passwordMinLen {
+		return badRequest("password too short")
+	}
+
+	session, _ := h.authSession(r)
+	if err := h.savePassword(r.Context(), req.NewPassword, session.ID, true); err != nil {
+		return internal(err)
+	}
+	slog.Info("password changed", slog.String("remote", r.RemoteAddr))
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
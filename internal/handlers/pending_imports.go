@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+// getPendingImports lists imported rows waiting for someone to pick the
+// right TMDB match.
+func (h *Handler) getPendingImports(w http.ResponseWriter, r *http.Request) error {
+	pending, err := h.store.ListPendingImports(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.PendingImportsResponse{Pending: make([]*pb.PendingImport, 0, len(pending))}
+	for i := range pending {
+		row := &pending[i]
+		candidates, err := h.toPBPendingCandidates(r, row.Candidates)
+		if err != nil {
+			return err
+		}
+		resp.Pending = append(resp.Pending, &pb.PendingImport{
+			Id:         row.ID,
+			Source:     row.Source,
+			Line:       toInt32(row.Line),
+			Title:      row.Title,
+			Year:       row.Year,
+			MediaType:  row.MediaType,
+			Status:     row.Status,
+			BfRating:   fromSQLNull(row.BfRating),
+			GfRating:   fromSQLNull(row.GfRating),
+			Candidates: candidates,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// postPendingImportConfirm imports a pending row as the chosen TMDB entry,
+// merging its ratings like the original import would have, and drops it
+// from review.
+func (h *Handler) postPendingImportConfirm(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	var req pb.ConfirmImportRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	if req.TmdbId <= 0 {
+		return badRequest("tmdb_id required")
+	}
+	if req.MediaType != "movie" && req.MediaType != "tv" {
+		return badRequest("invalid media_type")
+	}
+
+	locked, err := h.lockedPeople(r)
+	if err != nil {
+		return err
+	}
+
+	pending, err := h.store.GetPendingImport(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	stored, err := h.addShow(ctx, req.TmdbId, req.MediaType, store.StatusPlanned)
+	if err != nil {
+		return err
+	}
+	stored.BfRating = pending.BfRating
+	stored.GfRating = pending.GfRating
+	stored.BfComment = pending.BfComment
+	stored.GfComment = pending.GfComment
+	if _, err := h.store.ImportShows(ctx, []store.Show{stored}, false, locked...); err != nil {
+		return internal(err)
+	}
+	if pending.Status == store.StatusWatched && stored.Status != store.StatusWatched {
+		if err := h.markImportedWatched(ctx, stored.ID); err != nil {
+			return internal(err)
+		}
+	}
+
+	if err := h.store.DeletePendingImport(ctx, id); err != nil && !isNoRows(err) {
+		return internal(err)
+	}
+	return h.writeShowDetail(w, r, stored.ID)
+}
+
+// deletePendingImport dismisses a pending row without importing it.
+func (h *Handler) deletePendingImport(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	if err := h.store.DeletePendingImport(r.Context(), id); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) toPBPendingCandidates(r *http.Request, candidates []store.PendingCandidate) ([]*pb.SearchResult, error) {
+	items := make([]tmdb.SearchResult, 0, len(candidates))
+	for _, c := range candidates {
+		items = append(items, tmdb.SearchResult(c))
+	}
+	results, err := h.toPBSearchResults(r.Context(), h.requestLanguage(r), items)
+	if err != nil {
+		return nil, internal(err)
+	}
+	return results, nil
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+const planNoteMaxLen = 500
+
+// getPlans lists upcoming and unfinished movie nights; ?all=1 adds the
+// completed ones.
+func (h *Handler) getPlans(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	plans, err := h.store.ListPlans(ctx, r.URL.Query().Get("all") == "1")
+	if err != nil {
+		return internal(err)
+	}
+	resp, err := h.toPBPlans(ctx, plans)
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, &pb.PlansResponse{Plans: resp})
+	return nil
+}
+
+// postPlans schedules a show. The session's person, if any, is confirmed
+// right away.
+func (h *Handler) postPlans(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var req pb.PlanRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	if req.ScheduledAt == nil {
+		return badRequest("invalid scheduled_at")
+	}
+	plan := store.Plan{ShowID: req.ShowId}
+	if err := applyPlanRequest(&plan, &req); err != nil {
+		return err
+	}
+	if _, err := h.store.GetShow(ctx, req.ShowId); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	plan.Confirm(requestPerson(r), true)
+
+	if err := h.store.CreatePlan(ctx, &plan); err != nil {
+		return internal(err)
+	}
+	return h.writePlan(ctx, w, plan.ID)
+}
+
+func (h *Handler) getPlan(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	return h.writePlan(r.Context(), w, id)
+}
+
+// patchPlan reschedules or edits the note. Moving the date resets the other
+// partner's confirmation.
+func (h *Handler) patchPlan(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	plan, err := h.loadPlan(r)
+	if err != nil {
+		return err
+	}
+
+	var req pb.PlanRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	before := plan.ScheduledAt
+	if err := applyPlanRequest(&plan, &req); err != nil {
+		return err
+	}
+	if plan.ScheduledAt != before {
+		plan.BfConfirmed, plan.GfConfirmed = false, false
+		plan.Confirm(requestPerson(r), true)
+	}
+
+	if err := h.store.SavePlan(ctx, &plan); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	return h.writePlan(ctx, w, plan.ID)
+}
+
+func (h *Handler) deletePlan(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	if err := h.store.DeletePlan(r.Context(), id); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// postPlanRSVP records whether a partner is coming.
+func (h *Handler) postPlanRSVP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	plan, err := h.loadPlan(r)
+	if err != nil {
+		return err
+	}
+
+	var req pb.RSVPRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	person := strings.TrimSpace(req.Person)
+	if person == "" {
+		person = requestPerson(r)
+	}
+	if !store.ValidPerson(person) {
+		return badRequest("invalid person")
+	}
+	plan.Confirm(person, req.Going)
+
+	if err := h.store.SavePlan(ctx, &plan); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	return h.writePlan(ctx, w, plan.ID)
+}
+
+// postPlanComplete closes the plan and marks its show watched now.
+func (h *Handler) postPlanComplete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	plan, err := h.loadPlan(r)
+	if err != nil {
+		return err
+	}
+	if plan.CompletedAt.Valid {
+		return &Error{Status: http.StatusConflict, Message: "plan completed"}
+	}
+
+	if err := h.store.CompletePlan(ctx, plan.ID); err != nil {
+		return showWriteError(err)
+	}
+	return h.writePlan(ctx, w, plan.ID)
+}
+
+func (h *Handler) loadPlan(r *http.Request) (store.Plan, error) {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return store.Plan{}, notFound("not found")
+	}
+	plan, err := h.store.GetPlan(r.Context(), id)
+	if err != nil {
+		if isNoRows(err) {
+			return store.Plan{}, notFound("not found")
+		}
+		return store.Plan{}, internal(err)
+	}
+	return plan, nil
+}
+
+// applyPlanRequest copies the set fields of req onto plan.
+func applyPlanRequest(plan *store.Plan, req *pb.PlanRequest) error {
+	if req.ScheduledAt != nil {
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(*req.ScheduledAt))
+		if err != nil {
+			return badRequest("invalid scheduled_at")
+		}
+		plan.ScheduledAt = t.UTC().Format(time.RFC3339)
+	}
+	if req.Note != nil {
+		note := strings.TrimSpace(*req.Note)
+		if utf8.RuneCountInString(note) > planNoteMaxLen {
+			return badRequest("note too long")
+		}
+		plan.Note = sql.Null[string]{V: note, Valid: note != ""}
+	}
+	return nil
+}
+
+func (h *Handler) writePlan(ctx context.Context, w http.ResponseWriter, id int64) error {
+	plan, err := h.store.GetPlan(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	resp, err := h.toPBPlans(ctx, []store.Plan{plan})
+	if err != nil {
+		return internal(err)
+	}
+	writeJSON(w, http.StatusOK, resp[0])
+	return nil
+}
+
+func (h *Handler) toPBPlans(ctx context.Context, plans []store.Plan) ([]*pb.Plan, error) {
+	ids := make([]int64, 0, len(plans))
+	for _, plan := range plans {
+		ids = append(ids, plan.ShowID)
+	}
+	shows, err := h.store.ShowsByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*pb.Plan, 0, len(plans))
+	for _, plan := range plans {
+		item := &pb.Plan{
+			Id:          plan.ID,
+			ScheduledAt: plan.ScheduledAt,
+			Note:        fromSQLNull(plan.Note),
+			BfConfirmed: plan.BfConfirmed,
+			GfConfirmed: plan.GfConfirmed,
+			Confirmed:   plan.BfConfirmed && plan.GfConfirmed,
+			CompletedAt: fromSQLNull(plan.CompletedAt),
+			CreatedAt:   plan.CreatedAt,
+		}
+		if show, ok := shows[plan.ShowID]; ok {
+			item.Show = toPBShow(&show)
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/i18n"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+// personLanguage returns the metadata language the session's person picked,
+// or "" when there is no person or preference.
+func (h *Handler) personLanguage(r *http.Request) string {
+	person := requestPerson(r)
+	if person == "" {
+		return ""
+	}
+	prefs, err := h.store.GetPreferences(r.Context(), person)
+	if err != nil {
+		slog.Warn("load preferences failed", slog.Any("err", err))
+		return ""
+	}
+	if !prefs.MetadataLanguage.Valid {
+		return ""
+	}
+	return prefs.MetadataLanguage.V
+}
+
+// metadataClient is the TMDB client for what is only shown to the person
+// (searches, recommendations, lookups): their preferred language, falling
+// back to the instance default. Stored metadata always uses h.tmdb.
+func (h *Handler) metadataClient(r *http.Request) tmdb.MetadataProvider {
+	if lang := h.personLanguage(r); lang != "" {
+		return h.tmdb.WithLanguage(lang)
+	}
+	return h.tmdb
+}
+
+// requestLanguage is the TMDB language for reference data: the person's
+// preference, then Accept-Language, then the instance default.
+func (h *Handler) requestLanguage(r *http.Request) string {
+	if lang := h.personLanguage(r); lang != "" {
+		return lang
+	}
+	if lang := i18n.Preferred(r.Header.Get("Accept-Language")); lang != "" {
+		return lang
+	}
+	return h.tmdb.Language()
+}
+
+func (h *Handler) getPreferences(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	resp := &pb.PreferencesResponse{DefaultMetadataLanguage: h.tmdb.Language()}
+	for _, person := range []string{store.PersonBf, store.PersonGf} {
+		prefs, err := h.store.GetPreferences(ctx, person)
+		if err != nil {
+			return internal(err)
+		}
+		resp.Preferences = append(resp.Preferences, toPBPreferences(&prefs))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *Handler) putPreferences(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	person := chi.URLParam(r, "person")
+	if !store.ValidPerson(person) {
+		return notFound("not found")
+	}
+	if err := h.checkOwner(r, person, notYourAccount()); err != nil {
+		return err
+	}
+
+	var req pb.UpdatePreferencesRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+
+	if req.MetadataLanguage != nil {
+		lang := toSQLNullString(i18n.Normalize(*req.MetadataLanguage))
+		if strings.TrimSpace(*req.MetadataLanguage) != "" && !lang.Valid {
+			// English is TMDB's default; store it explicitly so it wins over
+			// a non-English instance default.
+			lang = toSQLNullString("en-US")
+		}
+		if err := h.store.SetMetadataLanguage(ctx, person, lang); err != nil {
+			return internal(err)
+		}
+	}
+
+	prefs, err := h.store.GetPreferences(ctx, person)
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, toPBPreferences(&prefs))
+	return nil
+}
+
+func (h *Handler) postSessionPerson(w http.ResponseWriter, r *http.Request) error {
+	var req pb.SetPersonRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	person := strings.TrimSpace(req.Person)
+	if !store.ValidPerson(person) {
+		return badRequest("invalid person")
+	}
+	if account := accountPerson(r); account != "" && account != person {
+		return &Error{Status: http.StatusForbidden, Message: "person mismatch"}
+	}
+	if err := h.checkOwner(r, person, &Error{Status: http.StatusForbidden, Message: "person has an account"}); err != nil {
+		return err
+	}
+
+	h.setPersonCookie(w, person)
+
+	resp := h.sessionResponse(true)
+	resp.Person = ptr(person)
+	resp.Account = accountPerson(r) != ""
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func toPBPreferences(prefs *store.Preferences) *pb.Preferences {
+	return &pb.Preferences{
+		Person:           prefs.Person,
+		MetadataLanguage: fromSQLNull(prefs.MetadataLanguage),
+	}
+}
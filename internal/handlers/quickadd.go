@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+const quickAddMaxCandidates = 5
+
+var trailingYearPattern = regexp.MustCompile(`\s*\(?((?:19|20)\d{2})\)?\s*$`)
+
+// handleQuickAdd adds a title in a single call: the query may be a TMDB URL, an
+// IMDb ID/URL, or free text. Free text is only added when there is exactly one
+// confident match; otherwise the top candidates are returned.
+func (h *Handler) handleQuickAdd(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var req pb.QuickAddRequest
+	if isJSONRequest(r) {
+		if err := decodeJSON(r, &req); err != nil {
+			return badRequest("bad request")
+		}
+	} else {
+		req.Query = r.FormValue("query")
+		if strings.TrimSpace(req.Query) == "" {
+			req.Query = r.FormValue("q")
+		}
+		req.MediaType = r.FormValue("media_type")
+		req.Status = r.FormValue("status")
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return badRequest("query required")
+	}
+
+	mediaType := strings.TrimSpace(req.MediaType)
+	if mediaType != "" && mediaType != "movie" && mediaType != "tv" {
+		return badRequest("invalid media_type")
+	}
+
+	status := strings.TrimSpace(req.Status)
+	if status != "planned" && status != "watched" {
+		status = "planned"
+	}
+
+	tmdbID, resolvedType, err := h.resolveQuickAdd(ctx, query, mediaType)
+	if err != nil {
+		return err
+	}
+	if tmdbID == 0 {
+		candidates, err := h.quickAddCandidates(ctx, h.metadataClient(r), query, mediaType)
+		if err != nil {
+			return err
+		}
+		if len(candidates) != 1 || !candidates[0].confident {
+			items := make([]tmdb.SearchResult, 0, len(candidates))
+			for _, c := range candidates {
+				items = append(items, c.SearchResult)
+			}
+			results, err := h.toPBSearchResults(ctx, h.requestLanguage(r), items)
+			if err != nil {
+				return internal(err)
+			}
+			writeJSON(w, http.StatusOK, &pb.QuickAddResponse{Candidates: results})
+			return nil
+		}
+		tmdbID, resolvedType = candidates[0].ID, candidates[0].MediaType
+	}
+
+	if id, err := h.store.GetShowIDByTMDB(ctx, tmdbID, resolvedType); err == nil {
+		existing, err := h.store.GetShow(ctx, id)
+		if err != nil {
+			return internal(err)
+		}
+		writeJSON(w, http.StatusOK, &pb.QuickAddResponse{Show: toPBShowDetail(&existing)})
+		return nil
+	} else if !isNoRows(err) {
+		return internal(err)
+	}
+
+	stored, err := h.addShow(ctx, tmdbID, resolvedType, status)
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, &pb.QuickAddResponse{
+		Added: true,
+		Show:  toPBShowDetail(&stored),
+	})
+	return nil
+}
+
+// isJSONRequest reports whether the request body is declared as JSON. Browsers
+// can't send that cross-site without a CORS preflight, which the API doesn't
+// answer.
+func isJSONRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "application/json"
+}
+
+// resolveQuickAdd handles queries that identify a title exactly (TMDB or IMDb
+// links). It returns a zero ID when the query is free text.
+func (h *Handler) resolveQuickAdd(ctx context.Context, query, mediaType string) (int64, string, error) {
+	if tmdbID, urlMediaType, ok := parseTMDBURL(query); ok {
+		return tmdbID, urlMediaType, nil
+	}
+	if _, ok := parseIMDbID(query); ok {
+		return h.resolveAddTarget(ctx, &pb.AddShowRequest{ImdbId: query, MediaType: mediaType})
+	}
+	return 0, "", nil
+}
+
+type quickAddCandidate struct {
+	tmdb.SearchResult
+	confident bool
+}
+
+func (h *Handler) quickAddCandidates(ctx context.Context, client tmdb.MetadataProvider, query, mediaType string) ([]quickAddCandidate, error) {
+	title := query
+	year := ""
+	if m := trailingYearPattern.FindStringSubmatch(query); m != nil && strings.TrimSpace(query[:len(query)-len(m[0])]) != "" {
+		year = m[1]
+		title = strings.TrimSpace(query[:len(query)-len(m[0])])
+	}
+
+	mediaTypes := []string{"movie", "tv"}
+	if mediaType != "" {
+		mediaTypes = []string{mediaType}
+	}
+
+	var found []tmdb.SearchResult
+	for _, mt := range mediaTypes {
+		page, err := client.SearchPage(ctx, title, mt, 1)
+		if err != nil {
+			return nil, &Error{Status: http.StatusBadGateway, Message: err.Error()}
+		}
+		found = append(found, page.Results...)
+	}
+	if year != "" {
+		filtered := make([]tmdb.SearchResult, 0, len(found))
+		for _, item := range found {
+			if item.Year == year {
+				filtered = append(filtered, item)
+			}
+		}
+		found = filtered
+	}
+	if len(found) == 0 {
+		return nil, notFound("no matches")
+	}
+
+	var exact []quickAddCandidate
+	for _, item := range found {
+		if strings.EqualFold(strings.TrimSpace(item.Title), title) {
+			exact = append(exact, quickAddCandidate{SearchResult: item, confident: true})
+		}
+	}
+	if len(exact) == 1 {
+		return exact, nil
+	}
+	if len(found) == 1 {
+		return []quickAddCandidate{{SearchResult: found[0], confident: true}}, nil
+	}
+
+	// Ambiguous: prefer exact title matches, then the most voted-on results.
+	found = applySearchSort(found, "votes", false)
+	out := make([]quickAddCandidate, 0, quickAddMaxCandidates)
+	for _, c := range exact {
+		if len(out) == quickAddMaxCandidates {
+			break
+		}
+		c.confident = false
+		out = append(out, c)
+	}
+	for _, item := range found {
+		if len(out) == quickAddMaxCandidates {
+			break
+		}
+		if strings.EqualFold(strings.TrimSpace(item.Title), title) {
+			continue
+		}
+		out = append(out, quickAddCandidate{SearchResult: item})
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// showWriteError maps a failed rating or status write to its response.
+func showWriteError(err error) error {
+	switch {
+	case isNoRows(err):
+		return notFound("not found")
+	case errors.Is(err, store.ErrRatingsFrozen):
+		return &Error{Status: http.StatusConflict, Message: "ratings are frozen"}
+	case errors.Is(err, store.ErrRatingsRequired):
+		return &Error{Status: http.StatusConflict, Message: "ratings required"}
+	}
+	return internal(err)
+}
+
+// postUnfreezeRatings reopens a show's frozen ratings for another freeze
+// period. Like erase, it asks for the caller's password again, so an open
+// session alone can't undo the freeze.
+func (h *Handler) postUnfreezeRatings(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	var req pb.UnfreezeRatingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	ok, _, err := h.checkLogin(ctx, accountPerson(r), req.Password)
+	if err != nil {
+		return internal(err)
+	}
+	if !ok {
+		return unauthorized("invalid password")
+	}
+	slog.Info("ratings unfrozen", append(requestAttrs(r), slog.Int64("show_id", id))...)
+	if err := h.store.UnfreezeRatings(ctx, id); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	show, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, toPBShowDetail(&show))
+	return nil
+}
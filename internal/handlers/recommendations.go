@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+const (
+	recommendationSeeds     = 5
+	recommendationMinRating = 7
+	recommendationRowSize   = 12
+)
+
+// getRecommendations builds "Because you both liked X" rows from TMDB
+// recommendations for the best jointly rated shows, skipping anything already
+// in the library or shown in an earlier row.
+func (h *Handler) getRecommendations(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	seeds, err := h.store.ListTopJoint(ctx, recommendationMinRating, recommendationSeeds)
+	if err != nil {
+		return internal(err)
+	}
+
+	client := h.metadataClient(r)
+	lang := h.requestLanguage(r)
+	seen := map[store.TMDBRef]struct{}{}
+
+	resp := &pb.RecommendationsResponse{Rows: make([]*pb.RecommendationRow, 0, len(seeds))}
+	for i := range seeds {
+		seed := &seeds[i]
+
+		page, err := client.FetchRecommendations(ctx, seed.TMDBID, seed.MediaType)
+		if err != nil {
+			// One failed seed should not hide the other rows.
+			slog.Warn("recommendations: tmdb fetch failed", slog.Int64("show_id", seed.ID), slog.Any("err", err))
+			continue
+		}
+
+		items, err := h.excludeLibraryItems(ctx, page.Results, searchFilters{ExcludeLibrary: true})
+		if err != nil {
+			return internal(err)
+		}
+
+		fresh := make([]tmdb.SearchResult, 0, recommendationRowSize)
+		for _, item := range items {
+			if len(fresh) == recommendationRowSize {
+				break
+			}
+			ref := store.TMDBRef{ID: item.ID, MediaType: item.MediaType}
+			if _, ok := seen[ref]; ok {
+				continue
+			}
+			seen[ref] = struct{}{}
+			fresh = append(fresh, item)
+		}
+		if len(fresh) == 0 {
+			continue
+		}
+
+		results, err := h.toPBSearchResults(ctx, lang, fresh)
+		if err != nil {
+			return internal(err)
+		}
+		resp.Rows = append(resp.Rows, &pb.RecommendationRow{
+			Label:   "Because you both liked " + seed.Title,
+			Source:  toPBShow(seed),
+			Results: results,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
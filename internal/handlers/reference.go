@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+const (
+	// referenceTTL is how long TMDB genres, countries and languages are used
+	// before they are fetched again.
+	referenceTTL = 24 * time.Hour
+	// referenceRetry is how soon TMDB is tried again after stored reference
+	// data was served because it failed.
+	referenceRetry = 5 * time.Minute
+)
+
+// loadReference returns reference data stored in the database while it is
+// fresh, and otherwise fetches it and stores the result. When the fetch fails,
+// older stored data is served instead. The returned time is the fetch time the
+// in-memory copy should carry.
+func loadReference[T any](
+	ctx context.Context,
+	name string,
+	readOnly bool,
+	load func() ([]T, time.Time, error),
+	fetch func() ([]T, error),
+	save func([]T) error,
+) ([]T, time.Time, error) {
+	stored, fetchedAt, err := load()
+	if err != nil {
+		slog.Warn("reference data: load failed", slog.String("name", name), slog.Any("err", err))
+		stored = nil
+	}
+	if stored != nil && time.Since(fetchedAt) < referenceTTL {
+		return stored, fetchedAt, nil
+	}
+
+	items, err := fetch()
+	if err != nil {
+		if stored == nil || ctx.Err() != nil {
+			return nil, time.Time{}, err
+		}
+		slog.Warn("reference data: tmdb failed, serving stored copy",
+			slog.String("name", name),
+			slog.Time("fetched_at", fetchedAt),
+			slog.Any("err", err),
+		)
+		return stored, time.Now().Add(referenceRetry - referenceTTL), nil
+	}
+	if !readOnly {
+		if err := save(items); err != nil {
+			slog.Warn("reference data: save failed", slog.String("name", name), slog.Any("err", err))
+		}
+	}
+	return items, time.Now(), nil
+}
+
+func (h *Handler) loadRefGenres(ctx context.Context, lang, mediaType string) ([]tmdb.Genre, time.Time, error) {
+	return loadReference(ctx, "genres", h.readOnly,
+		func() ([]tmdb.Genre, time.Time, error) {
+			rows, fetchedAt, err := h.store.RefGenres(ctx, lang, mediaType)
+			if err != nil || rows == nil {
+				return nil, fetchedAt, err
+			}
+			out := make([]tmdb.Genre, 0, len(rows))
+			for _, row := range rows {
+				out = append(out, tmdb.Genre(row))
+			}
+			return out, fetchedAt, nil
+		},
+		func() ([]tmdb.Genre, error) {
+			return h.tmdb.WithLanguage(lang).FetchGenres(ctx, mediaType)
+		},
+		func(genres []tmdb.Genre) error {
+			rows := make([]store.RefGenre, 0, len(genres))
+			for _, genre := range genres {
+				rows = append(rows, store.RefGenre(genre))
+			}
+			return h.store.SaveRefGenres(ctx, lang, mediaType, rows)
+		},
+	)
+}
+
+func (h *Handler) loadRefCountries(ctx context.Context, lang string) ([]tmdb.Country, time.Time, error) {
+	return loadReference(ctx, "countries", h.readOnly,
+		func() ([]tmdb.Country, time.Time, error) {
+			rows, fetchedAt, err := h.store.RefCountries(ctx, lang)
+			if err != nil || rows == nil {
+				return nil, fetchedAt, err
+			}
+			out := make([]tmdb.Country, 0, len(rows))
+			for _, row := range rows {
+				out = append(out, tmdb.Country(row))
+			}
+			return out, fetchedAt, nil
+		},
+		func() ([]tmdb.Country, error) {
+			return h.tmdb.WithLanguage(lang).FetchCountries(ctx)
+		},
+		func(countries []tmdb.Country) error {
+			rows := make([]store.RefCountry, 0, len(countries))
+			for _, country := range countries {
+				rows = append(rows, store.RefCountry(country))
+			}
+			return h.store.SaveRefCountries(ctx, lang, rows)
+		},
+	)
+}
+
+func (h *Handler) loadRefLanguages(ctx context.Context) ([]tmdb.Language, time.Time, error) {
+	return loadReference(ctx, "languages", h.readOnly,
+		func() ([]tmdb.Language, time.Time, error) {
+			rows, fetchedAt, err := h.store.RefLanguages(ctx)
+			if err != nil || rows == nil {
+				return nil, fetchedAt, err
+			}
+			out := make([]tmdb.Language, 0, len(rows))
+			for _, row := range rows {
+				out = append(out, tmdb.Language(row))
+			}
+			return out, fetchedAt, nil
+		},
+		func() ([]tmdb.Language, error) {
+			return h.tmdb.FetchLanguages(ctx)
+		},
+		func(languages []tmdb.Language) error {
+			rows := make([]store.RefLanguage, 0, len(languages))
+			for _, language := range languages {
+				rows = append(rows, store.RefLanguage(language))
+			}
+			return h.store.SaveRefLanguages(ctx, rows)
+		},
+	)
+}
+
+// earliest returns the earlier of two times.
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
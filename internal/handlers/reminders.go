@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+const snoozeMaxDays = 365
+
+// getReminders lists the watched shows the person still has to rate. The
+// list is returned while snoozed too; only the scheduled reminder pauses.
+func (h *Handler) getReminders(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	person := chi.URLParam(r, "person")
+	if !store.ValidPerson(person) {
+		return notFound("not found")
+	}
+
+	prefs, err := h.store.GetPreferences(ctx, person)
+	if err != nil {
+		return internal(err)
+	}
+	shows, err := h.store.ListUnratedWatched(ctx, person, time.Now().Add(-h.reminderAfter))
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.RemindersResponse{
+		Person:    person,
+		AfterDays: int32(h.reminderAfter / (24 * time.Hour)),
+		Shows:     make([]*pb.Show, 0, len(shows)),
+	}
+	if prefs.RemindersSnoozed(time.Now()) {
+		resp.SnoozedUntil = ptr(prefs.RemindersSnoozedUntil.V)
+	}
+	for i := range shows {
+		resp.Shows = append(resp.Shows, toPBShow(&shows[i]))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// postSnoozeReminders pauses the person's rating reminders for a number of
+// days, or resumes them with 0.
+func (h *Handler) postSnoozeReminders(w http.ResponseWriter, r *http.Request) error {
+	person := chi.URLParam(r, "person")
+	if !store.ValidPerson(person) {
+		return notFound("not found")
+	}
+	if err := h.checkOwner(r, person, notYourAccount()); err != nil {
+		return err
+	}
+
+	var req pb.SnoozeRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	if req.Days < 0 || req.Days > snoozeMaxDays {
+		return badRequest("invalid days")
+	}
+
+	var until sql.Null[string]
+	if req.Days > 0 {
+		until = sql.Null[string]{V: time.Now().UTC().AddDate(0, 0, int(req.Days)).Format(time.RFC3339), Valid: true}
+	}
+	if err := h.store.SnoozeReminders(r.Context(), person, until); err != nil {
+		return internal(err)
+	}
+
+	return h.getReminders(w, r)
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/handsomefox/website-rating/internal/arr"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+const requestStatusRequested = "requested"
+
+// postShowRequest sends a library show to Overseerr, or else to Radarr
+// (movies) or Sonarr (TV), and records the request on the show. The body is
+// optional.
+func (h *Handler) postShowRequest(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	var req pb.MediaRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			return badRequest("bad request")
+		}
+	}
+
+	show, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	status := requestStatusRequested
+	if h.overseerr != nil {
+		status, err = h.overseerr.Request(ctx, show.TMDBID, show.MediaType)
+		if err != nil {
+			return &Error{Status: http.StatusBadGateway, Message: err.Error()}
+		}
+	} else {
+		client := h.radarr
+		if show.MediaType == "tv" {
+			client = h.sonarr
+		}
+		if client == nil {
+			return &Error{Status: http.StatusNotImplemented, Message: "requests not configured"}
+		}
+
+		title := arr.Title{TMDBID: show.TMDBID, TVDBID: show.TVDBID.V}
+		if err := client.Add(ctx, title, req.GetQualityProfileId()); err != nil {
+			if errors.Is(err, arr.ErrMissingTVDBID) {
+				return badRequest("tvdb_id required")
+			}
+			return &Error{Status: http.StatusBadGateway, Message: err.Error()}
+		}
+	}
+
+	if err := h.store.SetRequested(ctx, id, status); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	updated, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, toPBShowDetail(&updated))
+	return nil
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+const (
+	searchHistoryLimit = 20
+	suggestionLimit    = 10
+)
+
+func (h *Handler) getSearchHistory(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	limit := searchHistoryLimit
+	if val := strings.TrimSpace(r.URL.Query().Get("limit")); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
+			limit = min(parsed, 100)
+		}
+	}
+
+	entries, err := h.store.ListSearchHistory(ctx, requestPerson(r), "", limit)
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.SearchHistoryResponse{Entries: make([]*pb.SearchHistoryEntry, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &pb.SearchHistoryEntry{
+			Query:      entry.Query,
+			Count:      toInt32(int(entry.Count)),
+			SearchedAt: entry.SearchedAt,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *Handler) deleteSearchHistory(w http.ResponseWriter, r *http.Request) error {
+	if err := h.store.ClearSearchHistory(r.Context(), requestPerson(r)); err != nil {
+		return internal(err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// getSearchSuggest returns typeahead suggestions: the person's matching past
+// searches first, then TMDB keyword matches.
+func (h *Handler) getSearchSuggest(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	prefix := strings.TrimSpace(r.URL.Query().Get("q"))
+	resp := &pb.SuggestResponse{Suggestions: []*pb.Suggestion{}}
+	if prefix == "" {
+		writeJSON(w, http.StatusOK, resp)
+		return nil
+	}
+
+	seen := map[string]struct{}{}
+	add := func(text, source string) {
+		key := strings.ToLower(strings.TrimSpace(text))
+		if key == "" || len(resp.Suggestions) >= suggestionLimit {
+			return
+		}
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		resp.Suggestions = append(resp.Suggestions, &pb.Suggestion{Text: text, Source: source})
+	}
+
+	history, err := h.store.ListSearchHistory(ctx, requestPerson(r), prefix, suggestionLimit)
+	if err != nil {
+		return internal(err)
+	}
+	for _, entry := range history {
+		add(entry.Query, "history")
+	}
+
+	if len(resp.Suggestions) < suggestionLimit {
+		keywords, err := h.tmdb.SearchKeywords(ctx, prefix)
+		if err != nil {
+			// History suggestions are still useful when TMDB is unavailable.
+			slog.Warn("search suggest: tmdb keywords failed", slog.Any("err", err))
+		}
+		for _, kw := range keywords {
+			add(kw.Name, "keyword")
+		}
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
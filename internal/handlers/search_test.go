@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+func TestSearchTextAppliesFilters(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+
+	env.tmdb.setSearch("movie",
+		tmdb.SearchResult{ID: 1, MediaType: "movie", Title: "Alien", Year: "1979"},
+		tmdb.SearchResult{ID: 2, MediaType: "movie", Title: "Alien: Romulus", Year: "2024"},
+	)
+
+	var resp pb.SearchResponse
+	c.mustDo(http.MethodGet, "/api/search?q=alien&media_type=movie&year_from=2000", nil, &resp)
+	if len(resp.Results) != 1 || resp.Results[0].Id != 2 {
+		t.Fatalf("results = %v, want only id 2", resp.Results)
+	}
+}
+
+func TestSearchOurServicesRejectsTextQuery(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+	setTestSubscriptions(t, env)
+
+	c.expect(http.StatusBadRequest, http.MethodGet, "/api/search?q=alien&our_services=1", nil)
+	if calls := env.tmdb.discoverFilters(); len(calls) != 0 {
+		t.Fatalf("discover called %d times", len(calls))
+	}
+}
+
+func TestSearchOurServicesFiltersDiscover(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+	setTestSubscriptions(t, env)
+
+	env.tmdb.setDiscover("movie", tmdb.SearchResult{ID: 3, MediaType: "movie", Title: "Dune", Year: "2021"})
+
+	var resp pb.SearchResponse
+	c.mustDo(http.MethodGet, "/api/search?media_type=movie&our_services=1", nil, &resp)
+	if len(resp.Results) != 1 || resp.Results[0].Id != 3 {
+		t.Fatalf("results = %v, want id 3", resp.Results)
+	}
+
+	calls := env.tmdb.discoverFilters()
+	if len(calls) == 0 {
+		t.Fatal("discover not called")
+	}
+	got := calls[len(calls)-1]
+	if got.WatchRegion != "UA" || got.WatchProviders != "8|337" {
+		t.Fatalf("discover region %q providers %q, want UA 8|337", got.WatchRegion, got.WatchProviders)
+	}
+}
+
+func TestSearchOurServicesNeedsSubscriptions(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+
+	c.expect(http.StatusBadRequest, http.MethodGet, "/api/search?media_type=movie&our_services=1", nil)
+}
+
+func setTestSubscriptions(t *testing.T, env *testEnv) {
+	t.Helper()
+	err := env.store.SetSubscriptions(context.Background(), store.Subscriptions{
+		Region: "UA",
+		Providers: []store.Subscription{
+			{ID: 8, Name: "Netflix"},
+			{ID: 337, Name: "Disney Plus"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("set subscriptions: %v", err)
+	}
+}
+
+func TestSearchReadOnlySkipsHistory(t *testing.T) {
+	env := newTestEnv(t, func(cfg *Config) { cfg.ReadOnly = true })
+	c := env.login(store.PersonBf)
+	env.tmdb.setSearch("all", tmdb.SearchResult{ID: 1, MediaType: "movie", Title: "Alien", Year: "1979"})
+
+	c.mustDo(http.MethodGet, "/api/search?q=alien", nil, nil)
+
+	history, err := env.store.ListSearchHistory(context.Background(), store.PersonBf, "", 10)
+	if err != nil {
+		t.Fatalf("search history: %v", err)
+	}
+	if len(history) != 0 {
+		t.Fatalf("history = %v, want nothing recorded", history)
+	}
+}
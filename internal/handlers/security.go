@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+const (
+	// loginMaxFailures failed sign-ins from one IP within loginLockout refuse
+	// further attempts from it until the window passes.
+	loginMaxFailures = 10
+	loginLockout     = time.Hour
+
+	securityReportDays    = 7
+	securityReportMaxDays = 30
+)
+
+// loginLocked reports whether ip has used up its failed sign-ins.
+func (h *Handler) loginLocked(ctx context.Context, ip string) (bool, error) {
+	since := time.Now().Add(-loginLockout)
+	if h.readOnly {
+		return h.memSessions.failureCount(ip, since) >= loginMaxFailures, nil
+	}
+	count, err := h.store.LoginFailureCount(ctx, ip, since)
+	if err != nil {
+		return false, err
+	}
+	return count >= loginMaxFailures, nil
+}
+
+func (h *Handler) recordLoginFailure(r *http.Request) {
+	if h.readOnly {
+		h.memSessions.recordFailure(clientIP(r))
+		return
+	}
+	if err := h.store.RecordLoginFailure(r.Context(), clientIP(r)); err != nil {
+		slog.Warn("login: recording failure failed", slog.Any("err", err))
+	}
+}
+
+func tooManyAttempts() error {
+	return &Error{Status: http.StatusTooManyRequests, Message: "too many attempts"}
+}
+
+// getSecurityReport summarizes failed sign-ins over the last days (7 by
+// default, at most 30) by IP and by hour.
+func (h *Handler) getSecurityReport(w http.ResponseWriter, r *http.Request) error {
+	days := securityReportDays
+	if raw := strings.TrimSpace(r.URL.Query().Get("days")); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 || n > securityReportMaxDays {
+			return badRequest("invalid days")
+		}
+		days = n
+	}
+
+	ctx := r.Context()
+	report, err := h.store.LoginFailures(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.SecurityReport{
+		Days:          int32(days),
+		TotalFailures: report.Total,
+		Ips:           make([]*pb.LoginFailureIP, 0, len(report.IPs)),
+		Buckets:       make([]*pb.LoginFailureBucket, 0, len(report.Buckets)),
+	}
+	for _, ip := range report.IPs {
+		locked, err := h.loginLocked(ctx, ip.IP)
+		if err != nil {
+			return internal(err)
+		}
+		resp.Ips = append(resp.Ips, &pb.LoginFailureIP{
+			Ip:     ip.IP,
+			Count:  ip.Count,
+			LastAt: ip.LastAt,
+			Locked: locked,
+		})
+	}
+	for _, bucket := range report.Buckets {
+		resp.Buckets = append(resp.Buckets, &pb.LoginFailureBucket{
+			Start: bucket.Start,
+			Count: bucket.Count,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+const sessionNameMaxLen = 60
+
+// getSessions lists the signed-in devices, marking the caller's own.
+func (h *Handler) getSessions(w http.ResponseWriter, r *http.Request) error {
+	var sessions []store.Session
+	if h.readOnly {
+		sessions = h.memSessions.list()
+	} else {
+		var err error
+		sessions, err = h.store.ListSessions(r.Context())
+		if err != nil {
+			return internal(err)
+		}
+	}
+
+	current, _ := h.authSession(r)
+	resp := &pb.SessionsResponse{Sessions: make([]*pb.SessionInfo, 0, len(sessions))}
+	for i := range sessions {
+		resp.Sessions = append(resp.Sessions, toPBSessionInfo(&sessions[i], current.ID))
+	}
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// patchSession names a device; an empty name clears it.
+func (h *Handler) patchSession(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	if err := h.checkSessionOwner(r, id); err != nil {
+		return err
+	}
+
+	var req pb.SessionPatch
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	name := strings.TrimSpace(req.Name)
+	if utf8.RuneCountInString(name) > sessionNameMaxLen {
+		return badRequest("name too long")
+	}
+
+	if err := h.store.RenameSession(r.Context(), id, name); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// deleteSession signs a device out. Deleting the caller's own session also
+// clears its cookie.
+func (h *Handler) deleteSession(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	if err := h.checkSessionOwner(r, id); err != nil {
+		return err
+	}
+
+	if err := h.store.DeleteSession(r.Context(), id); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	if current, ok := h.authSession(r); ok && current.ID == id {
+		h.clearAuthCookie(w)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func toPBSessionInfo(session *store.Session, currentID int64) *pb.SessionInfo {
+	return &pb.SessionInfo{
+		Id:         session.ID,
+		Name:       fromSQLNull(session.Name),
+		UserAgent:  session.UserAgent,
+		Ip:         session.IP,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		ExpiresAt:  session.ExpiresAt,
+		Current:    session.ID == currentID,
+	}
+}
+
+// checkSessionOwner rejects renaming or signing out another person's account
+// session. The request's own session and shared-password sessions are open
+// to everyone.
+func (h *Handler) checkSessionOwner(r *http.Request, id int64) error {
+	if current, ok := h.authSession(r); ok && current.ID == id {
+		return nil
+	}
+	target, err := h.store.GetSession(r.Context(), id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	if !target.Person.Valid {
+		return nil
+	}
+	return h.checkOwner(r, target.Person.V, notYourAccount())
+}
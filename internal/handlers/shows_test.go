@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+func TestAddShowStoresInstanceLanguage(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+
+	ctx := context.Background()
+	if err := env.store.SetMetadataLanguage(ctx, store.PersonBf, sql.Null[string]{V: "uk-UA", Valid: true}); err != nil {
+		t.Fatalf("set language: %v", err)
+	}
+	env.tmdb.addDetail("uk-UA", tmdb.Detail{MediaType: "movie", TMDBID: 603, Title: "Матриця", Year: "1999"})
+
+	show := c.addMovie(tmdb.Detail{TMDBID: 603, Title: "The Matrix", Year: "1999"})
+	if show.Title != "The Matrix" {
+		t.Fatalf("added title = %q, want the default-language one", show.Title)
+	}
+
+	var refreshed pb.ShowDetail
+	c.mustDo(http.MethodPost, showPath(show.Id, "refresh-tmdb"), nil, &refreshed)
+	if refreshed.Show.GetTitle() != "The Matrix" {
+		t.Fatalf("refreshed title = %q, want the default-language one", refreshed.Show.GetTitle())
+	}
+
+	for _, lang := range env.tmdb.detailLanguages() {
+		if lang != "" {
+			t.Fatalf("details fetched in %q for a library write", lang)
+		}
+	}
+}
+
+func TestRefreshRemapsMergedTMDBID(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+
+	show := c.addMovie(tmdb.Detail{TMDBID: 100, Title: "Old Entry", IMDbID: "tt0000100"})
+
+	// TMDB merged the entry into 101.
+	env.tmdb.removeDetail("movie", 100)
+	env.tmdb.addDetail("", tmdb.Detail{MediaType: "movie", TMDBID: 101, Title: "New Entry", IMDbID: "tt0000100"})
+	env.tmdb.setIMDb("tt0000100", tmdb.SearchResult{ID: 101, MediaType: "movie"})
+
+	var refreshed pb.ShowDetail
+	c.mustDo(http.MethodPost, showPath(show.Id, "refresh-tmdb"), nil, &refreshed)
+	if refreshed.Show.GetTmdbId() != 101 || refreshed.Show.GetTitle() != "New Entry" {
+		t.Fatalf("refreshed = %d %q, want 101 New Entry", refreshed.Show.GetTmdbId(), refreshed.Show.GetTitle())
+	}
+
+	remaps := libraryRemaps(t, env)
+	if len(remaps) != 1 || remaps[0].Kind != store.EventRemapped || remaps[0].OldTMDBID.V != 100 || remaps[0].TMDBID != 101 {
+		t.Fatalf("remap events = %+v, want one remapped 100 -> 101", remaps)
+	}
+}
+
+func TestRefreshRemapConflict(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+
+	old := c.addMovie(tmdb.Detail{TMDBID: 100, Title: "Old Entry", IMDbID: "tt0000100"})
+	taken := c.addMovie(tmdb.Detail{TMDBID: 200, Title: "Merged Entry"})
+
+	// TMDB merged 100 into 200, which is already in the library.
+	env.tmdb.removeDetail("movie", 100)
+	env.tmdb.setIMDb("tt0000100", tmdb.SearchResult{ID: 200, MediaType: "movie"})
+
+	c.expect(http.StatusConflict, http.MethodPost, showPath(old.Id, "refresh-tmdb"), nil)
+
+	ctx := context.Background()
+	kept, err := env.store.GetShow(ctx, old.Id)
+	if err != nil {
+		t.Fatalf("get show: %v", err)
+	}
+	if kept.TMDBID != 100 || kept.Title != "Old Entry" {
+		t.Fatalf("conflicting show = %d %q, want it left alone", kept.TMDBID, kept.Title)
+	}
+	other, err := env.store.GetShow(ctx, taken.Id)
+	if err != nil {
+		t.Fatalf("get show: %v", err)
+	}
+	if other.Title != "Merged Entry" {
+		t.Fatalf("show holding the id = %q, want it left alone", other.Title)
+	}
+
+	remaps := libraryRemaps(t, env)
+	if len(remaps) != 1 || remaps[0].Kind != store.EventRemapSkipped || remaps[0].OldTMDBID.V != 100 || remaps[0].TMDBID != 200 {
+		t.Fatalf("remap events = %+v, want one remap_skipped 100 -> 200", remaps)
+	}
+}
+
+func TestWatchedRatingsBlock(t *testing.T) {
+	env := newTestEnv(t)
+	c := env.login(store.PersonBf)
+
+	if err := env.store.SetRatingRule(context.Background(), store.RatingRuleBlock); err != nil {
+		t.Fatalf("set rule: %v", err)
+	}
+	show := c.addMovie(tmdb.Detail{TMDBID: 27205, Title: "Inception"})
+
+	watched := &pb.StatusRequest{Status: "watched"}
+	c.expect(http.StatusConflict, http.MethodPost, showPath(show.Id, "status"), watched)
+	c.expect(http.StatusConflict, http.MethodPost, showPath(show.Id, "watches"), &pb.LogWatchRequest{})
+
+	bf, gf := int32(8), int32(9)
+	c.mustDo(http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{BfRating: &bf, GfRating: &gf}, nil)
+	c.expect(http.StatusOK, http.MethodPost, showPath(show.Id, "status"), watched)
+}
+
+func TestWatchedRatingsBlockKeepsPartlyRatedShowsPlanned(t *testing.T) {
+	env := newTestEnv(t)
+	if err := env.store.SetRatingRule(context.Background(), store.RatingRuleBlock); err != nil {
+		t.Fatalf("set rule: %v", err)
+	}
+	bf := env.signUp(store.PersonBf, "bf-own-password")
+	gf := env.signUp(store.PersonGf, "gf-own-password")
+
+	show := bf.addMovie(tmdb.Detail{TMDBID: 27205, Title: "Inception"})
+	rating := int32(8)
+
+	var detail pb.ShowDetail
+	bf.mustDo(http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{Rating: &rating}, &detail)
+	if detail.Show.GetStatus() != store.StatusPlanned || detail.Show.GetBfRating() != 8 {
+		t.Fatalf("after bf rated: %q bf=%d, want planned with the rating", detail.Show.GetStatus(), detail.Show.GetBfRating())
+	}
+
+	gf.mustDo(http.MethodPost, showPath(show.Id, "ratings"), &pb.RatingsRequest{Rating: &rating}, &detail)
+	if detail.Show.GetStatus() != store.StatusWatched {
+		t.Fatalf("after both rated: %q, want watched", detail.Show.GetStatus())
+	}
+
+	// Adding a title as watched leaves it planned until both have rated it.
+	env.tmdb.addDetail("", tmdb.Detail{MediaType: "movie", TMDBID: 157336, Title: "Interstellar"})
+	bf.mustDo(http.MethodPost, "/api/shows", &pb.AddShowRequest{TmdbId: 157336, MediaType: "movie", Status: "watched"}, &detail)
+	if detail.Show.GetStatus() != store.StatusPlanned {
+		t.Fatalf("added as watched: %q, want planned", detail.Show.GetStatus())
+	}
+}
+
+func libraryRemaps(t *testing.T, env *testEnv) []store.LibraryEvent {
+	t.Helper()
+	diff, err := env.store.LibraryDiff(context.Background(), "0000", "9999")
+	if err != nil {
+		t.Fatalf("library diff: %v", err)
+	}
+	return diff.Remapped
+}
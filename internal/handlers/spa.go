@@ -3,31 +3,61 @@ package handlers
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/fs"
+	"mime"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// SPA serves the frontend build from distFS, falling back to index.html for
+// client-side routes. distFS is either the embedded dist or a directory on
+// disk (STATIC_DIR); index.html is re-read per request so edits on disk show
+// up without a restart. Every file carries a content-hash ETag.
 func SPA(distFS fs.FS) (http.Handler, error) {
-	indexBytes, err := fs.ReadFile(distFS, "index.html")
-	if err != nil {
-		return nil, fmt.Errorf("failed to read embedded index.html: %w", err)
+	if _, err := fs.ReadFile(distFS, "index.html"); err != nil {
+		return nil, fmt.Errorf("failed to read index.html: %w", err)
 	}
 	fileServer := http.FileServer(http.FS(distFS))
+	etags := newETagCache(distFS)
+	serveIndex := func(w http.ResponseWriter, r *http.Request) {
+		info, err := fs.Stat(distFS, "index.html")
+		if err != nil {
+			http.Error(w, "index.html missing", http.StatusInternalServerError)
+			return
+		}
+		indexBytes, err := fs.ReadFile(distFS, "index.html")
+		if err != nil {
+			http.Error(w, "index.html missing", http.StatusInternalServerError)
+			return
+		}
+		if tag := etags.tag("index.html", info); tag != "" {
+			w.Header().Set("ETag", tag)
+		}
+		writeIndex(w, r, indexBytes)
+	}
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		cleanPath := path.Clean(r.URL.Path)
 		if cleanPath == "." {
 			cleanPath = "/"
 		}
 		if cleanPath == "/" {
-			serveIndex(w, r, indexBytes)
+			serveIndex(w, r)
 			return
 		}
 		trimmed := strings.TrimPrefix(cleanPath, "/")
 		if info, err := fs.Stat(distFS, trimmed); err == nil && !info.IsDir() {
 			setStaticCacheHeaders(w, trimmed)
+			tag := etags.tag(trimmed, info)
+			if tag != "" {
+				w.Header().Set("ETag", tag)
+			}
+			if servePrecompressed(w, r, distFS, trimmed, tag) {
+				return
+			}
 			fileServer.ServeHTTP(w, r)
 			return
 		}
@@ -36,11 +66,79 @@ func SPA(distFS fs.FS) (http.Handler, error) {
 			fileServer.ServeHTTP(w, r)
 			return
 		}
-		serveIndex(w, r, indexBytes)
+		serveIndex(w, r)
 	}), nil
 }
 
-func serveIndex(w http.ResponseWriter, r *http.Request, index []byte) {
+// precompressed lists the sibling encodings a build may ship next to an asset,
+// in order of preference.
+var precompressed = []struct {
+	encoding string
+	ext      string
+}{
+	{"br", ".br"},
+	{"gzip", ".gz"},
+}
+
+// servePrecompressed serves a .br/.gz sibling of name when the client accepts
+// that encoding. It reports whether it wrote a response.
+func servePrecompressed(w http.ResponseWriter, r *http.Request, distFS fs.FS, name, etag string) bool {
+	accept := r.Header.Get("Accept-Encoding")
+	varied := false
+	for _, pc := range precompressed {
+		info, err := fs.Stat(distFS, name+pc.ext)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		if !varied {
+			w.Header().Add("Vary", "Accept-Encoding")
+			varied = true
+		}
+		if !acceptsEncoding(accept, pc.encoding) {
+			continue
+		}
+		f, err := distFS.Open(name + pc.ext)
+		if err != nil {
+			continue
+		}
+		rs, ok := f.(io.ReadSeeker)
+		if !ok {
+			_ = f.Close()
+			continue
+		}
+		if ctype := mime.TypeByExtension(path.Ext(name)); ctype != "" {
+			w.Header().Set("Content-Type", ctype)
+		}
+		w.Header().Set("Content-Encoding", pc.encoding)
+		if tag := variantETag(etag, pc.encoding); tag != "" {
+			w.Header().Set("ETag", tag)
+		}
+		http.ServeContent(w, r, name, info.ModTime(), rs)
+		_ = f.Close()
+		return true
+	}
+	return false
+}
+
+// acceptsEncoding reports whether an Accept-Encoding header allows enc,
+// treating q=0 as a refusal.
+func acceptsEncoding(header, enc string) bool {
+	for part := range strings.SplitSeq(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), enc) {
+			continue
+		}
+		qv, ok := strings.CutPrefix(strings.ReplaceAll(params, " ", ""), "q=")
+		if !ok {
+			return true
+		}
+		q, err := strconv.ParseFloat(qv, 64)
+		return err == nil && q > 0
+	}
+	return false
+}
+
+func writeIndex(w http.ResponseWriter, r *http.Request, index []byte) {
 	w.Header().Set("Cache-Control", "no-cache")
 	http.ServeContent(w, r, "index.html", time.Time{}, bytes.NewReader(index))
 }
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"cmp"
+	"math"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// getTasteProfile returns one person's average ratings by genre, decade,
+// country and runtime.
+func (h *Handler) getTasteProfile(w http.ResponseWriter, r *http.Request) error {
+	person := chi.URLParam(r, "person")
+	if !store.ValidPerson(person) {
+		return notFound("not found")
+	}
+
+	profile, err := h.store.TasteProfile(r.Context(), person)
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, toPBTasteProfile(&profile))
+	return nil
+}
+
+func toPBTasteProfile(profile *store.TasteProfile) *pb.TasteProfile {
+	return &pb.TasteProfile{
+		Person:     profile.Person,
+		RatedCount: toInt32(int(profile.Rated)),
+		Average:    profile.Average,
+		Genres:     toPBTasteBuckets(profile.Genres),
+		Decades:    toPBTasteBuckets(profile.Decades),
+		Countries:  toPBTasteBuckets(profile.Countries),
+		Runtimes:   toPBTasteBuckets(profile.Runtimes),
+	}
+}
+
+func toPBTasteBuckets(buckets []store.TasteBucket) []*pb.TasteBucket {
+	out := make([]*pb.TasteBucket, 0, len(buckets))
+	for _, b := range buckets {
+		out = append(out, &pb.TasteBucket{
+			Key:     b.Key,
+			Count:   toInt32(int(b.Count)),
+			Average: b.Average,
+		})
+	}
+	return out
+}
+
+// getBacklog returns how long the planned queue would take to watch, per
+// media type and overall. TV counts every episode.
+func (h *Handler) getBacklog(w http.ResponseWriter, r *http.Request) error {
+	totals, err := h.store.Backlog(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+
+	var overall store.BacklogTotal
+	resp := &pb.BacklogResponse{MediaTypes: make([]*pb.BacklogTotal, 0, len(totals))}
+	for _, t := range totals {
+		resp.MediaTypes = append(resp.MediaTypes, toPBBacklogTotal(t))
+		overall.Count += t.Count
+		overall.Minutes += t.Minutes
+		overall.Unknown += t.Unknown
+	}
+	resp.Total = toPBBacklogTotal(overall)
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func toPBBacklogTotal(t store.BacklogTotal) *pb.BacklogTotal {
+	return &pb.BacklogTotal{
+		MediaType:    t.MediaType,
+		Count:        toInt32(int(t.Count)),
+		Minutes:      t.Minutes,
+		Hours:        math.Round(float64(t.Minutes)/6) / 10,
+		UnknownCount: toInt32(int(t.Unknown)),
+	}
+}
+
+const compatibilityGenreLimit = 5
+
+// getCompatibility combines both taste profiles: how closely the two rate the
+// same shows, which genres both enjoy, and which ones both rate poorly.
+func (h *Handler) getCompatibility(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	bf, err := h.store.TasteProfile(ctx, store.PersonBf)
+	if err != nil {
+		return internal(err)
+	}
+	gf, err := h.store.TasteProfile(ctx, store.PersonGf)
+	if err != nil {
+		return internal(err)
+	}
+	shared, err := h.store.SharedRatings(ctx)
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.CompatibilityResponse{
+		SharedCount:    toInt32(int(shared.Count)),
+		AverageDelta:   shared.AvgDelta,
+		SharedFavorite: []*pb.GenreCompatibility{},
+		AvoidTogether:  []*pb.GenreCompatibility{},
+	}
+	if shared.Count > 0 {
+		// Ratings are 1-10, so the widest possible average gap is 9.
+		resp.RatingScore = math.Round(100 * (1 - shared.AvgDelta/9))
+		resp.AgreementRate = float64(shared.Agreed) / float64(shared.Count)
+	}
+
+	gfGenres := make(map[string]store.TasteBucket, len(gf.Genres))
+	for _, b := range gf.Genres {
+		gfGenres[b.Key] = b
+	}
+
+	var common, matching int
+	for _, bfBucket := range bf.Genres {
+		gfBucket, ok := gfGenres[bfBucket.Key]
+		if !ok {
+			continue
+		}
+		common++
+
+		// Compare each genre against the person's own average so a harsh and
+		// a generous rater can still agree.
+		bfLikes := bfBucket.Average >= bf.Average
+		gfLikes := gfBucket.Average >= gf.Average
+		if bfLikes != gfLikes {
+			continue
+		}
+		matching++
+
+		item := &pb.GenreCompatibility{
+			Genre:     bfBucket.Key,
+			BfAverage: bfBucket.Average,
+			GfAverage: gfBucket.Average,
+			Combined:  (bfBucket.Average + gfBucket.Average) / 2,
+		}
+		if bfLikes {
+			resp.SharedFavorite = append(resp.SharedFavorite, item)
+		} else {
+			resp.AvoidTogether = append(resp.AvoidTogether, item)
+		}
+	}
+	if common > 0 {
+		resp.GenreOverlap = math.Round(100 * float64(matching) / float64(common))
+	}
+
+	slices.SortFunc(resp.SharedFavorite, func(a, b *pb.GenreCompatibility) int {
+		return cmp.Compare(b.Combined, a.Combined)
+	})
+	slices.SortFunc(resp.AvoidTogether, func(a, b *pb.GenreCompatibility) int {
+		return cmp.Compare(a.Combined, b.Combined)
+	})
+	resp.SharedFavorite = resp.SharedFavorite[:min(len(resp.SharedFavorite), compatibilityGenreLimit)]
+	resp.AvoidTogether = resp.AvoidTogether[:min(len(resp.AvoidTogether), compatibilityGenreLimit)]
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// getFairness counts who picked the watched shows and whose turn it is next.
+func (h *Handler) getFairness(w http.ResponseWriter, r *http.Request) error {
+	picks, err := h.store.PickCounts(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, &pb.FairnessResponse{
+		BfPicks:      toInt32(int(picks.Bf)),
+		GfPicks:      toInt32(int(picks.Gf)),
+		Unattributed: toInt32(int(picks.Unattributed)),
+		Turn:         picks.Turn(),
+	})
+	return nil
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+// StreamingRefreshJob is the name of the background job refreshing streaming
+// availability, which saving subscriptions triggers.
+const StreamingRefreshJob = "streaming refresh"
+
+func (h *Handler) getSubscriptions(w http.ResponseWriter, r *http.Request) error {
+	subs, err := h.store.GetSubscriptions(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+	writeJSON(w, http.StatusOK, toPBSubscriptions(&subs))
+	return nil
+}
+
+func (h *Handler) putSubscriptions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var req pb.UpdateSubscriptionsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	region, ok := parseRegion(req.Region)
+	if !ok {
+		return badRequest("invalid region")
+	}
+
+	subs := store.Subscriptions{Region: region}
+	if len(req.ProviderIds) > 0 {
+		known, err := h.watchProviders(ctx, region)
+		if err != nil {
+			return &Error{Status: http.StatusBadGateway, Message: err.Error()}
+		}
+		for _, id := range req.ProviderIds {
+			i := slices.IndexFunc(known, func(p tmdb.WatchProvider) bool { return p.ID == int(id) })
+			if i < 0 {
+				return badRequest("unknown provider")
+			}
+			if slices.ContainsFunc(subs.Providers, func(s store.Subscription) bool { return s.ID == int(id) }) {
+				continue
+			}
+			subs.Providers = append(subs.Providers, store.Subscription{ID: known[i].ID, Name: known[i].Name})
+		}
+	}
+
+	if err := h.store.SetSubscriptions(ctx, subs); err != nil {
+		return internal(err)
+	}
+
+	// Fill in availability for the new region/providers right away instead of
+	// waiting for the next scheduled refresh. The job runs one refresh at a
+	// time, and saves made while one is pending share it.
+	if h.jobs == nil || !h.jobs.Trigger(StreamingRefreshJob) {
+		slog.Debug("streaming refresh: no job to trigger")
+	}
+
+	writeJSON(w, http.StatusOK, toPBSubscriptions(&subs))
+	return nil
+}
+
+func (h *Handler) getWatchProviders(w http.ResponseWriter, r *http.Request) error {
+	region, ok := parseRegion(r.URL.Query().Get("region"))
+	if !ok {
+		return badRequest("invalid region")
+	}
+	providers, err := h.watchProviders(r.Context(), region)
+	if err != nil {
+		return &Error{Status: http.StatusBadGateway, Message: err.Error()}
+	}
+
+	resp := &pb.WatchProvidersResponse{}
+	for _, p := range providers {
+		resp.Providers = append(resp.Providers, &pb.WatchProvider{
+			Id:       toInt32(p.ID),
+			Name:     p.Name,
+			LogoPath: p.LogoPath,
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// watchProviders lists the movie and TV providers in region, deduplicated.
+func (h *Handler) watchProviders(ctx context.Context, region string) ([]tmdb.WatchProvider, error) {
+	movie, err := h.tmdb.ListWatchProviders(ctx, "movie", region)
+	if err != nil {
+		return nil, err
+	}
+	tv, err := h.tmdb.ListWatchProviders(ctx, "tv", region)
+	if err != nil {
+		return nil, err
+	}
+	out := movie
+	for _, p := range tv {
+		if !slices.ContainsFunc(out, func(m tmdb.WatchProvider) bool { return m.ID == p.ID }) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// ourServices returns the subscription filter as TMDB discover parameters
+// (region and an any-of provider list).
+func (h *Handler) ourServices(ctx context.Context) (region, providers string, err error) {
+	subs, err := h.store.GetSubscriptions(ctx)
+	if err != nil {
+		return "", "", internal(err)
+	}
+	if !subs.Configured() {
+		return "", "", badRequest("no subscriptions set")
+	}
+	ids := make([]string, 0, len(subs.Providers))
+	for _, id := range subs.ProviderIDs() {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return subs.Region, strings.Join(ids, "|"), nil
+}
+
+// parseRegion accepts an ISO 3166-1 alpha-2 code in any case.
+func parseRegion(raw string) (string, bool) {
+	region := strings.ToUpper(strings.TrimSpace(raw))
+	if len(region) != 2 || region[0] < 'A' || region[0] > 'Z' || region[1] < 'A' || region[1] > 'Z' {
+		return "", false
+	}
+	return region, true
+}
+
+func toPBSubscriptions(subs *store.Subscriptions) *pb.Subscriptions {
+	out := &pb.Subscriptions{Region: subs.Region}
+	for _, p := range subs.Providers {
+		out.Providers = append(out.Providers, &pb.WatchProvider{Id: toInt32(p.ID), Name: p.Name})
+	}
+	return out
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+// getSync returns shows changed since the cursor plus the IDs of deleted
+// ones, so an offline client can reconcile its copy of the library.
+func (h *Handler) getSync(w http.ResponseWriter, r *http.Request) error {
+	var since int64
+	if raw := strings.TrimSpace(r.URL.Query().Get("since")); raw != "" {
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || v < 0 {
+			return badRequest("invalid cursor")
+		}
+		since = v
+	}
+
+	changes, err := h.store.ChangesSince(r.Context(), since)
+	if err != nil {
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, &pb.SyncResponse{
+		Shows:      toPBShows(changes.Shows),
+		DeletedIds: changes.Deleted,
+		Cursor:     strconv.FormatInt(changes.Cursor, 10),
+	})
+	return nil
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+)
+
+const tonightPickCount = 5
+
+// getTonight picks a few random planned shows that fit into max_minutes:
+// movies by their runtime, TV by the length of one episode. The response
+// also says whose turn it is to choose.
+func (h *Handler) getTonight(w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query()
+
+	maxMinutes, err := strconv.Atoi(strings.TrimSpace(query.Get("max_minutes")))
+	if err != nil || maxMinutes <= 0 {
+		return badRequest("invalid max_minutes")
+	}
+
+	mediaType := strings.TrimSpace(query.Get("media_type"))
+	if mediaType != "" && mediaType != "movie" && mediaType != "tv" {
+		return badRequest("invalid media_type")
+	}
+
+	shows, err := h.store.ListTonightPicks(r.Context(), maxMinutes, mediaType, tonightPickCount)
+	if err != nil {
+		return internal(err)
+	}
+
+	picks, err := h.store.PickCounts(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.TonightResponse{Shows: make([]*pb.Show, 0, len(shows)), Turn: picks.Turn()}
+	for i := range shows {
+		resp.Shows = append(resp.Shows, toPBShow(&shows[i]))
+	}
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// postShowVeto vetoes a planned show for the session's person, hiding it
+// from the random picker. Each person holds at most vetoLimit active vetoes.
+func (h *Handler) postShowVeto(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	person := requestPerson(r)
+	if person == "" {
+		return badRequest("person required")
+	}
+
+	if err := h.store.Veto(ctx, id, person, h.vetoLimit); err != nil {
+		switch {
+		case isNoRows(err):
+			return notFound("not found")
+		case errors.Is(err, store.ErrVetoLimit):
+			return &Error{Status: http.StatusConflict, Message: "no vetoes left"}
+		case errors.Is(err, store.ErrNotVetoable):
+			return &Error{Status: http.StatusConflict, Message: "cannot veto this show"}
+		}
+		return internal(err)
+	}
+	return h.writeShowDetail(w, r, id)
+}
+
+// deleteShowVeto lifts a veto. A veto by someone with an account can only be
+// lifted from their account.
+func (h *Handler) deleteShowVeto(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	show, err := h.store.GetShow(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	if show.VetoedBy.Valid {
+		if err := h.checkOwner(r, show.VetoedBy.V, &Error{Status: http.StatusForbidden, Message: "not your veto"}); err != nil {
+			return err
+		}
+	}
+
+	if err := h.store.Unveto(ctx, id); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	return h.writeShowDetail(w, r, id)
+}
+
+func (h *Handler) writeShowDetail(w http.ResponseWriter, r *http.Request, id int64) error {
+	show, err := h.store.GetShow(r.Context(), id)
+	if err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	writeJSON(w, http.StatusOK, toPBShowDetail(&show))
+	return nil
+}
+
+// getVetoStats reports veto usage against the limit.
+func (h *Handler) getVetoStats(w http.ResponseWriter, r *http.Request) error {
+	counts, err := h.store.VetoCounts(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+
+	limit := int64(h.vetoLimit)
+	writeJSON(w, http.StatusOK, &pb.VetoStats{
+		Limit:       toInt32(h.vetoLimit),
+		BfActive:    toInt32(int(counts.Bf)),
+		GfActive:    toInt32(int(counts.Gf)),
+		BfRemaining: toInt32(int(max(limit-counts.Bf, 0))),
+		GfRemaining: toInt32(int(max(limit-counts.Gf, 0))),
+		Overruled:   toInt32(int(counts.Overruled)),
+	})
+	return nil
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Warmup loads what the first requests would otherwise wait for: the TMDB
+// genre, country and language lists in the default language and the
+// library's TMDB refs. It also makes one TMDB call to check the credentials.
+// Failures are logged and don't stop the rest; health reports "warming" until
+// it returns.
+func (h *Handler) Warmup(ctx context.Context) {
+	defer h.warming.Store(false)
+	start := time.Now()
+	failed := 0
+
+	if _, err := h.tmdb.FetchLanguages(ctx); err != nil {
+		slog.Error("warm-up: tmdb check failed", slog.Any("err", err))
+		failed++
+	}
+
+	lang := h.tmdb.Language()
+	if _, _, err := h.fetchGenreLists(ctx, lang); err != nil {
+		slog.Warn("warm-up: genres failed", slog.Any("err", err))
+		failed++
+	}
+	if _, err := h.fetchCountryList(ctx, lang); err != nil {
+		slog.Warn("warm-up: countries failed", slog.Any("err", err))
+		failed++
+	}
+	if _, err := h.fetchLanguageList(ctx); err != nil {
+		slog.Warn("warm-up: languages failed", slog.Any("err", err))
+		failed++
+	}
+	if err := h.store.WarmLibraryRefs(ctx); err != nil {
+		slog.Warn("warm-up: library refs failed", slog.Any("err", err))
+		failed++
+	}
+
+	slog.Info("ready", slog.Duration("warmup", time.Since(start)), slog.Int("failed_steps", failed))
+}
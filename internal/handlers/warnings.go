@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// contentWarningsTTL is how long cached DoesTheDogDie answers are reused.
+const contentWarningsTTL = 7 * 24 * time.Hour
+
+// contentWarnings returns the show's DoesTheDogDie warnings that match the
+// configured sensitivities, refreshing the cache when it is stale. A failed
+// lookup falls back to the stale cache. Read-only instances use a fresh
+// answer without caching it, and a failed cache write is only logged.
+func (h *Handler) contentWarnings(ctx context.Context, show *store.Show) ([]*pb.ContentWarning, error) {
+	cached, fetchedAt, err := h.store.GetContentWarnings(ctx, show.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Since(fetchedAt) > contentWarningsTTL {
+		found, err := h.ddtd.Warnings(ctx, show.IMDbID.V, show.Title, show.Year.V, show.MediaType)
+		if err != nil {
+			slog.Warn("doesthedogdie lookup failed", slog.Int64("show_id", show.ID), slog.Any("err", err))
+		} else {
+			cached = make([]store.ContentWarning, 0, len(found))
+			for _, w := range found {
+				cached = append(cached, store.ContentWarning{Topic: w.Topic, Yes: w.Yes, No: w.No})
+			}
+			if !h.readOnly {
+				if err := h.store.SetContentWarnings(ctx, show.ID, cached); err != nil {
+					slog.Warn("content warnings cache write failed", slog.Int64("show_id", show.ID), slog.Any("err", err))
+				}
+			}
+		}
+	}
+
+	out := make([]*pb.ContentWarning, 0, len(cached))
+	for _, w := range cached {
+		if !h.ddtd.Relevant(w.Topic) {
+			continue
+		}
+		out = append(out, &pb.ContentWarning{
+			Topic:    w.Topic,
+			YesVotes: toInt32(w.Yes),
+			NoVotes:  toInt32(w.No),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+func (h *Handler) getWatchedRatings(w http.ResponseWriter, r *http.Request) error {
+	rule, err := h.store.GetRatingRule(r.Context())
+	if err != nil {
+		return internal(err)
+	}
+	writeJSON(w, http.StatusOK, &pb.WatchedRatingsSetting{Mode: rule})
+	return nil
+}
+
+func (h *Handler) putWatchedRatings(w http.ResponseWriter, r *http.Request) error {
+	var req pb.WatchedRatingsSetting
+	if err := decodeJSON(r, &req); err != nil {
+		return badRequest("bad request")
+	}
+	rule := strings.TrimSpace(req.Mode)
+	if !store.ValidRatingRule(rule) {
+		return badRequest("invalid mode")
+	}
+	if err := h.store.SetRatingRule(r.Context(), rule); err != nil {
+		return internal(err)
+	}
+	writeJSON(w, http.StatusOK, &pb.WatchedRatingsSetting{Mode: rule})
+	return nil
+}
+
+// missingRatings lists who has not rated the show.
+func missingRatings(show *store.Show) []string {
+	var missing []string
+	if !show.BfRating.Valid {
+		missing = append(missing, store.PersonBf)
+	}
+	if !show.GfRating.Valid {
+		missing = append(missing, store.PersonGf)
+	}
+	return missing
+}
+
+// checkWatchedRatings applies the watched-ratings rule before show moves to
+// status. Under "block" it refuses with 409; under "warn" it returns who is
+// missing a rating for the response.
+func (h *Handler) checkWatchedRatings(ctx context.Context, show *store.Show, status string) ([]string, error) {
+	if status != store.StatusWatched || show.Status == store.StatusWatched {
+		return nil, nil
+	}
+	missing := missingRatings(show)
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	rule, err := h.store.GetRatingRule(ctx)
+	if err != nil {
+		return nil, internal(err)
+	}
+	switch rule {
+	case store.RatingRuleBlock:
+		return nil, &Error{Status: http.StatusConflict, Message: "ratings required"}
+	case store.RatingRuleWarn:
+		return missing, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+const (
+	defaultActivityMonths = 12
+	maxActivityMonths     = 120
+)
+
+// getShowWatches lists every recorded watch of a show, rewatches included.
+func (h *Handler) getShowWatches(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	if _, err := h.store.GetShow(ctx, id); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	watches, err := h.store.ListWatches(ctx, id)
+	if err != nil {
+		return internal(err)
+	}
+	writeJSON(w, http.StatusOK, &pb.WatchesResponse{Watches: toPBWatches(watches)})
+	return nil
+}
+
+// postShowWatch logs a watch. A planned show becomes watched; a watched one
+// gets a rewatch.
+func (h *Handler) postShowWatch(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	var req pb.LogWatchRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			return badRequest("bad request")
+		}
+	}
+	watchedAt := time.Now().UTC()
+	if req.WatchedAt != nil {
+		if raw := strings.TrimSpace(*req.WatchedAt); raw != "" {
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				if t, err = time.Parse(dateLayout, raw); err != nil {
+					return badRequest("invalid watched_at")
+				}
+			}
+			watchedAt = t.UTC()
+		}
+	}
+
+	if err := h.store.LogWatch(ctx, id, watchedAt.Format(time.RFC3339)); err != nil {
+		return showWriteError(err)
+	}
+	return h.writeShowDetail(w, r, id)
+}
+
+// deleteShowWatch removes a logged watch. Removing the last one moves the
+// show back to planned.
+func (h *Handler) deleteShowWatch(w http.ResponseWriter, r *http.Request) error {
+	id, err := idParam(r, "id")
+	if err != nil {
+		return notFound("not found")
+	}
+	watchID, err := idParam(r, "watchID")
+	if err != nil {
+		return notFound("not found")
+	}
+
+	if err := h.store.DeleteWatch(r.Context(), id, watchID); err != nil {
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+	return h.writeShowDetail(w, r, id)
+}
+
+// getActivity counts watches per month over the last ?months=12 months,
+// the current one included.
+func (h *Handler) getActivity(w http.ResponseWriter, r *http.Request) error {
+	months := defaultActivityMonths
+	if raw := strings.TrimSpace(r.URL.Query().Get("months")); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 || n > maxActivityMonths {
+			return badRequest("invalid months")
+		}
+		months = n
+	}
+
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1-months, 0)
+	activity, err := h.store.WatchActivity(r.Context(), start.Format("2006-01"))
+	if err != nil {
+		return internal(err)
+	}
+
+	resp := &pb.ActivityResponse{Months: make([]*pb.MonthActivity, 0, len(activity))}
+	for _, month := range activity {
+		resp.Months = append(resp.Months, &pb.MonthActivity{
+			Month:   month.Month,
+			Watches: toInt32(int(month.Watches)),
+			Shows:   toInt32(int(month.Shows)),
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func toPBWatches(watches []store.WatchEvent) []*pb.WatchEvent {
+	out := make([]*pb.WatchEvent, 0, len(watches))
+	for _, watch := range watches {
+		out = append(out, &pb.WatchEvent{
+			Id:        watch.ID,
+			ShowId:    watch.ShowID,
+			WatchedAt: watch.WatchedAt,
+		})
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/gen/pb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// Media server webhooks mark library titles watched when playback finishes.
+// Only movies are matched: for episodes the payload GUIDs identify the
+// episode, not the series.
+
+type plexPayload struct {
+	Event    string `json:"event"`
+	Metadata struct {
+		Type string `json:"type"`
+		GUID []struct {
+			ID string `json:"id"`
+		} `json:"Guid"`
+		LastViewedAt int64 `json:"lastViewedAt"`
+	} `json:"Metadata"`
+}
+
+// postPlexWebhook handles Plex webhooks (multipart, JSON in the "payload"
+// field). Plex sends media.scrobble once an item is played past ~90%, which
+// is its playback-finished event; media.stop fires on every stop.
+func (h *Handler) postPlexWebhook(w http.ResponseWriter, r *http.Request) error {
+	raw := r.FormValue("payload")
+	if raw == "" {
+		return badRequest("bad request")
+	}
+	var payload plexPayload
+	if err := json.Unmarshal([]byte(raw), &payload); err != nil {
+		return badRequest("bad request")
+	}
+	if payload.Event != "media.scrobble" || payload.Metadata.Type != "movie" {
+		writeJSON(w, http.StatusOK, &pb.WebhookResponse{})
+		return nil
+	}
+
+	var tmdbID int64
+	var imdbID string
+	for _, guid := range payload.Metadata.GUID {
+		scheme, value, ok := strings.Cut(guid.ID, "://")
+		if !ok {
+			continue
+		}
+		switch scheme {
+		case "tmdb":
+			tmdbID, _ = strconv.ParseInt(value, 10, 64)
+		case "imdb":
+			imdbID = value
+		}
+	}
+
+	watched := time.Now().UTC()
+	if payload.Metadata.LastViewedAt > 0 {
+		watched = time.Unix(payload.Metadata.LastViewedAt, 0).UTC()
+	}
+	return h.scrobble(w, r, tmdbID, imdbID, watched)
+}
+
+// jellyfinPayload follows the default template of the Jellyfin webhook plugin.
+type jellyfinPayload struct {
+	NotificationType   string `json:"NotificationType"`
+	ItemType           string `json:"ItemType"`
+	PlayedToCompletion bool   `json:"PlayedToCompletion"`
+	ProviderTMDB       string `json:"Provider_tmdb"`
+	ProviderIMDb       string `json:"Provider_imdb"`
+	UtcTimestamp       string `json:"UtcTimestamp"`
+}
+
+func (h *Handler) postJellyfinWebhook(w http.ResponseWriter, r *http.Request) error {
+	var payload jellyfinPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return badRequest("bad request")
+	}
+	if payload.NotificationType != "PlaybackStop" || !payload.PlayedToCompletion || payload.ItemType != "Movie" {
+		writeJSON(w, http.StatusOK, &pb.WebhookResponse{})
+		return nil
+	}
+
+	tmdbID, _ := strconv.ParseInt(strings.TrimSpace(payload.ProviderTMDB), 10, 64)
+
+	watched := time.Now().UTC()
+	if ts, err := time.Parse(time.RFC3339, payload.UtcTimestamp); err == nil {
+		watched = ts.UTC()
+	}
+	return h.scrobble(w, r, tmdbID, strings.TrimSpace(payload.ProviderIMDb), watched)
+}
+
+// scrobble marks the matching library movie watched. Unknown titles are
+// acknowledged rather than rejected so media servers do not retry them.
+func (h *Handler) scrobble(w http.ResponseWriter, r *http.Request, tmdbID int64, imdbID string, watched time.Time) error {
+	ctx := r.Context()
+
+	id, err := h.findScrobbled(ctx, tmdbID, imdbID)
+	if err != nil {
+		if isNoRows(err) {
+			writeJSON(w, http.StatusOK, &pb.WebhookResponse{})
+			return nil
+		}
+		return internal(err)
+	}
+
+	if err := h.store.MarkWatched(ctx, id, watched.Format(time.RFC3339)); err != nil {
+		if errors.Is(err, store.ErrRatingsRequired) {
+			slog.Info("webhook: watch not recorded, ratings required", slog.Int64("show_id", id))
+			writeJSON(w, http.StatusOK, &pb.WebhookResponse{Matched: true, ShowId: id, RatingsRequired: true})
+			return nil
+		}
+		if isNoRows(err) {
+			return notFound("not found")
+		}
+		return internal(err)
+	}
+
+	writeJSON(w, http.StatusOK, &pb.WebhookResponse{Matched: true, ShowId: id})
+	return nil
+}
+
+func (h *Handler) findScrobbled(ctx context.Context, tmdbID int64, imdbID string) (int64, error) {
+	if tmdbID > 0 {
+		id, err := h.store.GetShowIDByTMDB(ctx, tmdbID, "movie")
+		if !isNoRows(err) {
+			return id, err
+		}
+	}
+	if imdbID == "" {
+		return 0, sql.ErrNoRows
+	}
+	return h.store.GetShowIDByIMDb(ctx, imdbID, "movie")
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// wikipediaSummaryTTL is how long cached Wikipedia summaries are reused.
+const wikipediaSummaryTTL = 30 * 24 * time.Hour
+
+// wikipediaSummary returns the show's cached Wikipedia summary, fetching it
+// through the stored Wikidata ID when the cache is stale. A failed lookup
+// falls back to the stale cache. Read-only instances use a fresh summary
+// without caching it, and a failed cache write is only logged.
+func (h *Handler) wikipediaSummary(ctx context.Context, show *store.Show) (store.WikipediaSummary, error) {
+	wikidataID := strings.TrimSpace(show.WikidataID.V)
+	if wikidataID == "" {
+		return store.WikipediaSummary{}, nil
+	}
+
+	cached, fetchedAt, err := h.store.GetWikipediaSummary(ctx, show.ID)
+	if err != nil {
+		return store.WikipediaSummary{}, err
+	}
+	if time.Since(fetchedAt) <= wikipediaSummaryTTL {
+		return cached, nil
+	}
+
+	found, err := h.wikipedia.Summary(ctx, wikidataID)
+	if err != nil {
+		slog.Warn("wikipedia lookup failed", slog.Int64("show_id", show.ID), slog.Any("err", err))
+		return cached, nil
+	}
+
+	summary := store.WikipediaSummary{ShowID: show.ID, Extract: found.Extract, URL: found.URL}
+	if h.readOnly {
+		return summary, nil
+	}
+	if err := h.store.SetWikipediaSummary(ctx, &summary); err != nil {
+		slog.Warn("wikipedia cache write failed", slog.Int64("show_id", show.ID), slog.Any("err", err))
+	}
+	return summary, nil
+}
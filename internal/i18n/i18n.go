@@ -0,0 +1,163 @@
+// Package i18n translates user-facing API messages and picks request languages.
+package i18n
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Default is the language messages are written in.
+const Default = "en"
+
+// catalogs maps a language to translations keyed by the English message.
+var catalogs = map[string]map[string]string{
+	"uk": {
+		"bad request":               "некоректний запит",
+		"unauthorized":              "потрібна авторизація",
+		"invalid password":          "невірний пароль",
+		"not found":                 "не знайдено",
+		"tmdb_id required":          "потрібен tmdb_id",
+		"invalid tmdb_id":           "некоректний tmdb_id",
+		"invalid media_type":        "некоректний media_type",
+		"invalid imdb_id":           "некоректний imdb_id",
+		"invalid tmdb_url":          "некоректне посилання TMDB",
+		"no tmdb entry for imdb_id": "у TMDB немає запису для цього imdb_id",
+		"query required":            "потрібен запит",
+		"no matches":                "нічого не знайдено",
+		"invalid person":            "некоректна особа",
+		"name required":             "потрібна назва",
+		"invalid month":             "некоректний місяць",
+		"invalid format":            "некоректний формат",
+		"invalid cursor":            "некоректний курсор",
+		"invalid status":            "некоректний статус",
+		"invalid rating":            "оцінка має бути від 0 до 10",
+		"invalid watched_at":        "некоректний watched_at",
+		"nothing to update":         "немає що оновлювати",
+		"read-only mode":            "режим лише для читання",
+		"confirmation mismatch":     "фраза підтвердження не збігається",
+		"requests not configured":   "запити на завантаження не налаштовано",
+		"tvdb_id required":          "потрібен tvdb_id",
+		"invalid region":            "некоректний регіон",
+		"unknown provider":          "невідомий стримінговий сервіс",
+		"no subscriptions set":      "підписки не налаштовано",
+		"invalid max_minutes":       "некоректний max_minutes",
+		"name too long":             "назва задовга",
+		"password too short":        "пароль закороткий",
+		"too many attempts":         "забагато спроб, спробуйте пізніше",
+		"invalid days":              "некоректний days",
+		"invalid mode":              "некоректний режим",
+		"ratings required":          "спершу поставте обидві оцінки",
+		"ratings are frozen":        "оцінки вже заморожено",
+		"invalid scheduled_at":      "некоректний scheduled_at",
+		"plan completed":            "вечір уже завершено",
+		"note too long":             "нотатка задовга",
+		"person required":           "спершу оберіть особу",
+		"can only rate as yourself": "можна змінювати лише власні оцінки",
+		"person mismatch":           "ви увійшли як інша особа",
+		"no vetoes left":            "вето вичерпано",
+		"cannot veto this show":     "на цей запис не можна накласти вето",
+		"not your veto":             "це вето наклала інша особа",
+		"invalid csv":               "некоректний CSV",
+		"title column required":     "потрібна колонка title",
+		"too many rows":             "забагато рядків",
+		"export is encrypted":       "експорт зашифровано, а пароль резервних копій не задано",
+		"wrong backup passphrase":   "неправильний пароль резервних копій",
+		"export is damaged":         "експорт пошкоджено",
+		"invalid months":            "некоректний months",
+		"invalid since":             "некоректний since",
+		"invalid until":             "некоректний until",
+		"not your account":          "це не ваш обліковий запис",
+		"person has an account":     "ця особа має власний обліковий запис",
+		"tmdb id already in use":    "цей запис TMDB уже є в бібліотеці",
+		"discover-only filter":      "цей фільтр працює лише без текстового запиту",
+	},
+}
+
+// Translate returns msg in lang, or msg unchanged when there is no translation.
+func Translate(lang, msg string) string {
+	if catalog, ok := catalogs[lang]; ok {
+		if translated, ok := catalog[msg]; ok {
+			return translated
+		}
+	}
+	return msg
+}
+
+// Match returns the best supported message language for an Accept-Language
+// header, falling back to Default.
+func Match(acceptLanguage string) string {
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		base, _, _ := strings.Cut(tag, "-")
+		if base == Default {
+			return Default
+		}
+		if _, ok := catalogs[base]; ok {
+			return base
+		}
+	}
+	return Default
+}
+
+// Preferred returns the highest-weighted language tag from an Accept-Language
+// header in TMDB's format ("uk-UA", "de"). It returns "" for English or when
+// the header is empty, so callers fall back to TMDB's default.
+func Preferred(acceptLanguage string) string {
+	tags := parseAcceptLanguage(acceptLanguage)
+	if len(tags) == 0 {
+		return ""
+	}
+	return Normalize(tags[0])
+}
+
+// Normalize converts a language tag into TMDB's format. English maps to ""
+// (the TMDB default).
+func Normalize(tag string) string {
+	tag = strings.TrimSpace(strings.ReplaceAll(tag, "_", "-"))
+	base, region, hasRegion := strings.Cut(tag, "-")
+	base = strings.ToLower(base)
+	if base == "" || base == "*" || base == Default {
+		return ""
+	}
+	if !hasRegion || region == "" {
+		return base
+	}
+	return base + "-" + strings.ToUpper(region)
+}
+
+type weightedTag struct {
+	tag    string
+	weight float64
+}
+
+func parseAcceptLanguage(header string) []string {
+	var weighted []weightedTag
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				weight = parsed
+			}
+		}
+		if weight <= 0 {
+			continue
+		}
+		weighted = append(weighted, weightedTag{tag: tag, weight: weight})
+	}
+
+	slices.SortStableFunc(weighted, func(a, b weightedTag) int {
+		return cmp.Compare(b.weight, a.weight)
+	})
+
+	out := make([]string, 0, len(weighted))
+	for _, w := range weighted {
+		out = append(out, w.tag)
+	}
+	return out
+}
@@ -0,0 +1,152 @@
+// Package jobs runs periodic background tasks.
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/logger"
+)
+
+type Job struct {
+	Name string
+	// Interval between runs. Zero or less runs the job only when triggered.
+	Interval time.Duration
+	// Jitter delays each run by a random amount up to it, so jobs calling
+	// the same API don't line up.
+	Jitter time.Duration
+	// RunAtStart runs the job once right away instead of after the first
+	// interval.
+	RunAtStart bool
+	Run        func(ctx context.Context) error
+}
+
+// Status is what a job has done since startup.
+type Status struct {
+	Name     string
+	Interval time.Duration
+	Runs     int
+	Failures int
+	// LastStarted and LastFinished are zero before the first run.
+	LastStarted  time.Time
+	LastFinished time.Time
+	// LastError is the latest run's error, or "" if it succeeded.
+	LastError string
+	NextRun   time.Time
+}
+
+// Runner runs jobs and keeps their status.
+type Runner struct {
+	mu       sync.Mutex
+	statuses []*Status
+	triggers map[string]chan struct{}
+}
+
+func NewRunner() *Runner {
+	return &Runner{triggers: map[string]chan struct{}{}}
+}
+
+// Start runs each job in its own goroutine until ctx is done. Failures are
+// logged and the job keeps its schedule.
+func (r *Runner) Start(ctx context.Context, jobs ...Job) {
+	for _, job := range jobs {
+		status := &Status{Name: job.Name, Interval: job.Interval}
+		trigger := make(chan struct{}, 1)
+		r.mu.Lock()
+		r.statuses = append(r.statuses, status)
+		r.triggers[job.Name] = trigger
+		r.mu.Unlock()
+		go r.run(ctx, job, status, trigger)
+	}
+}
+
+// Trigger asks the named job to run as soon as it isn't running, without
+// waiting for its interval. Triggers that arrive before that run starts are
+// folded into it. It reports false when there is no such job.
+func (r *Runner) Trigger(name string) bool {
+	r.mu.Lock()
+	trigger, ok := r.triggers[name]
+	r.mu.Unlock()
+	if !ok {
+		return false
+	}
+	select {
+	case trigger <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// Statuses returns every job's status in start order.
+func (r *Runner) Statuses() []Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Status, 0, len(r.statuses))
+	for _, status := range r.statuses {
+		out = append(out, *status)
+	}
+	return out
+}
+
+func (r *Runner) run(ctx context.Context, job Job, status *Status, trigger <-chan struct{}) {
+	if job.RunAtStart {
+		r.runOnce(ctx, job, status)
+	}
+	for {
+		var tick <-chan time.Time
+		var timer *time.Timer
+		if job.Interval > 0 {
+			wait := job.Interval
+			if job.Jitter > 0 {
+				wait += rand.N(job.Jitter)
+			}
+			r.mu.Lock()
+			status.NextRun = time.Now().Add(wait)
+			r.mu.Unlock()
+			timer = time.NewTimer(wait)
+			tick = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-tick:
+		case <-trigger:
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+		r.runOnce(ctx, job, status)
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context, job Job, status *Status) {
+	start := time.Now()
+	r.mu.Lock()
+	status.LastStarted = start
+	r.mu.Unlock()
+
+	err := job.Run(ctx)
+
+	r.mu.Lock()
+	status.Runs++
+	status.LastFinished = time.Now()
+	status.LastError = ""
+	if err != nil {
+		status.Failures++
+		status.LastError = err.Error()
+	}
+	r.mu.Unlock()
+
+	if err != nil {
+		slog.Warn("job failed", slog.String("job", job.Name), logger.Error(err))
+		return
+	}
+	slog.Debug("job done", slog.String("job", job.Name), slog.Duration("elapsed", time.Since(start)))
+}
@@ -2,13 +2,19 @@
 package logger
 
 import (
+	"io"
 	"log/slog"
 	"os"
 	"strings"
 )
 
-func New(level slog.Level) *slog.Logger {
-	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+// New logs to stderr and, when file is non-nil, to the rotating file as well.
+func New(level slog.Level, file *RotatingFile) *slog.Logger {
+	var out io.Writer = os.Stderr
+	if file != nil {
+		out = io.MultiWriter(os.Stderr, file)
+	}
+	return slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{
 		AddSource: true,
 		Level:     level,
 		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
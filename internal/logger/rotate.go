@@ -0,0 +1,126 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const backupTimeLayout = "20060102T150405"
+
+// RotatingFile is an append-only log file that is rotated once it grows past
+// MaxSize bytes or has been open for MaxAge. Rotated files are renamed to
+// <path>.<timestamp>; only the newest MaxBackups are kept.
+type RotatingFile struct {
+	Path       string
+	MaxSize    int64
+	MaxAge     time.Duration
+	MaxBackups int
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return 0, err
+		}
+	}
+	if f.due(int64(len(p))) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *RotatingFile) due(next int64) bool {
+	if f.MaxSize > 0 && f.size > 0 && f.size+next > f.MaxSize {
+		return true
+	}
+	return f.MaxAge > 0 && time.Since(f.openedAt) > f.MaxAge
+}
+
+func (f *RotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o750); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+	// Age counts from the file's last write so restarts do not keep
+	// postponing rotation forever.
+	f.openedAt = time.Now()
+	if f.size > 0 {
+		f.openedAt = info.ModTime()
+	}
+	return nil
+}
+
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+
+	backup := f.Path + "." + time.Now().UTC().Format(backupTimeLayout)
+	if err := os.Rename(f.Path, backup); err != nil {
+		return err
+	}
+	f.prune()
+	return f.open()
+}
+
+// prune removes the oldest backups beyond MaxBackups. The timestamp suffix
+// sorts chronologically.
+func (f *RotatingFile) prune() {
+	if f.MaxBackups <= 0 {
+		return
+	}
+	matches, err := filepath.Glob(f.Path + ".*")
+	if err != nil {
+		return
+	}
+	matches = slices.DeleteFunc(matches, func(m string) bool {
+		_, err := time.Parse(backupTimeLayout, strings.TrimPrefix(m, f.Path+"."))
+		return err != nil
+	})
+	slices.Sort(matches)
+	for len(matches) > f.MaxBackups {
+		_ = os.Remove(matches[0])
+		matches = matches[1:]
+	}
+}
@@ -0,0 +1,61 @@
+package mediaserver
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Jellyfin struct {
+	http   *http.Client
+	base   string
+	apiKey string
+}
+
+func NewJellyfin(base, apiKey string) *Jellyfin {
+	return &Jellyfin{
+		http:   &http.Client{Timeout: time.Minute},
+		base:   baseURL(base),
+		apiKey: apiKey,
+	}
+}
+
+func (j *Jellyfin) Name() string { return "jellyfin" }
+
+type jellyfinItems struct {
+	Items []struct {
+		Type        string            `json:"Type"`
+		ProviderIDs map[string]string `json:"ProviderIds"`
+	} `json:"Items"`
+}
+
+func (j *Jellyfin) Items(ctx context.Context) ([]Item, error) {
+	values := url.Values{}
+	values.Set("IncludeItemTypes", "Movie,Series")
+	values.Set("Recursive", "true")
+	values.Set("Fields", "ProviderIds")
+
+	header := http.Header{}
+	header.Set("X-Emby-Token", j.apiKey)
+
+	var resp jellyfinItems
+	if err := getJSON(ctx, j.http, j.base+"/Items?"+values.Encode(), header, &resp); err != nil {
+		return nil, err
+	}
+
+	out := make([]Item, 0, len(resp.Items))
+	for _, raw := range resp.Items {
+		item := Item{MediaType: "movie", IMDbID: raw.ProviderIDs["Imdb"]}
+		if raw.Type == "Series" {
+			item.MediaType = "tv"
+		}
+		item.TMDBID, _ = strconv.ParseInt(raw.ProviderIDs["Tmdb"], 10, 64)
+		if item.TMDBID == 0 && item.IMDbID == "" {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
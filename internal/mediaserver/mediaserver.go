@@ -0,0 +1,66 @@
+// Package mediaserver lists what is available on a Plex or Jellyfin server and
+// syncs that into the library.
+package mediaserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// Item is one movie or series on a media server, identified the way the
+// library identifies shows.
+type Item struct {
+	TMDBID    int64
+	IMDbID    string
+	MediaType string
+}
+
+type Client interface {
+	// Name is stored in the shows' available_on list ("plex", "jellyfin").
+	Name() string
+	Items(ctx context.Context) ([]Item, error)
+}
+
+// Sync marks every library show found on the server as available there and
+// clears the mark from shows that are gone.
+func Sync(ctx context.Context, st *store.Store, client Client) error {
+	items, err := client.Items(ctx)
+	if err != nil {
+		return err
+	}
+
+	refs := make([]store.MediaRef, 0, len(items))
+	for _, item := range items {
+		refs = append(refs, store.MediaRef{TMDBID: item.TMDBID, IMDbID: item.IMDbID, MediaType: item.MediaType})
+	}
+	return st.SetAvailability(ctx, client.Name(), refs)
+}
+
+func getJSON(ctx context.Context, httpClient *http.Client, endpoint string, header http.Header, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header = header
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("media server request failed: %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+func baseURL(raw string) string {
+	return strings.TrimRight(strings.TrimSpace(raw), "/")
+}
@@ -0,0 +1,95 @@
+package mediaserver
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Plex struct {
+	http  *http.Client
+	base  string
+	token string
+}
+
+func NewPlex(base, token string) *Plex {
+	return &Plex{
+		http:  &http.Client{Timeout: time.Minute},
+		base:  baseURL(base),
+		token: token,
+	}
+}
+
+func (p *Plex) Name() string { return "plex" }
+
+type plexSections struct {
+	MediaContainer struct {
+		Directory []struct {
+			Key  string `json:"key"`
+			Type string `json:"type"`
+		} `json:"Directory"`
+	} `json:"MediaContainer"`
+}
+
+type plexSection struct {
+	MediaContainer struct {
+		Metadata []struct {
+			Type string `json:"type"`
+			GUID []struct {
+				ID string `json:"id"`
+			} `json:"Guid"`
+		} `json:"Metadata"`
+	} `json:"MediaContainer"`
+}
+
+// Items walks the movie and TV sections. includeGuids makes Plex return the
+// external tmdb:// and imdb:// GUIDs next to its own.
+func (p *Plex) Items(ctx context.Context) ([]Item, error) {
+	header := http.Header{}
+	header.Set("X-Plex-Token", p.token)
+
+	var sections plexSections
+	if err := getJSON(ctx, p.http, p.base+"/library/sections", header, &sections); err != nil {
+		return nil, err
+	}
+
+	var out []Item
+	for _, dir := range sections.MediaContainer.Directory {
+		if dir.Type != "movie" && dir.Type != "show" {
+			continue
+		}
+
+		var section plexSection
+		endpoint := p.base + "/library/sections/" + url.PathEscape(dir.Key) + "/all?includeGuids=1"
+		if err := getJSON(ctx, p.http, endpoint, header, &section); err != nil {
+			return nil, err
+		}
+
+		for _, meta := range section.MediaContainer.Metadata {
+			item := Item{MediaType: "movie"}
+			if meta.Type == "show" {
+				item.MediaType = "tv"
+			}
+			for _, guid := range meta.GUID {
+				scheme, value, ok := strings.Cut(guid.ID, "://")
+				if !ok {
+					continue
+				}
+				switch scheme {
+				case "tmdb":
+					item.TMDBID, _ = strconv.ParseInt(value, 10, 64)
+				case "imdb":
+					item.IMDbID = value
+				}
+			}
+			if item.TMDBID == 0 && item.IMDbID == "" {
+				continue
+			}
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,109 @@
+// Package omdb fetches Rotten Tomatoes, Metacritic and IMDb scores from the
+// OMDb API.
+package omdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultBase = "https://www.omdbapi.com/"
+
+type Client struct {
+	http   *http.Client
+	base   string
+	apiKey string
+}
+
+func New(apiKey string) *Client {
+	return &Client{
+		http:   &http.Client{Timeout: 10 * time.Second},
+		base:   defaultBase,
+		apiKey: strings.TrimSpace(apiKey),
+	}
+}
+
+// Scores are the external scores OMDb knows for a title. Zero means the
+// source has no score.
+type Scores struct {
+	// RottenTomatoes is the Tomatometer percentage (0-100).
+	RottenTomatoes int
+	// Metascore is Metacritic's critic score (0-100).
+	Metascore  int
+	IMDbRating float64
+	IMDbVotes  int64
+}
+
+type titleResponse struct {
+	Response   string `json:"Response"`
+	Error      string `json:"Error"`
+	Metascore  string `json:"Metascore"`
+	IMDbRating string `json:"imdbRating"`
+	IMDbVotes  string `json:"imdbVotes"`
+	Ratings    []struct {
+		Source string `json:"Source"`
+		Value  string `json:"Value"`
+	} `json:"Ratings"`
+}
+
+// ErrNotFound is returned when OMDb has no title for the IMDb ID.
+var ErrNotFound = errors.New("omdb: title not found")
+
+// Scores looks the title up by IMDb ID.
+func (c *Client) Scores(ctx context.Context, imdbID string) (Scores, error) {
+	values := url.Values{}
+	values.Set("apikey", c.apiKey)
+	values.Set("i", imdbID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.base+"?"+values.Encode(), http.NoBody)
+	if err != nil {
+		return Scores{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Scores{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return Scores{}, fmt.Errorf("omdb request failed: %s", resp.Status)
+	}
+
+	var payload titleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return Scores{}, err
+	}
+	if payload.Response != "True" {
+		if strings.Contains(strings.ToLower(payload.Error), "not found") {
+			return Scores{}, ErrNotFound
+		}
+		return Scores{}, fmt.Errorf("omdb request failed: %s", payload.Error)
+	}
+
+	// OMDb uses "N/A" for missing values, which the parsers turn into zero.
+	scores := Scores{
+		Metascore: atoi(payload.Metascore),
+		IMDbVotes: int64(atoi(strings.ReplaceAll(payload.IMDbVotes, ",", ""))),
+	}
+	scores.IMDbRating, _ = strconv.ParseFloat(payload.IMDbRating, 64)
+	for _, rating := range payload.Ratings {
+		if rating.Source == "Rotten Tomatoes" {
+			scores.RottenTomatoes = atoi(strings.TrimSuffix(rating.Value, "%"))
+		}
+	}
+	return scores, nil
+}
+
+func atoi(raw string) int {
+	n, _ := strconv.Atoi(strings.TrimSpace(raw))
+	return n
+}
@@ -0,0 +1,172 @@
+// Package overseerr forwards download requests to Overseerr (or Jellyseerr,
+// which shares its API) and polls their status.
+package overseerr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/handsomefox/website-rating/internal/logger"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// Request statuses stored on shows. Overseerr's media status wins once it is
+// past "pending".
+const (
+	StatusPending            = "pending"
+	StatusProcessing         = "processing"
+	StatusPartiallyAvailable = "partially_available"
+	StatusAvailable          = "available"
+	StatusDeclined           = "declined"
+)
+
+type Client struct {
+	http   *http.Client
+	base   string
+	apiKey string
+}
+
+func New(base, apiKey string) *Client {
+	return &Client{
+		http:   &http.Client{Timeout: 30 * time.Second},
+		base:   strings.TrimRight(strings.TrimSpace(base), "/"),
+		apiKey: apiKey,
+	}
+}
+
+type requestResponse struct {
+	// 1 pending approval, 2 approved, 3 declined.
+	Status int `json:"status"`
+	Media  struct {
+		Status int `json:"status"`
+	} `json:"media"`
+}
+
+type mediaResponse struct {
+	MediaInfo *struct {
+		Status   int `json:"status"`
+		Requests []struct {
+			Status int `json:"status"`
+		} `json:"requests"`
+	} `json:"mediaInfo"`
+}
+
+// Request asks Overseerr for the title (all seasons for TV) and returns the
+// resulting status.
+func (c *Client) Request(ctx context.Context, tmdbID int64, mediaType string) (string, error) {
+	body := map[string]any{
+		"mediaType": mediaType,
+		"mediaId":   tmdbID,
+	}
+	if mediaType == "tv" {
+		body["seasons"] = "all"
+	}
+
+	var resp requestResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/request", body, &resp); err != nil {
+		return "", err
+	}
+	return toStatus(resp.Status, resp.Media.Status), nil
+}
+
+// Status returns the current status of a requested title, or "" when
+// Overseerr no longer knows about a request for it.
+func (c *Client) Status(ctx context.Context, tmdbID int64, mediaType string) (string, error) {
+	var resp mediaResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/"+mediaType+"/"+strconv.FormatInt(tmdbID, 10), nil, &resp); err != nil {
+		return "", err
+	}
+	if resp.MediaInfo == nil {
+		return "", nil
+	}
+	requestStatus := 0
+	if n := len(resp.MediaInfo.Requests); n > 0 {
+		requestStatus = resp.MediaInfo.Requests[n-1].Status
+	}
+	return toStatus(requestStatus, resp.MediaInfo.Status), nil
+}
+
+// toStatus maps Overseerr's request status (1 pending approval, 2 approved,
+// 3 declined) and media status (3 processing, 4 partially available,
+// 5 available) onto one show status.
+func toStatus(requestStatus, mediaStatus int) string {
+	switch mediaStatus {
+	case 5:
+		return StatusAvailable
+	case 4:
+		return StatusPartiallyAvailable
+	}
+	switch requestStatus {
+	case 3:
+		return StatusDeclined
+	case 2:
+		return StatusProcessing
+	}
+	if mediaStatus == 3 {
+		return StatusProcessing
+	}
+	return StatusPending
+}
+
+// Refresh updates the status of every open request.
+func Refresh(ctx context.Context, st *store.Store, client *Client) error {
+	shows, err := st.ListOpenRequests(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range shows {
+		show := &shows[i]
+		status, err := client.Status(ctx, show.TMDBID, show.MediaType)
+		if err != nil {
+			slog.Warn("overseerr status failed", slog.Int64("id", show.ID), logger.Error(err))
+			continue
+		}
+		if status == "" || status == show.RequestStatus.V {
+			continue
+		}
+		if err := st.SetRequestStatus(ctx, show.ID, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, dst any) error {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.base+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Api-Key", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("overseerr request failed: %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
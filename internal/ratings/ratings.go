@@ -0,0 +1,74 @@
+// Package ratings gathers external scores for library shows from pluggable
+// providers. Adding a source means implementing Provider and passing it to
+// the handlers alongside the others.
+package ratings
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/handsomefox/website-rating/internal/omdb"
+	"github.com/handsomefox/website-rating/internal/store"
+)
+
+// Provider fetches a show's ratings from one external service. Each call
+// replaces what the provider stored before, so a provider returns every
+// source it knows for the show.
+type Provider interface {
+	// Name identifies the provider in storage and logs.
+	Name() string
+	Ratings(ctx context.Context, show *store.Show) ([]store.ExternalRating, error)
+}
+
+// TMDB reports the vote average already stored from TMDB details.
+type TMDB struct{}
+
+func (TMDB) Name() string { return "tmdb" }
+
+func (TMDB) Ratings(_ context.Context, show *store.Show) ([]store.ExternalRating, error) {
+	if !show.TMDBRating.Valid {
+		return nil, nil
+	}
+	return []store.ExternalRating{{
+		Source: store.SourceTMDB,
+		Value:  show.TMDBRating.V,
+		Max:    10,
+		Votes:  show.TMDBVotes.V,
+	}}, nil
+}
+
+// OMDb reports IMDb, Rotten Tomatoes and Metacritic scores, looked up by
+// IMDb ID.
+type OMDb struct {
+	Client *omdb.Client
+}
+
+func (OMDb) Name() string { return "omdb" }
+
+func (p OMDb) Ratings(ctx context.Context, show *store.Show) ([]store.ExternalRating, error) {
+	imdbID := strings.TrimSpace(show.IMDbID.V)
+	if imdbID == "" {
+		return nil, nil
+	}
+
+	scores, err := p.Client.Scores(ctx, imdbID)
+	if errors.Is(err, omdb.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var out []store.ExternalRating
+	if scores.IMDbRating > 0 {
+		out = append(out, store.ExternalRating{Source: store.SourceIMDb, Value: scores.IMDbRating, Max: 10, Votes: scores.IMDbVotes})
+	}
+	if scores.RottenTomatoes > 0 {
+		out = append(out, store.ExternalRating{Source: store.SourceRottenTomatoes, Value: float64(scores.RottenTomatoes), Max: 100})
+	}
+	if scores.Metascore > 0 {
+		out = append(out, store.ExternalRating{Source: store.SourceMetacritic, Value: float64(scores.Metascore), Max: 100})
+	}
+	return out, nil
+}
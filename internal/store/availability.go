@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// MediaRef identifies a title on a media server. Either ID may be missing.
+type MediaRef struct {
+	TMDBID    int64
+	IMDbID    string
+	MediaType string
+}
+
+// SetAvailability records that exactly the given titles are available on the
+// named media server: matching shows get it added to available_on, all other
+// shows have it removed.
+func (s *Store) SetAvailability(ctx context.Context, server string, refs []MediaRef) error {
+	byTMDB := make(map[TMDBRef]bool, len(refs))
+	byIMDb := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		if ref.TMDBID > 0 {
+			byTMDB[TMDBRef{ID: ref.TMDBID, MediaType: ref.MediaType}] = true
+		}
+		if ref.IMDbID != "" {
+			byIMDb[ref.MediaType+":"+ref.IMDbID] = true
+		}
+	}
+
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var rows []struct {
+			ID          int64            `bun:"id"`
+			TMDBID      int64            `bun:"tmdb_id"`
+			MediaType   string           `bun:"media_type"`
+			IMDbID      sql.Null[string] `bun:"imdb_id"`
+			AvailableOn sql.Null[string] `bun:"available_on"`
+		}
+		if err := tx.NewSelect().
+			Table("shows").
+			Column("id", "tmdb_id", "media_type", "imdb_id", "available_on").
+			Scan(ctx, &rows); err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			available := byTMDB[TMDBRef{ID: row.TMDBID, MediaType: row.MediaType}] ||
+				(row.IMDbID.Valid && byIMDb[row.MediaType+":"+row.IMDbID.V])
+
+			servers := splitServers(row.AvailableOn.V)
+			has := slices.Contains(servers, server)
+			if available == has {
+				continue
+			}
+			if available {
+				servers = append(servers, server)
+				slices.Sort(servers)
+			} else {
+				servers = slices.DeleteFunc(servers, func(s string) bool { return s == server })
+			}
+
+			value := sql.Null[string]{V: strings.Join(servers, ","), Valid: len(servers) > 0}
+			if _, err := tx.NewUpdate().
+				Table("shows").
+				Set("available_on = ?", value).
+				Where("id = ?", row.ID).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func splitServers(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, ",")
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// ListCalendar returns shows with a date inside [from, to] (YYYY-MM-DD,
+// inclusive): planned titles releasing in the range and TV shows with an
+// episode airing in it.
+func (s *Store) ListCalendar(ctx context.Context, from, to string) ([]Show, error) {
+	out := []Show{}
+	err := s.db.NewSelect().
+		Model(&out).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("status = 'planned' AND release_date BETWEEN ? AND ?", from, to).
+				WhereOr("media_type = 'tv' AND next_air_date BETWEEN ? AND ?", from, to)
+		}).
+		OrderExpr("title COLLATE NOCASE ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListUpcoming returns planned shows releasing on or after from (YYYY-MM-DD),
+// soonest first.
+func (s *Store) ListUpcoming(ctx context.Context, from string) ([]Show, error) {
+	out := []Show{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("status = 'planned'").
+		Where("release_date >= ?", from).
+		OrderExpr("release_date ASC").
+		OrderExpr("title COLLATE NOCASE ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// Company is a TMDB production company (studio). shows.companies keeps the
+// comma-joined names for display; filtering goes through show_companies.
+type Company struct {
+	bun.BaseModel `bun:"table:companies,alias:co"`
+
+	ID   int64  `bun:"id,pk"`
+	Name string `bun:"name,notnull"`
+}
+
+type ShowCompany struct {
+	bun.BaseModel `bun:"table:show_companies,alias:sco"`
+
+	ShowID    int64 `bun:"show_id,pk"`
+	CompanyID int64 `bun:"company_id,pk"`
+}
+
+func replaceShowCompanies(ctx context.Context, db bun.IDB, showID int64, companies []Company) error {
+	if _, err := db.NewDelete().
+		Model((*ShowCompany)(nil)).
+		Where("show_id = ?", showID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(companies) == 0 {
+		return nil
+	}
+
+	if _, err := db.NewInsert().
+		Model(&companies).
+		On("CONFLICT (id) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Exec(ctx); err != nil {
+		return err
+	}
+	rows := make([]ShowCompany, 0, len(companies))
+	for _, c := range companies {
+		rows = append(rows, ShowCompany{ShowID: showID, CompanyID: c.ID})
+	}
+	_, err := db.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx)
+	return err
+}
+
+// ListCompanies returns the production companies of library shows, by name.
+func (s *Store) ListCompanies(ctx context.Context) ([]Company, error) {
+	out := []Company{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("EXISTS (SELECT 1 FROM show_companies AS sco WHERE sco.company_id = co.id)").
+		OrderExpr("co.name COLLATE NOCASE ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// decadeCutoff is the first decade with its own bucket; older titles share
+// the "pre-1970" one.
+const decadeCutoff = 1970
+
+var preDecadeLabel = "pre-" + strconv.Itoa(decadeCutoff)
+
+// DecadeLabel returns the decade bucket for a year, e.g. "1980s".
+func DecadeLabel(year int) string {
+	if year < decadeCutoff {
+		return preDecadeLabel
+	}
+	return strconv.Itoa(year/10*10) + "s"
+}
+
+// ParseDecade expands a decade bucket ("1980s" or "pre-1970") into an
+// inclusive year range; from is nil for the open-ended pre-1970 bucket.
+func ParseDecade(label string) (from, to *int, ok bool) {
+	label = strings.ToLower(strings.TrimSpace(label))
+	if label == preDecadeLabel {
+		end := decadeCutoff - 1
+		return nil, &end, true
+	}
+	start, err := strconv.Atoi(strings.TrimSuffix(label, "s"))
+	if err != nil || !strings.HasSuffix(label, "s") || start <= 0 || start%10 != 0 {
+		return nil, nil, false
+	}
+	end := start + 9
+	return &start, &end, true
+}
+
+// ListDecades returns the decade buckets that have library titles, oldest
+// first.
+func (s *Store) ListDecades(ctx context.Context) ([]string, error) {
+	var decades []int
+	err := s.db.NewSelect().
+		Table("shows").
+		ColumnExpr("DISTINCT (year / 10) * 10").
+		Where("year IS NOT NULL").
+		OrderExpr("1 ASC").
+		Scan(ctx, &decades)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []string{}
+	for _, decade := range decades {
+		label := DecadeLabel(decade)
+		if len(out) > 0 && out[len(out)-1] == label {
+			continue
+		}
+		out = append(out, label)
+	}
+	return out, nil
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// erasedTables are emptied by EraseAll. Child tables cascade from shows, and
+// show_changes and library_events follow it so the delete triggers' rows go
+// with them.
+var erasedTables = []string{
+	"shows",
+	"show_changes",
+	"library_events",
+	"preferences",
+	"search_history",
+	"smart_lists",
+	"settings",
+	"companies",
+	"networks",
+	"sessions",
+	"users",
+	"pending_imports",
+	"login_failures",
+	"tmdb_cache",
+	"tmdb_genres",
+	"tmdb_countries",
+	"tmdb_languages",
+}
+
+// EraseAll deletes every row in the database, resets ID counters and
+// vacuums the file so the old data is gone from disk too.
+func (s *Store) EraseAll(ctx context.Context) error {
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, table := range erasedTables {
+			if _, err := tx.NewDelete().Table(table).Where("1 = 1").Exec(ctx); err != nil {
+				return err
+			}
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM sqlite_sequence")
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, "VACUUM")
+	return err
+}
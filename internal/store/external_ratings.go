@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// External rating sources. Rotten Tomatoes and Metacritic are mirrored into
+// shows.rt_score/metascore for sorting and filtering.
+const (
+	SourceTMDB           = "tmdb"
+	SourceIMDb           = "imdb"
+	SourceRottenTomatoes = "rotten_tomatoes"
+	SourceMetacritic     = "metacritic"
+)
+
+// ExternalRating is one source's score for a show, on a 0-Max scale.
+type ExternalRating struct {
+	bun.BaseModel `bun:"table:external_ratings,alias:er"`
+
+	ShowID int64  `bun:"show_id,pk"`
+	Source string `bun:"source,pk"`
+	// Provider is the ratings provider that fetched the score.
+	Provider  string  `bun:"provider,notnull"`
+	Value     float64 `bun:"value,notnull"`
+	Max       float64 `bun:"max,notnull"`
+	Votes     int64   `bun:"votes,notnull"`
+	FetchedAt string  `bun:"fetched_at,notnull"`
+}
+
+// SetExternalRatings replaces everything the provider previously stored for
+// the show with ratings, stamped with the current time.
+func (s *Store) SetExternalRatings(ctx context.Context, showID int64, provider string, ratings []ExternalRating) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*ExternalRating)(nil)).
+			Where("show_id = ?", showID).
+			Where("provider = ?", provider).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if len(ratings) > 0 {
+			now := nowUTC()
+			rows := make([]ExternalRating, 0, len(ratings))
+			for _, r := range ratings {
+				r.ShowID = showID
+				r.Provider = provider
+				r.FetchedAt = now
+				rows = append(rows, r)
+			}
+			if _, err := tx.NewInsert().
+				Model(&rows).
+				On("CONFLICT (show_id, source) DO UPDATE").
+				Set("provider = EXCLUDED.provider").
+				Set("value = EXCLUDED.value").
+				Set("max = EXCLUDED.max").
+				Set("votes = EXCLUDED.votes").
+				Set("fetched_at = EXCLUDED.fetched_at").
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.NewUpdate().
+			Table("shows").
+			Set("rt_score = (SELECT CAST(value AS INTEGER) FROM external_ratings WHERE show_id = ? AND source = ?)", showID, SourceRottenTomatoes).
+			Set("metascore = (SELECT CAST(value AS INTEGER) FROM external_ratings WHERE show_id = ? AND source = ?)", showID, SourceMetacritic).
+			Where("id = ?", showID).
+			Exec(ctx)
+		return err
+	})
+}
+
+// ListExternalRatings returns a show's stored ratings in source order.
+func (s *Store) ListExternalRatings(ctx context.Context, showID int64) ([]ExternalRating, error) {
+	out := []ExternalRating{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("show_id = ?", showID).
+		OrderExpr(`CASE source
+			WHEN 'tmdb' THEN 0
+			WHEN 'imdb' THEN 1
+			WHEN 'rotten_tomatoes' THEN 2
+			WHEN 'metacritic' THEN 3
+			ELSE 4
+		END`).
+		OrderExpr("source ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
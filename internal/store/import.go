@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+)
+
+// ImportResult counts what ImportShows did with each show.
+type ImportResult struct {
+	Created int
+	Updated int
+	Skipped int
+	// Frozen counts existing shows whose ratings and comments were kept
+	// because they are frozen (see FreezeRatingsAfter).
+	Frozen int
+}
+
+// ImportShows restores shows from an export in one transaction. Shows not in
+// the library are created as exported. For shows already there, replace
+// overwrites status, watch date, picker, pin, ratings and comments with the
+// exported ones; otherwise only the library's empty fields are filled in and
+// watched wins over planned. Frozen ratings and comments are kept either way,
+// as are those of the people in keep ("bf"/"gf"), which new shows don't get.
+// Metadata of existing shows is left alone.
+func (s *Store) ImportShows(ctx context.Context, shows []Show, replace bool, keep ...string) (ImportResult, error) {
+	var result ImportResult
+	now := nowUTC()
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for i := range shows {
+			sh := shows[i]
+
+			var existing Show
+			err := tx.NewSelect().
+				Model(&existing).
+				Where("tmdb_id = ?", sh.TMDBID).
+				Where("media_type = ?", sh.MediaType).
+				Limit(1).
+				Scan(ctx)
+			if errors.Is(err, sql.ErrNoRows) {
+				keepPersonal(&sh, &existing, keep)
+				sh.ID = 0
+				if sh.CreatedAt == "" {
+					sh.CreatedAt = now
+				}
+				sh.UpdatedAt = now
+				if _, err := tx.NewInsert().Model(&sh).ExcludeColumn("id").Exec(ctx); err != nil {
+					return err
+				}
+				if err := tx.NewSelect().
+					Table("shows").
+					Column("id").
+					Where("tmdb_id = ?", sh.TMDBID).
+					Where("media_type = ?", sh.MediaType).
+					Limit(1).
+					Scan(ctx, &sh.ID); err != nil {
+					return err
+				}
+				if err := replaceShowTags(ctx, tx, sh.ID, &sh); err != nil {
+					return err
+				}
+				result.Created++
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			merged := mergeImported(existing, &sh, replace)
+			keepPersonal(&merged, &existing, keep)
+			if !sameRatings(&merged, &existing) {
+				err := s.ratingsOpen(ctx, tx, existing.ID)
+				if errors.Is(err, ErrRatingsFrozen) {
+					merged.BfRating, merged.GfRating = existing.BfRating, existing.GfRating
+					merged.BfComment, merged.GfComment = existing.BfComment, existing.GfComment
+					result.Frozen++
+				} else if err != nil {
+					return err
+				}
+			}
+			if samePersonalFields(&merged, &existing) {
+				result.Skipped++
+				continue
+			}
+			if _, err := tx.NewUpdate().
+				Model(&merged).
+				Column("status", "watched_at", "picked_by", "pinned", "bf_rating", "gf_rating", "bf_comment", "gf_comment").
+				Set("updated_at = ?", now).
+				WherePK().
+				Exec(ctx); err != nil {
+				return err
+			}
+			result.Updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return ImportResult{}, err
+	}
+	return result, s.refreshCoupleScores(ctx, 0)
+}
+
+// mergeImported applies an imported show's personal fields to the stored one.
+func mergeImported(existing Show, imported *Show, replace bool) Show {
+	out := existing
+	if replace {
+		out.Status = imported.Status
+		out.WatchedAt = imported.WatchedAt
+		out.PickedBy = imported.PickedBy
+		out.Pinned = imported.Pinned
+		out.BfRating, out.GfRating = imported.BfRating, imported.GfRating
+		out.BfComment, out.GfComment = imported.BfComment, imported.GfComment
+		return out
+	}
+
+	if imported.Status == StatusWatched {
+		out.Status = StatusWatched
+	}
+	out.WatchedAt = coalesce(out.WatchedAt, imported.WatchedAt)
+	out.PickedBy = coalesce(out.PickedBy, imported.PickedBy)
+	out.Pinned = out.Pinned || imported.Pinned
+	out.BfRating = coalesce(out.BfRating, imported.BfRating)
+	out.GfRating = coalesce(out.GfRating, imported.GfRating)
+	out.BfComment = coalesce(out.BfComment, imported.BfComment)
+	out.GfComment = coalesce(out.GfComment, imported.GfComment)
+	return out
+}
+
+// keepPersonal copies the ratings and comments of the people in keep from
+// src to dst.
+func keepPersonal(dst, src *Show, keep []string) {
+	for _, person := range keep {
+		switch person {
+		case PersonBf:
+			dst.BfRating, dst.BfComment = src.BfRating, src.BfComment
+		case PersonGf:
+			dst.GfRating, dst.GfComment = src.GfRating, src.GfComment
+		}
+	}
+}
+
+func samePersonalFields(a, b *Show) bool {
+	return a.Status == b.Status &&
+		a.WatchedAt == b.WatchedAt &&
+		a.PickedBy == b.PickedBy &&
+		a.Pinned == b.Pinned &&
+		sameRatings(a, b)
+}
+
+func sameRatings(a, b *Show) bool {
+	return a.BfRating == b.BfRating &&
+		a.GfRating == b.GfRating &&
+		a.BfComment == b.BfComment &&
+		a.GfComment == b.GfComment
+}
+
+func coalesce[T any](a, b sql.Null[T]) sql.Null[T] {
+	if a.Valid {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// IntegrityReport is the outcome of one CheckIntegrity run. Problems is empty
+// when the database is healthy.
+type IntegrityReport struct {
+	CheckedAt string
+	Problems  []string
+}
+
+// CheckIntegrity runs PRAGMA integrity_check and foreign_key_check and keeps
+// the report for LastIntegrity.
+func (s *Store) CheckIntegrity(ctx context.Context) (IntegrityReport, error) {
+	report := IntegrityReport{CheckedAt: time.Now().UTC().Format(time.RFC3339), Problems: []string{}}
+
+	rows, err := s.db.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return report, err
+	}
+	for rows.Next() {
+		var msg string
+		if err := rows.Scan(&msg); err != nil {
+			_ = rows.Close()
+			return report, err
+		}
+		if msg != "ok" {
+			report.Problems = append(report.Problems, msg)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return report, err
+	}
+
+	var fks []struct {
+		Table  string          `bun:"table"`
+		RowID  sql.Null[int64] `bun:"rowid"`
+		Parent string          `bun:"parent"`
+		FKID   int64           `bun:"fkid"`
+	}
+	if err := s.db.NewRaw("PRAGMA foreign_key_check").Scan(ctx, &fks); err != nil {
+		return report, err
+	}
+	for _, fk := range fks {
+		report.Problems = append(report.Problems,
+			fmt.Sprintf("%s row %d references missing %s row", fk.Table, fk.RowID.V, fk.Parent))
+	}
+
+	s.integrity.Store(&report)
+	return report, nil
+}
+
+// LastIntegrity returns the latest CheckIntegrity report, if any ran yet.
+func (s *Store) LastIntegrity() (IntegrityReport, bool) {
+	report := s.integrity.Load()
+	if report == nil {
+		return IntegrityReport{}, false
+	}
+	return *report, true
+}
+
+// Ping checks that the database answers.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.sqldb.PingContext(ctx)
+}
@@ -0,0 +1,109 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+)
+
+// Library event kinds.
+const (
+	EventAdded   = "added"
+	EventDeleted = "deleted"
+	EventRated   = "rated"
+	// EventRemapped is a show re-pointed at a new TMDB entry, and
+	// EventRemapSkipped one that wasn't because another show already had it.
+	// Both carry the new entry in TMDBID and the old one in OldTMDBID.
+	EventRemapped     = "remapped"
+	EventRemapSkipped = "remap_skipped"
+)
+
+// LibraryEvent is one entry of the library's change log, written by triggers
+// on shows. Old ratings are the show's before the change and new ones after
+// it, so an added show has no old ratings and a deleted one no new ones.
+type LibraryEvent struct {
+	bun.BaseModel `bun:"table:library_events,alias:le"`
+
+	ID          int64           `bun:"id,pk,autoincrement"`
+	ShowID      int64           `bun:"show_id,notnull"`
+	TMDBID      int64           `bun:"tmdb_id,notnull"`
+	MediaType   string          `bun:"media_type,notnull"`
+	Title       string          `bun:"title,notnull"`
+	Kind        string          `bun:"kind,notnull"`
+	OldBfRating sql.Null[int64] `bun:"old_bf_rating"`
+	OldGfRating sql.Null[int64] `bun:"old_gf_rating"`
+	NewBfRating sql.Null[int64] `bun:"new_bf_rating"`
+	NewGfRating sql.Null[int64] `bun:"new_gf_rating"`
+	OldTMDBID   sql.Null[int64] `bun:"old_tmdb_id"`
+	CreatedAt   string          `bun:"created_at,notnull"`
+}
+
+// LibraryDiff is the net change to the library over a period. A show that
+// was added and removed again within it shows up nowhere.
+type LibraryDiff struct {
+	// Added holds each new show's latest event, carrying its ratings.
+	Added []LibraryEvent
+	// Deleted holds each removed show's delete, carrying its last ratings.
+	Deleted []LibraryEvent
+	// Rated compares ratings of shows present throughout: old ratings from
+	// the period's start, new ones from its end.
+	Rated []LibraryEvent
+	// Remapped lists TMDB remaps, done or skipped, in order.
+	Remapped []LibraryEvent
+}
+
+// LibraryDiff summarizes changes logged in [from, to). Shows are matched by
+// TMDB entry, so a replace import that re-adds a show with the same ratings
+// is no change.
+func (s *Store) LibraryDiff(ctx context.Context, from, to string) (LibraryDiff, error) {
+	var events []LibraryEvent
+	err := s.db.NewSelect().
+		Model(&events).
+		Where("created_at >= ?", from).
+		Where("created_at < ?", to).
+		OrderExpr("id ASC").
+		Scan(ctx)
+	if err != nil {
+		return LibraryDiff{}, err
+	}
+
+	diff := LibraryDiff{Added: []LibraryEvent{}, Deleted: []LibraryEvent{}, Rated: []LibraryEvent{}, Remapped: []LibraryEvent{}}
+	var refs []TMDBRef
+	first := map[TMDBRef]LibraryEvent{}
+	last := map[TMDBRef]LibraryEvent{}
+	for _, event := range events {
+		if event.Kind == EventRemapped || event.Kind == EventRemapSkipped {
+			diff.Remapped = append(diff.Remapped, event)
+			continue
+		}
+		ref := TMDBRef{ID: event.TMDBID, MediaType: event.MediaType}
+		if _, ok := first[ref]; !ok {
+			first[ref] = event
+			refs = append(refs, ref)
+		}
+		last[ref] = event
+	}
+
+	for _, ref := range refs {
+		start, end := first[ref], last[ref]
+		existedBefore := start.Kind != EventAdded
+		existsAfter := end.Kind != EventDeleted
+		switch {
+		case !existedBefore && existsAfter:
+			diff.Added = append(diff.Added, end)
+		case existedBefore && !existsAfter:
+			diff.Deleted = append(diff.Deleted, end)
+		case existedBefore && existsAfter:
+			if start.OldBfRating == end.NewBfRating && start.OldGfRating == end.NewGfRating {
+				continue
+			}
+			change := end
+			change.Kind = EventRated
+			change.OldBfRating = start.OldBfRating
+			change.OldGfRating = start.OldGfRating
+			diff.Rated = append(diff.Rated, change)
+		}
+	}
+	return diff, nil
+}
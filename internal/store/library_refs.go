@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/uptrace/bun"
+)
+
+// libraryRefs caches every show's TMDB ref and status so search results can
+// be checked against the library without a query per request. A query hook
+// drops it whenever a statement writes to shows; the next lookup reloads it.
+type libraryRefs struct {
+	mu       sync.Mutex
+	statuses map[TMDBRef]string
+	// generation counts invalidations, so a load that raced a write isn't
+	// kept.
+	generation uint64
+}
+
+var _ bun.QueryHook = (*libraryRefs)(nil)
+
+func (c *libraryRefs) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	return ctx
+}
+
+func (c *libraryRefs) AfterQuery(_ context.Context, event *bun.QueryEvent) {
+	op := strings.ToUpper(event.Operation())
+	if strings.HasPrefix(op, "SELECT") || op == "BEGIN" || op == "COMMIT" || op == "ROLLBACK" {
+		return
+	}
+	// Any other statement naming shows counts; a spurious reload is cheap.
+	if !strings.Contains(event.Query, "shows") {
+		return
+	}
+	c.invalidate()
+}
+
+func (c *libraryRefs) invalidate() {
+	c.mu.Lock()
+	c.statuses = nil
+	c.generation++
+	c.mu.Unlock()
+}
+
+// libraryStatuses returns the cached statuses, loading them if needed. The
+// map must not be modified.
+func (s *Store) libraryStatuses(ctx context.Context) (map[TMDBRef]string, error) {
+	s.refs.mu.Lock()
+	statuses, generation := s.refs.statuses, s.refs.generation
+	s.refs.mu.Unlock()
+	if statuses != nil {
+		return statuses, nil
+	}
+
+	var rows []struct {
+		ID        int64  `bun:"tmdb_id"`
+		MediaType string `bun:"media_type"`
+		Status    string `bun:"status"`
+	}
+	err := s.db.NewSelect().
+		Table("shows").
+		Column("tmdb_id", "media_type", "status").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses = make(map[TMDBRef]string, len(rows))
+	for _, row := range rows {
+		statuses[TMDBRef{ID: row.ID, MediaType: row.MediaType}] = row.Status
+	}
+
+	s.refs.mu.Lock()
+	if s.refs.generation == generation {
+		s.refs.statuses = statuses
+	}
+	s.refs.mu.Unlock()
+	return statuses, nil
+}
+
+// WarmLibraryRefs loads the in-memory copy of the library's TMDB refs ahead
+// of the first search.
+func (s *Store) WarmLibraryRefs(ctx context.Context) error {
+	_, err := s.libraryStatuses(ctx)
+	return err
+}
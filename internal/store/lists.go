@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// SmartList is a saved set of library criteria that is materialized on read.
+// Criteria holds the handler-owned criteria encoded as JSON.
+type SmartList struct {
+	bun.BaseModel `bun:"table:smart_lists,alias:sl"`
+
+	ID        int64  `bun:"id,pk,autoincrement"`
+	Name      string `bun:"name,notnull"`
+	Criteria  string `bun:"criteria,notnull"`
+	CreatedAt string `bun:"created_at,notnull"`
+	UpdatedAt string `bun:"updated_at,notnull"`
+}
+
+func (s *Store) ListSmartLists(ctx context.Context) ([]SmartList, error) {
+	out := []SmartList{}
+	err := s.db.NewSelect().
+		Model(&out).
+		OrderExpr("name COLLATE NOCASE ASC").
+		Scan(ctx)
+	return out, err
+}
+
+func (s *Store) GetSmartList(ctx context.Context, id int64) (SmartList, error) {
+	var list SmartList
+	err := s.db.NewSelect().
+		Model(&list).
+		Where("id = ?", id).
+		Limit(1).
+		Scan(ctx)
+	return list, err
+}
+
+func (s *Store) CreateSmartList(ctx context.Context, name, criteria string) (int64, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, errors.New("name is required")
+	}
+
+	now := nowUTC()
+	list := SmartList{
+		Name:      name,
+		Criteria:  criteria,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := s.db.NewInsert().Model(&list).Exec(ctx); err != nil {
+		return 0, err
+	}
+	return list.ID, nil
+}
+
+func (s *Store) UpdateSmartList(ctx context.Context, id int64, name, criteria string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	res, err := s.db.NewUpdate().
+		Table("smart_lists").
+		Set("name = ?", name).
+		Set("criteria = ?", criteria).
+		Set("updated_at = ?", nowUTC()).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+func (s *Store) DeleteSmartList(ctx context.Context, id int64) error {
+	res, err := s.db.NewDelete().
+		Table("smart_lists").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// loginFailure counts failed sign-ins from one IP within an hour.
+type loginFailure struct {
+	bun.BaseModel `bun:"table:login_failures,alias:lf"`
+
+	IP     string `bun:"ip,pk"`
+	Bucket string `bun:"bucket,pk"`
+	Count  int64  `bun:"count,notnull"`
+	LastAt string `bun:"last_at,notnull"`
+}
+
+// loginFailureRetention is how long failed sign-ins are kept for the report.
+const loginFailureRetention = 30 * 24 * time.Hour
+
+// LoginFailureIP is the failed sign-ins from one IP.
+type LoginFailureIP struct {
+	IP     string `bun:"ip"`
+	Count  int64  `bun:"count"`
+	LastAt string `bun:"last_at"`
+}
+
+// LoginFailureBucket is the failed sign-ins in one hour, from any IP.
+type LoginFailureBucket struct {
+	Start string `bun:"bucket"`
+	Count int64  `bun:"count"`
+}
+
+type LoginFailureReport struct {
+	Total   int64
+	IPs     []LoginFailureIP
+	Buckets []LoginFailureBucket
+}
+
+func loginFailureBucket(t time.Time) string {
+	return t.UTC().Truncate(time.Hour).Format(time.RFC3339)
+}
+
+// RecordLoginFailure counts a failed sign-in from ip and drops failures past
+// the retention period.
+func (s *Store) RecordLoginFailure(ctx context.Context, ip string) error {
+	now := time.Now().UTC()
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		row := loginFailure{IP: ip, Bucket: loginFailureBucket(now), Count: 1, LastAt: now.Format(time.RFC3339)}
+		if _, err := tx.NewInsert().
+			Model(&row).
+			On("CONFLICT (ip, bucket) DO UPDATE").
+			Set("count = lf.count + 1").
+			Set("last_at = EXCLUDED.last_at").
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.NewDelete().
+			Model((*loginFailure)(nil)).
+			Where("bucket < ?", loginFailureBucket(now.Add(-loginFailureRetention))).
+			Exec(ctx)
+		return err
+	})
+}
+
+// LoginFailureCount returns the failed sign-ins from ip since the start of
+// the hour that contains since.
+func (s *Store) LoginFailureCount(ctx context.Context, ip string, since time.Time) (int64, error) {
+	var count int64
+	err := s.db.NewSelect().
+		Model((*loginFailure)(nil)).
+		ColumnExpr("COALESCE(SUM(count), 0)").
+		Where("ip = ?", ip).
+		Where("bucket >= ?", loginFailureBucket(since)).
+		Scan(ctx, &count)
+	return count, err
+}
+
+// LoginFailures aggregates failed sign-ins since the start of the hour that
+// contains since, by IP (most failures first) and by hour (oldest first).
+func (s *Store) LoginFailures(ctx context.Context, since time.Time) (LoginFailureReport, error) {
+	report := LoginFailureReport{IPs: []LoginFailureIP{}, Buckets: []LoginFailureBucket{}}
+	from := loginFailureBucket(since)
+
+	if err := s.db.NewSelect().
+		Model((*loginFailure)(nil)).
+		ColumnExpr("ip, SUM(count) AS count, MAX(last_at) AS last_at").
+		Where("bucket >= ?", from).
+		GroupExpr("ip").
+		OrderExpr("count DESC, last_at DESC").
+		Scan(ctx, &report.IPs); err != nil {
+		return LoginFailureReport{}, err
+	}
+	if err := s.db.NewSelect().
+		Model((*loginFailure)(nil)).
+		ColumnExpr("bucket, SUM(count) AS count").
+		Where("bucket >= ?", from).
+		GroupExpr("bucket").
+		OrderExpr("bucket ASC").
+		Scan(ctx, &report.Buckets); err != nil {
+		return LoginFailureReport{}, err
+	}
+
+	for _, ip := range report.IPs {
+		report.Total += ip.Count
+	}
+	return report, nil
+}
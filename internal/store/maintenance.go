@@ -0,0 +1,56 @@
+package store
+
+import (
+	"context"
+)
+
+// OptimizeResult reports the database file size around an Optimize run.
+type OptimizeResult struct {
+	SizeBefore int64
+	SizeAfter  int64
+}
+
+// Optimize refreshes query planner statistics and returns free pages to the
+// filesystem. The first run on an older file switches it to incremental
+// auto-vacuum, which needs one full VACUUM.
+func (s *Store) Optimize(ctx context.Context) (OptimizeResult, error) {
+	var res OptimizeResult
+	var err error
+	if res.SizeBefore, err = s.fileSize(ctx); err != nil {
+		return res, err
+	}
+
+	var autoVacuum int
+	if err := s.db.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&autoVacuum); err != nil {
+		return res, err
+	}
+
+	stmts := []string{"ANALYZE", "PRAGMA optimize"}
+	const incremental = 2
+	if autoVacuum != incremental {
+		stmts = append(stmts, "PRAGMA auto_vacuum = INCREMENTAL", "VACUUM")
+	} else {
+		stmts = append(stmts, "PRAGMA incremental_vacuum")
+	}
+	stmts = append(stmts, "PRAGMA wal_checkpoint(TRUNCATE)", "PRAGMA shrink_memory")
+
+	for _, stmt := range stmts {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return res, err
+		}
+	}
+
+	res.SizeAfter, err = s.fileSize(ctx)
+	return res, err
+}
+
+func (s *Store) fileSize(ctx context.Context) (int64, error) {
+	var pages, pageSize int64
+	if err := s.db.QueryRowContext(ctx, "PRAGMA page_count").Scan(&pages); err != nil {
+		return 0, err
+	}
+	if err := s.db.QueryRowContext(ctx, "PRAGMA page_size").Scan(&pageSize); err != nil {
+		return 0, err
+	}
+	return pages * pageSize, nil
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// Network is a TMDB TV network (HBO, BBC One). shows.networks keeps the
+// comma-joined names for display; filtering goes through show_networks.
+type Network struct {
+	bun.BaseModel `bun:"table:networks,alias:nw"`
+
+	ID   int64  `bun:"id,pk"`
+	Name string `bun:"name,notnull"`
+}
+
+type ShowNetwork struct {
+	bun.BaseModel `bun:"table:show_networks,alias:sn"`
+
+	ShowID    int64 `bun:"show_id,pk"`
+	NetworkID int64 `bun:"network_id,pk"`
+}
+
+func replaceShowNetworks(ctx context.Context, db bun.IDB, showID int64, networks []Network) error {
+	if _, err := db.NewDelete().
+		Model((*ShowNetwork)(nil)).
+		Where("show_id = ?", showID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(networks) == 0 {
+		return nil
+	}
+
+	if _, err := db.NewInsert().
+		Model(&networks).
+		On("CONFLICT (id) DO UPDATE").
+		Set("name = EXCLUDED.name").
+		Exec(ctx); err != nil {
+		return err
+	}
+	rows := make([]ShowNetwork, 0, len(networks))
+	for _, n := range networks {
+		rows = append(rows, ShowNetwork{ShowID: showID, NetworkID: n.ID})
+	}
+	_, err := db.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx)
+	return err
+}
+
+// ListNetworks returns the networks of library shows, by name.
+func (s *Store) ListNetworks(ctx context.Context) ([]Network, error) {
+	out := []Network{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("EXISTS (SELECT 1 FROM show_networks AS sn WHERE sn.network_id = nw.id)").
+		OrderExpr("nw.name COLLATE NOCASE ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
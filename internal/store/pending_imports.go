@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/uptrace/bun"
+)
+
+// PendingCandidate is a suggested TMDB match for a pending import. It has
+// the same fields as tmdb.SearchResult so the two convert directly.
+type PendingCandidate struct {
+	MediaType        string   `json:"media_type"`
+	Title            string   `json:"title"`
+	Year             string   `json:"year"`
+	PosterPath       string   `json:"poster_path"`
+	Overview         string   `json:"overview"`
+	ID               int64    `json:"id"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int      `json:"vote_count"`
+	GenreIDs         []int    `json:"genre_ids"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+}
+
+// PendingImport is an imported row whose title matched more than one TMDB
+// entry. It keeps the row's ratings until someone confirms the right match.
+type PendingImport struct {
+	bun.BaseModel `bun:"table:pending_imports,alias:pi"`
+
+	ID int64 `bun:"id,pk,autoincrement"`
+	// Source names the importer, e.g. "csv".
+	Source    string           `bun:"source,notnull"`
+	Line      int              `bun:"line,notnull"`
+	Title     string           `bun:"title,notnull"`
+	Year      string           `bun:"year,notnull"`
+	MediaType string           `bun:"media_type,notnull"`
+	Status    string           `bun:"status,notnull"`
+	BfRating  sql.Null[int64]  `bun:"bf_rating"`
+	GfRating  sql.Null[int64]  `bun:"gf_rating"`
+	BfComment sql.Null[string] `bun:"bf_comment"`
+	GfComment sql.Null[string] `bun:"gf_comment"`
+	// RawCandidates is the JSON-encoded Candidates.
+	RawCandidates string             `bun:"candidates,notnull"`
+	Candidates    []PendingCandidate `bun:"-"`
+	CreatedAt     string             `bun:"created_at,notnull"`
+}
+
+// AddPendingImports stores rows for review and sets their IDs. A row
+// replaces an earlier pending one for the same title, so importing the same
+// file twice doesn't queue it twice.
+func (s *Store) AddPendingImports(ctx context.Context, rows []PendingImport) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	now := nowUTC()
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for i := range rows {
+			row := &rows[i]
+			if row.Candidates == nil {
+				row.Candidates = []PendingCandidate{}
+			}
+			raw, err := json.Marshal(row.Candidates)
+			if err != nil {
+				return err
+			}
+			row.RawCandidates = string(raw)
+			row.CreatedAt = now
+
+			if _, err := tx.NewDelete().
+				Model((*PendingImport)(nil)).
+				Where("source = ?", row.Source).
+				Where("title = ?", row.Title).
+				Where("year = ?", row.Year).
+				Where("media_type = ?", row.MediaType).
+				Exec(ctx); err != nil {
+				return err
+			}
+			if _, err := tx.NewInsert().Model(row).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListPendingImports returns the rows waiting for review, oldest first.
+func (s *Store) ListPendingImports(ctx context.Context) ([]PendingImport, error) {
+	out := []PendingImport{}
+	if err := s.db.NewSelect().Model(&out).OrderExpr("id ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+	for i := range out {
+		if err := json.Unmarshal([]byte(out[i].RawCandidates), &out[i].Candidates); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// GetPendingImport returns one pending row.
+func (s *Store) GetPendingImport(ctx context.Context, id int64) (PendingImport, error) {
+	var out PendingImport
+	if err := s.db.NewSelect().Model(&out).Where("id = ?", id).Limit(1).Scan(ctx); err != nil {
+		return PendingImport{}, err
+	}
+	if err := json.Unmarshal([]byte(out.RawCandidates), &out.Candidates); err != nil {
+		return PendingImport{}, err
+	}
+	return out, nil
+}
+
+// DeletePendingImport drops a row from review, once confirmed or dismissed.
+func (s *Store) DeletePendingImport(ctx context.Context, id int64) error {
+	res, err := s.db.NewDelete().Model((*PendingImport)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// PickCounts tallies who chose the watched shows.
+type PickCounts struct {
+	Bf int64
+	Gf int64
+	// Unattributed counts watched shows without a recorded picker.
+	Unattributed int64
+	// LastPicker is the picker of the most recently watched attributed show.
+	LastPicker string
+}
+
+// Turn is whose turn it is to pick: whoever picked less, or on a tie the
+// person who did not pick last.
+func (c PickCounts) Turn() string {
+	switch {
+	case c.Bf < c.Gf:
+		return PersonBf
+	case c.Gf < c.Bf:
+		return PersonGf
+	case c.LastPicker == PersonBf:
+		return PersonGf
+	default:
+		return PersonBf
+	}
+}
+
+func (s *Store) PickCounts(ctx context.Context) (PickCounts, error) {
+	var counts PickCounts
+	if err := s.db.NewSelect().
+		Table("shows").
+		ColumnExpr("COUNT(CASE WHEN picked_by = ? THEN 1 END)", PersonBf).
+		ColumnExpr("COUNT(CASE WHEN picked_by = ? THEN 1 END)", PersonGf).
+		ColumnExpr("COUNT(CASE WHEN picked_by IS NULL THEN 1 END)").
+		Where("status = ?", StatusWatched).
+		Scan(ctx, &counts.Bf, &counts.Gf, &counts.Unattributed); err != nil {
+		return PickCounts{}, err
+	}
+
+	var last sql.Null[string]
+	err := s.db.NewSelect().
+		Table("shows").
+		Column("picked_by").
+		Where("status = ?", StatusWatched).
+		Where("picked_by IS NOT NULL").
+		OrderExpr("watched_at DESC, id DESC").
+		Limit(1).
+		Scan(ctx, &last)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return PickCounts{}, err
+	}
+	counts.LastPicker = last.V
+	return counts, nil
+}
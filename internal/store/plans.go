@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+)
+
+// Plan schedules a show for a movie night. Each partner confirms separately.
+type Plan struct {
+	bun.BaseModel `bun:"table:plans,alias:pl"`
+
+	ID          int64            `bun:"id,pk,autoincrement"`
+	ShowID      int64            `bun:"show_id,notnull"`
+	ScheduledAt string           `bun:"scheduled_at,notnull"`
+	Note        sql.Null[string] `bun:"note,nullzero"`
+	BfConfirmed bool             `bun:"bf_confirmed,notnull"`
+	GfConfirmed bool             `bun:"gf_confirmed,notnull"`
+	CompletedAt sql.Null[string] `bun:"completed_at,nullzero"`
+	CreatedAt   string           `bun:"created_at,notnull"`
+	UpdatedAt   string           `bun:"updated_at,notnull"`
+}
+
+// Confirm records the person's RSVP.
+func (p *Plan) Confirm(person string, going bool) {
+	switch person {
+	case PersonBf:
+		p.BfConfirmed = going
+	case PersonGf:
+		p.GfConfirmed = going
+	}
+}
+
+// ListPlans returns plans soonest first. Completed ones are left out unless
+// includeCompleted is set.
+func (s *Store) ListPlans(ctx context.Context, includeCompleted bool) ([]Plan, error) {
+	out := []Plan{}
+	q := s.db.NewSelect().Model(&out)
+	if !includeCompleted {
+		q = q.Where("completed_at IS NULL")
+	}
+	if err := q.OrderExpr("scheduled_at ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListPlansBetween returns plans scheduled inside [from, to) (RFC 3339),
+// soonest first.
+func (s *Store) ListPlansBetween(ctx context.Context, from, to string) ([]Plan, error) {
+	out := []Plan{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("scheduled_at >= ? AND scheduled_at < ?", from, to).
+		OrderExpr("scheduled_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *Store) GetPlan(ctx context.Context, id int64) (Plan, error) {
+	var plan Plan
+	err := s.db.NewSelect().
+		Model(&plan).
+		Where("id = ?", id).
+		Limit(1).
+		Scan(ctx)
+	return plan, err
+}
+
+// CreatePlan stores the plan and sets its ID. The show must exist.
+func (s *Store) CreatePlan(ctx context.Context, plan *Plan) error {
+	now := nowUTC()
+	plan.CreatedAt = now
+	plan.UpdatedAt = now
+	_, err := s.db.NewInsert().Model(plan).Returning("id").Exec(ctx)
+	return err
+}
+
+// SavePlan writes the plan's schedule, note and RSVPs.
+func (s *Store) SavePlan(ctx context.Context, plan *Plan) error {
+	plan.UpdatedAt = nowUTC()
+	res, err := s.db.NewUpdate().
+		Model(plan).
+		Column("scheduled_at", "note", "bf_confirmed", "gf_confirmed", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// CompletePlan marks the plan done and its show watched now.
+func (s *Store) CompletePlan(ctx context.Context, id int64) error {
+	now := nowUTC()
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var plan Plan
+		if err := tx.NewSelect().Model(&plan).Where("id = ?", id).Limit(1).Scan(ctx); err != nil {
+			return err
+		}
+		if err := checkCanWatch(ctx, tx, plan.ShowID, RatingsUpdate{}); err != nil {
+			return err
+		}
+		if _, err := tx.NewUpdate().
+			Model((*Plan)(nil)).
+			Set("completed_at = ?", now).
+			Set("updated_at = ?", now).
+			Where("id = ?", id).
+			Exec(ctx); err != nil {
+			return err
+		}
+		res, err := tx.NewUpdate().
+			Table("shows").
+			Set("status = ?", StatusWatched).
+			Set("watched_at = ?", now).
+			Set("updated_at = ?", now).
+			Where("id = ?", plan.ShowID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return expectRowsAffected(res)
+	})
+}
+
+func (s *Store) DeletePlan(ctx context.Context, id int64) error {
+	res, err := s.db.NewDelete().
+		Model((*Plan)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// ShowsByID loads the shows with the given IDs. Missing IDs are skipped.
+func (s *Store) ShowsByID(ctx context.Context, ids []int64) (map[int64]Show, error) {
+	out := make(map[int64]Show, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	var shows []Show
+	if err := s.db.NewSelect().Model(&shows).Where("id IN (?)", bun.In(ids)).Scan(ctx); err != nil {
+		return nil, err
+	}
+	for _, show := range shows {
+		out[show.ID] = show
+	}
+	return out, nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+)
+
+// Persons that can hold preferences, matching the bf_/gf_ rating columns.
+const (
+	PersonBf = "bf"
+	PersonGf = "gf"
+)
+
+type Preferences struct {
+	bun.BaseModel `bun:"table:preferences,alias:p"`
+
+	Person           string           `bun:"person,pk"`
+	MetadataLanguage sql.Null[string] `bun:"metadata_language,nullzero"`
+	UpdatedAt        string           `bun:"updated_at,notnull"`
+
+	// RemindersSnoozedUntil pauses rating reminders until then (RFC 3339).
+	RemindersSnoozedUntil sql.Null[string] `bun:"reminders_snoozed_until,nullzero"`
+}
+
+func ValidPerson(person string) bool {
+	return person == PersonBf || person == PersonGf
+}
+
+// GetPreferences returns the person's preferences, or empty preferences when
+// none were saved yet.
+func (s *Store) GetPreferences(ctx context.Context, person string) (Preferences, error) {
+	prefs := Preferences{Person: person}
+	err := s.db.NewSelect().
+		Model(&prefs).
+		Where("person = ?", person).
+		Limit(1).
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Preferences{}, err
+	}
+	return prefs, nil
+}
+
+func (s *Store) SetMetadataLanguage(ctx context.Context, person string, language sql.Null[string]) error {
+	prefs := Preferences{
+		Person:           person,
+		MetadataLanguage: language,
+		UpdatedAt:        nowUTC(),
+	}
+
+	_, err := s.db.NewInsert().
+		Model(&prefs).
+		On("CONFLICT (person) DO UPDATE").
+		Set("metadata_language = EXCLUDED.metadata_language").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+// SnoozeReminders pauses the person's rating reminders until the given time;
+// a null time resumes them.
+func (s *Store) SnoozeReminders(ctx context.Context, person string, until sql.Null[string]) error {
+	prefs := Preferences{
+		Person:                person,
+		RemindersSnoozedUntil: until,
+		UpdatedAt:             nowUTC(),
+	}
+
+	_, err := s.db.NewInsert().
+		Model(&prefs).
+		On("CONFLICT (person) DO UPDATE").
+		Set("reminders_snoozed_until = EXCLUDED.reminders_snoozed_until").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ErrRatingsFrozen is returned by rating writes once the show's ratings are
+// locked (see FreezeRatingsAfter).
+var ErrRatingsFrozen = errors.New("ratings are frozen")
+
+// FreezeRatingsAfter locks a show's ratings d after it was watched, so they
+// keep the first reaction. Zero disables the lock. Call it once, right after
+// Open.
+func (s *Store) FreezeRatingsAfter(d time.Duration) {
+	s.freezeAfter = d
+}
+
+// checkRatingsOpen returns ErrRatingsFrozen when the show's ratings are
+// locked and sql.ErrNoRows when there is no such show.
+func (s *Store) checkRatingsOpen(ctx context.Context, id int64) error {
+	return s.ratingsOpen(ctx, s.db, id)
+}
+
+// ratingsOpen is checkRatingsOpen on db, for use inside transactions. The
+// lock window starts at first_watched_at, or at the last UnfreezeRatings when
+// that is later. Unlike watched_at, first_watched_at can't be moved through
+// the API, so the window can't be restarted by editing the show.
+func (s *Store) ratingsOpen(ctx context.Context, db bun.IDB, id int64) error {
+	if s.freezeAfter <= 0 {
+		return nil
+	}
+
+	var row struct {
+		FirstWatchedAt sql.Null[string] `bun:"first_watched_at"`
+		UnfrozenAt     sql.Null[string] `bun:"ratings_unfrozen_at"`
+	}
+	err := db.NewSelect().
+		Table("shows").
+		Column("first_watched_at", "ratings_unfrozen_at").
+		Where("id = ?", id).
+		Limit(1).
+		Scan(ctx, &row)
+	if err != nil {
+		return err
+	}
+
+	start := row.FirstWatchedAt
+	if row.UnfrozenAt.Valid && (!start.Valid || row.UnfrozenAt.V > start.V) {
+		start = row.UnfrozenAt
+	}
+	if !start.Valid {
+		return nil
+	}
+	opened, err := time.Parse(time.RFC3339, start.V)
+	if err != nil {
+		// Hand-edited dates in another format never lock.
+		return nil
+	}
+	if time.Since(opened) > s.freezeAfter {
+		return ErrRatingsFrozen
+	}
+	return nil
+}
+
+// UnfreezeRatings reopens a show's ratings for another freeze period.
+func (s *Store) UnfreezeRatings(ctx context.Context, id int64) error {
+	res, err := s.db.NewUpdate().
+		Table("shows").
+		Set("ratings_unfrozen_at = ?", nowUTC()).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
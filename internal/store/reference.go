@@ -0,0 +1,174 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Reference data is TMDB's genre, country and language lists, kept so they
+// survive restarts and can be served while TMDB is down. Genres and
+// countries are stored per request language ("" is TMDB's English default).
+
+type RefGenre struct {
+	ID   int
+	Name string
+}
+
+type RefCountry struct {
+	Code string
+	Name string
+}
+
+type RefLanguage struct {
+	Code string
+	Name string
+}
+
+type refGenreRow struct {
+	bun.BaseModel `bun:"table:tmdb_genres,alias:tg"`
+
+	Language  string `bun:"language,pk"`
+	MediaType string `bun:"media_type,pk"`
+	ID        int    `bun:"id,pk"`
+	Name      string `bun:"name,notnull"`
+	FetchedAt string `bun:"fetched_at,notnull"`
+}
+
+type refCountryRow struct {
+	bun.BaseModel `bun:"table:tmdb_countries,alias:tco"`
+
+	Language  string `bun:"language,pk"`
+	Code      string `bun:"code,pk"`
+	Name      string `bun:"name,notnull"`
+	FetchedAt string `bun:"fetched_at,notnull"`
+}
+
+type refLanguageRow struct {
+	bun.BaseModel `bun:"table:tmdb_languages,alias:tl"`
+
+	Code      string `bun:"code,pk"`
+	Name      string `bun:"name,notnull"`
+	FetchedAt string `bun:"fetched_at,notnull"`
+}
+
+// RefGenres returns the stored genres for a language and media type, in
+// TMDB's order, and when they were fetched. No rows means a zero time.
+func (s *Store) RefGenres(ctx context.Context, language, mediaType string) ([]RefGenre, time.Time, error) {
+	var rows []refGenreRow
+	err := s.db.NewSelect().
+		Model(&rows).
+		Where("language = ?", language).
+		Where("media_type = ?", mediaType).
+		OrderExpr("rowid ASC").
+		Scan(ctx)
+	if err != nil || len(rows) == 0 {
+		return nil, time.Time{}, err
+	}
+	out := make([]RefGenre, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, RefGenre{ID: row.ID, Name: row.Name})
+	}
+	fetchedAt, err := time.Parse(time.RFC3339, rows[0].FetchedAt)
+	return out, fetchedAt, err
+}
+
+// SaveRefGenres replaces the stored genres for a language and media type.
+func (s *Store) SaveRefGenres(ctx context.Context, language, mediaType string, genres []RefGenre) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*refGenreRow)(nil)).
+			Where("language = ?", language).
+			Where("media_type = ?", mediaType).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if len(genres) == 0 {
+			return nil
+		}
+		rows := make([]refGenreRow, 0, len(genres))
+		for _, genre := range genres {
+			rows = append(rows, refGenreRow{Language: language, MediaType: mediaType, ID: genre.ID, Name: genre.Name, FetchedAt: now})
+		}
+		_, err := tx.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx)
+		return err
+	})
+}
+
+// RefCountries returns the stored countries for a language, in the order
+// they were saved, and when they were fetched.
+func (s *Store) RefCountries(ctx context.Context, language string) ([]RefCountry, time.Time, error) {
+	var rows []refCountryRow
+	err := s.db.NewSelect().
+		Model(&rows).
+		Where("language = ?", language).
+		OrderExpr("rowid ASC").
+		Scan(ctx)
+	if err != nil || len(rows) == 0 {
+		return nil, time.Time{}, err
+	}
+	out := make([]RefCountry, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, RefCountry{Code: row.Code, Name: row.Name})
+	}
+	fetchedAt, err := time.Parse(time.RFC3339, rows[0].FetchedAt)
+	return out, fetchedAt, err
+}
+
+// SaveRefCountries replaces the stored countries for a language.
+func (s *Store) SaveRefCountries(ctx context.Context, language string, countries []RefCountry) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*refCountryRow)(nil)).
+			Where("language = ?", language).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if len(countries) == 0 {
+			return nil
+		}
+		rows := make([]refCountryRow, 0, len(countries))
+		for _, country := range countries {
+			rows = append(rows, refCountryRow{Language: language, Code: country.Code, Name: country.Name, FetchedAt: now})
+		}
+		_, err := tx.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx)
+		return err
+	})
+}
+
+// RefLanguages returns the stored languages, in the order they were saved,
+// and when they were fetched.
+func (s *Store) RefLanguages(ctx context.Context) ([]RefLanguage, time.Time, error) {
+	var rows []refLanguageRow
+	if err := s.db.NewSelect().Model(&rows).OrderExpr("rowid ASC").Scan(ctx); err != nil || len(rows) == 0 {
+		return nil, time.Time{}, err
+	}
+	out := make([]RefLanguage, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, RefLanguage{Code: row.Code, Name: row.Name})
+	}
+	fetchedAt, err := time.Parse(time.RFC3339, rows[0].FetchedAt)
+	return out, fetchedAt, err
+}
+
+// SaveRefLanguages replaces the stored languages.
+func (s *Store) SaveRefLanguages(ctx context.Context, languages []RefLanguage) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().Model((*refLanguageRow)(nil)).Where("1 = 1").Exec(ctx); err != nil {
+			return err
+		}
+		if len(languages) == 0 {
+			return nil
+		}
+		rows := make([]refLanguageRow, 0, len(languages))
+		for _, language := range languages {
+			rows = append(rows, refLanguageRow{Code: language.Code, Name: language.Name, FetchedAt: now})
+		}
+		_, err := tx.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx)
+		return err
+	})
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// RatingReminder lists the watched shows one person still has to rate.
+type RatingReminder struct {
+	Person string
+	Shows  []Show
+}
+
+// RemindersSnoozed reports whether rating reminders are paused at now.
+func (p Preferences) RemindersSnoozed(now time.Time) bool {
+	return p.RemindersSnoozedUntil.Valid && p.RemindersSnoozedUntil.V > now.UTC().Format(time.RFC3339)
+}
+
+// ListUnratedWatched returns shows watched before the given time that the
+// person has not rated, oldest first.
+func (s *Store) ListUnratedWatched(ctx context.Context, person string, before time.Time) ([]Show, error) {
+	out := []Show{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("status = ?", StatusWatched).
+		Where("watched_at IS NOT NULL AND watched_at < ?", before.UTC().Format(time.RFC3339)).
+		Where("? IS NULL", bun.Ident(person+"_rating")).
+		OrderExpr("watched_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatingReminders returns, for each person whose reminders are not snoozed,
+// the shows watched more than after ago that they have not rated. People
+// with nothing to rate are left out.
+func (s *Store) RatingReminders(ctx context.Context, after time.Duration) ([]RatingReminder, error) {
+	now := time.Now()
+	var out []RatingReminder
+	for _, person := range []string{PersonBf, PersonGf} {
+		prefs, err := s.GetPreferences(ctx, person)
+		if err != nil {
+			return nil, err
+		}
+		if prefs.RemindersSnoozed(now) {
+			continue
+		}
+		shows, err := s.ListUnratedWatched(ctx, person, now.Add(-after))
+		if err != nil {
+			return nil, err
+		}
+		if len(shows) > 0 {
+			out = append(out, RatingReminder{Person: person, Shows: shows})
+		}
+	}
+	return out, nil
+}
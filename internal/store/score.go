@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// ScoreWeights weighs each person's rating in the stored couple score. The
+// score is the weighted mean of whichever ratings are present.
+type ScoreWeights struct {
+	Bf float64
+	Gf float64
+}
+
+// DefaultScoreWeights is a plain average.
+var DefaultScoreWeights = ScoreWeights{Bf: 1, Gf: 1}
+
+func (w ScoreWeights) Valid() bool {
+	return w.Bf >= 0 && w.Gf >= 0 && w.Bf+w.Gf > 0 &&
+		!math.IsInf(w.Bf, 0) && !math.IsInf(w.Gf, 0)
+}
+
+// SetScoreWeights changes the couple score weighting and recomputes every
+// stored score.
+func (s *Store) SetScoreWeights(ctx context.Context, weights ScoreWeights) error {
+	if !weights.Valid() {
+		return errors.New("invalid score weights")
+	}
+	s.weights = weights
+	return s.refreshCoupleScores(ctx, 0)
+}
+
+// refreshCoupleScores recomputes couple_score for one show, or all shows when
+// id is 0.
+func (s *Store) refreshCoupleScores(ctx context.Context, id int64) error {
+	w := s.weights
+	q := s.db.NewUpdate().
+		Table("shows").
+		Set(`couple_score = CASE
+	WHEN bf_rating IS NULL AND gf_rating IS NULL THEN NULL
+	ELSE (COALESCE(bf_rating * ?, 0) + COALESCE(gf_rating * ?, 0)) /
+		NULLIF((bf_rating IS NOT NULL) * ? + (gf_rating IS NOT NULL) * ?, 0)
+END`, w.Bf, w.Gf, w.Bf, w.Gf)
+	if id != 0 {
+		q = q.Where("id = ?", id)
+	} else {
+		q = q.Where("1 = 1")
+	}
+	_, err := q.Exec(ctx)
+	return err
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+type SearchHistoryEntry struct {
+	bun.BaseModel `bun:"table:search_history,alias:sh"`
+
+	ID         int64  `bun:"id,pk,autoincrement"`
+	Person     string `bun:"person,notnull"`
+	Query      string `bun:"query,notnull"`
+	Count      int64  `bun:"count,notnull"`
+	SearchedAt string `bun:"searched_at,notnull"`
+}
+
+// RecordSearch remembers a search query for a person ("" for sessions without
+// one), bumping its count and recency when it was searched before.
+func (s *Store) RecordSearch(ctx context.Context, person, query string) error {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil
+	}
+
+	entry := SearchHistoryEntry{
+		Person:     person,
+		Query:      query,
+		Count:      1,
+		SearchedAt: nowUTC(),
+	}
+	_, err := s.db.NewInsert().
+		Model(&entry).
+		Column("person", "query", "count", "searched_at").
+		On("CONFLICT (person, query) DO UPDATE").
+		Set("query = EXCLUDED.query").
+		Set("count = sh.count + 1").
+		Set("searched_at = EXCLUDED.searched_at").
+		Exec(ctx)
+	return err
+}
+
+// ListSearchHistory returns a person's most recent searches, optionally only
+// those starting with prefix.
+func (s *Store) ListSearchHistory(ctx context.Context, person, prefix string, limit int) ([]SearchHistoryEntry, error) {
+	out := []SearchHistoryEntry{}
+	q := s.db.NewSelect().
+		Model(&out).
+		Where("person = ?", person).
+		OrderExpr("searched_at DESC").
+		OrderExpr("count DESC").
+		Limit(limit)
+	if prefix = strings.TrimSpace(prefix); prefix != "" {
+		q = q.Where("query LIKE ? ESCAPE '\\'", escapeLike(prefix)+"%")
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *Store) ClearSearchHistory(ctx context.Context, person string) error {
+	_, err := s.db.NewDelete().
+		Model((*SearchHistoryEntry)(nil)).
+		Where("person = ?", person).
+		Exec(ctx)
+	return err
+}
+
+func escapeLike(val string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(val)
+}
@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Session is one signed-in device. The cookie holds a random token; only its
+// SHA-256 is stored.
+type Session struct {
+	bun.BaseModel `bun:"table:sessions,alias:ses"`
+
+	ID        int64  `bun:"id,pk,autoincrement"`
+	TokenHash string `bun:"token_hash,notnull"`
+	// Name is the user's label for the device ("Kitchen tablet").
+	Name      sql.Null[string] `bun:"name,nullzero"`
+	UserAgent string           `bun:"user_agent,notnull"`
+	IP        string           `bun:"ip,notnull"`
+	// Person is set for sessions signed in to a personal account; their
+	// ratings are attributed to that person.
+	Person     sql.Null[string] `bun:"person,nullzero"`
+	CreatedAt  string           `bun:"created_at,notnull"`
+	LastUsedAt string           `bun:"last_used_at,notnull"`
+	ExpiresAt  string           `bun:"expires_at,notnull"`
+}
+
+// sessionTouchInterval limits how often last_used_at is written.
+const sessionTouchInterval = 5 * time.Minute
+
+// CreateSession stores a new session that expires after ttl and sets its ID.
+func (s *Store) CreateSession(ctx context.Context, session *Session, ttl time.Duration) error {
+	now := time.Now().UTC()
+	session.CreatedAt = now.Format(time.RFC3339)
+	session.LastUsedAt = session.CreatedAt
+	session.ExpiresAt = now.Add(ttl).Format(time.RFC3339)
+	_, err := s.db.NewInsert().Model(session).Returning("id").Exec(ctx)
+	return err
+}
+
+// GetSessionByToken returns the unexpired session with the token hash, or
+// sql.ErrNoRows.
+func (s *Store) GetSessionByToken(ctx context.Context, tokenHash string) (Session, error) {
+	var out Session
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("token_hash = ?", tokenHash).
+		Where("expires_at > ?", nowUTC()).
+		Limit(1).
+		Scan(ctx)
+	return out, err
+}
+
+// KnownDevice reports whether any stored session, including expired ones not
+// yet cleaned up, signed in with the user agent from the IP.
+func (s *Store) KnownDevice(ctx context.Context, ip, userAgent string) (bool, error) {
+	return s.db.NewSelect().
+		Model((*Session)(nil)).
+		Where("ip = ?", ip).
+		Where("user_agent = ?", userAgent).
+		Exists(ctx)
+}
+
+// TouchSession records a request from the session. Writes are skipped while
+// last_used_at is recent.
+func (s *Store) TouchSession(ctx context.Context, id int64, ip string) error {
+	now := time.Now().UTC()
+	_, err := s.db.NewUpdate().
+		Model((*Session)(nil)).
+		Set("last_used_at = ?", now.Format(time.RFC3339)).
+		Set("ip = ?", ip).
+		Where("id = ?", id).
+		Where("last_used_at < ?", now.Add(-sessionTouchInterval).Format(time.RFC3339)).
+		Exec(ctx)
+	return err
+}
+
+// ListSessions returns unexpired sessions, most recently used first.
+func (s *Store) ListSessions(ctx context.Context) ([]Session, error) {
+	out := []Session{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("expires_at > ?", nowUTC()).
+		OrderExpr("last_used_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RenameSession sets the device name; an empty name clears it.
+func (s *Store) RenameSession(ctx context.Context, id int64, name string) error {
+	res, err := s.db.NewUpdate().
+		Model((*Session)(nil)).
+		Set("name = ?", sql.Null[string]{V: name, Valid: name != ""}).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// GetSession returns a session by ID, or sql.ErrNoRows.
+func (s *Store) GetSession(ctx context.Context, id int64) (Session, error) {
+	var out Session
+	err := s.db.NewSelect().Model(&out).Where("id = ?", id).Limit(1).Scan(ctx)
+	return out, err
+}
+
+// DeleteSession signs the session out.
+func (s *Store) DeleteSession(ctx context.Context, id int64) error {
+	res, err := s.db.NewDelete().
+		Model((*Session)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// DeleteExpiredSessions removes sessions past their expiry.
+func (s *Store) DeleteExpiredSessions(ctx context.Context) error {
+	_, err := s.db.NewDelete().
+		Model((*Session)(nil)).
+		Where("expires_at <= ?", nowUTC()).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,172 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/uptrace/bun"
+)
+
+// setting is a household-wide setting stored as JSON under a key.
+type setting struct {
+	bun.BaseModel `bun:"table:settings,alias:st"`
+
+	Key       string `bun:"key,pk"`
+	Value     string `bun:"value,notnull"`
+	UpdatedAt string `bun:"updated_at,notnull"`
+}
+
+const (
+	settingSubscriptions = "subscriptions"
+	settingPassword      = "password"
+	settingRatingRule    = "watched_ratings"
+)
+
+// Subscriptions are the streaming services the household pays for, as TMDB
+// watch providers in Region (ISO 3166-1).
+type Subscriptions struct {
+	Region    string         `json:"region"`
+	Providers []Subscription `json:"providers"`
+}
+
+type Subscription struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func (s Subscriptions) ProviderIDs() []int {
+	ids := make([]int, 0, len(s.Providers))
+	for _, p := range s.Providers {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+// Configured reports whether there is anything to filter by.
+func (s Subscriptions) Configured() bool {
+	return s.Region != "" && len(s.Providers) > 0
+}
+
+// getSetting decodes the setting into dst. It reports false when the setting
+// was never saved.
+func getSetting(ctx context.Context, db bun.IDB, key string, dst any) (bool, error) {
+	var row setting
+	err := db.NewSelect().Model(&row).Where("key = ?", key).Limit(1).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal([]byte(row.Value), dst)
+}
+
+func setSetting(ctx context.Context, db bun.IDB, key string, value any) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	row := setting{Key: key, Value: string(raw), UpdatedAt: nowUTC()}
+	_, err = db.NewInsert().
+		Model(&row).
+		On("CONFLICT (key) DO UPDATE").
+		Set("value = EXCLUDED.value").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+func (s *Store) GetSubscriptions(ctx context.Context) (Subscriptions, error) {
+	var subs Subscriptions
+	if _, err := getSetting(ctx, s.db, settingSubscriptions, &subs); err != nil {
+		return Subscriptions{}, err
+	}
+	return subs, nil
+}
+
+// SetSubscriptions saves the subscriptions. Changing the region drops the
+// stored streaming availability, which only holds for the old region.
+func (s *Store) SetSubscriptions(ctx context.Context, subs Subscriptions) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var old Subscriptions
+		if _, err := getSetting(ctx, tx, settingSubscriptions, &old); err != nil {
+			return err
+		}
+		if old.Region != subs.Region {
+			if _, err := tx.NewUpdate().
+				Table("shows").
+				Set("streaming_on = NULL").
+				Where("streaming_on IS NOT NULL").
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return setSetting(ctx, tx, settingSubscriptions, subs)
+	})
+}
+
+// StoredPassword is a password changed from the app, which replaces
+// APP_PASSWORD. EnvHash is the hash of APP_PASSWORD when it was saved, so
+// changing the environment variable later takes over again.
+type StoredPassword struct {
+	Salt    string `json:"salt"`
+	Hash    string `json:"hash"`
+	EnvHash string `json:"env_hash"`
+}
+
+// GetPassword returns the stored password. It reports false when the
+// password was never changed from the app.
+func (s *Store) GetPassword(ctx context.Context) (StoredPassword, bool, error) {
+	var pw StoredPassword
+	ok, err := getSetting(ctx, s.db, settingPassword, &pw)
+	if err != nil {
+		return StoredPassword{}, false, err
+	}
+	return pw, ok, nil
+}
+
+// SavePassword replaces the stored password without signing anyone out.
+func (s *Store) SavePassword(ctx context.Context, pw StoredPassword) error {
+	return setSetting(ctx, s.db, settingPassword, pw)
+}
+
+// ChangePassword saves the password and signs out every session except
+// keepSessionID.
+func (s *Store) ChangePassword(ctx context.Context, pw StoredPassword, keepSessionID int64) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := setSetting(ctx, tx, settingPassword, pw); err != nil {
+			return err
+		}
+		_, err := tx.NewDelete().
+			Model((*Session)(nil)).
+			Where("id != ?", keepSessionID).
+			Exec(ctx)
+		return err
+	})
+}
+
+// What happens when a show is marked watched while a rating is missing.
+const (
+	RatingRuleOff   = "off"
+	RatingRuleWarn  = "warn"
+	RatingRuleBlock = "block"
+)
+
+func ValidRatingRule(rule string) bool {
+	return rule == RatingRuleOff || rule == RatingRuleWarn || rule == RatingRuleBlock
+}
+
+// GetRatingRule returns the watched-ratings rule, RatingRuleOff when unset.
+func (s *Store) GetRatingRule(ctx context.Context) (string, error) {
+	rule := RatingRuleOff
+	if _, err := getSetting(ctx, s.db, settingRatingRule, &rule); err != nil {
+		return "", err
+	}
+	return rule, nil
+}
+
+func (s *Store) SetRatingRule(ctx context.Context, rule string) error {
+	return setSetting(ctx, s.db, settingRatingRule, rule)
+}
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// ShowCountry is one origin country of a show. shows.origin_country keeps the
+// comma-joined display value; filtering goes through this table.
+type ShowCountry struct {
+	bun.BaseModel `bun:"table:show_countries,alias:sc"`
+
+	ShowID int64  `bun:"show_id,pk"`
+	Code   string `bun:"code,pk"`
+}
+
+// ShowGenre is one genre of a show, normalized the same way as ShowCountry.
+type ShowGenre struct {
+	bun.BaseModel `bun:"table:show_genres,alias:sg"`
+
+	ShowID int64  `bun:"show_id,pk"`
+	Name   string `bun:"name,pk"`
+}
+
+// Show language kinds.
+const (
+	LanguageAudio       = "audio"
+	LanguageTranslation = "translation"
+)
+
+// ShowLanguage is one language a show is available in, split by kind like
+// ShowCountry.
+type ShowLanguage struct {
+	bun.BaseModel `bun:"table:show_languages,alias:sl"`
+
+	ShowID int64  `bun:"show_id,pk"`
+	Code   string `bun:"code,pk"`
+	Kind   string `bun:"kind,pk"`
+}
+
+func replaceShowTags(ctx context.Context, db bun.IDB, showID int64, sh *Show) error {
+	if _, err := db.NewDelete().
+		Model((*ShowCountry)(nil)).
+		Where("show_id = ?", showID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.NewDelete().
+		Model((*ShowGenre)(nil)).
+		Where("show_id = ?", showID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if codes := splitCountryCodes(sh.OriginCountry); len(codes) > 0 {
+		rows := make([]ShowCountry, 0, len(codes))
+		for _, code := range codes {
+			rows = append(rows, ShowCountry{ShowID: showID, Code: code})
+		}
+		if _, err := db.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if names := splitGenres(sh.Genres); len(names) > 0 {
+		rows := make([]ShowGenre, 0, len(names))
+		for _, name := range names {
+			rows = append(rows, ShowGenre{ShowID: showID, Name: name})
+		}
+		if _, err := db.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if err := replaceShowLanguages(ctx, db, showID, sh); err != nil {
+		return err
+	}
+	if err := replaceShowCompanies(ctx, db, showID, sh.CompanyRefs); err != nil {
+		return err
+	}
+	return replaceShowNetworks(ctx, db, showID, sh.NetworkRefs)
+}
+
+func replaceShowLanguages(ctx context.Context, db bun.IDB, showID int64, sh *Show) error {
+	if _, err := db.NewDelete().
+		Model((*ShowLanguage)(nil)).
+		Where("show_id = ?", showID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	var rows []ShowLanguage
+	for _, code := range splitLanguageCodes(sh.AudioLanguages) {
+		rows = append(rows, ShowLanguage{ShowID: showID, Code: code, Kind: LanguageAudio})
+	}
+	for _, code := range splitLanguageCodes(sh.Translations) {
+		rows = append(rows, ShowLanguage{ShowID: showID, Code: code, Kind: LanguageTranslation})
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	_, err := db.NewInsert().Model(&rows).On("CONFLICT DO NOTHING").Exec(ctx)
+	return err
+}
+
+// backfillShowTagsTx fills show_countries and show_genres for rows written
+// before the tables existed.
+func backfillShowTagsTx(ctx context.Context, tx *sql.Tx) error {
+	if err := backfillTagTableTx(ctx, tx, "origin_country", "show_countries", "code", splitCountryCodes); err != nil {
+		return err
+	}
+	return backfillTagTableTx(ctx, tx, "genres", "show_genres", "name", splitGenres)
+}
+
+func backfillTagTableTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	sourceColumn, table, column string,
+	split func(sql.Null[string]) []string,
+) error {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+SELECT id, %[1]s FROM shows
+WHERE %[1]s IS NOT NULL AND %[1]s != ''
+	AND NOT EXISTS (SELECT 1 FROM %[2]s WHERE show_id = shows.id)`, sourceColumn, table))
+	if err != nil {
+		return err
+	}
+
+	type pending struct {
+		id     int64
+		values []string
+	}
+	var todo []pending
+	for rows.Next() {
+		var id int64
+		var raw sql.Null[string]
+		if err := rows.Scan(&id, &raw); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		todo = append(todo, pending{id: id, values: split(raw)})
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf("INSERT OR IGNORE INTO %s (show_id, %s) VALUES (?, ?)", table, column)
+	for _, p := range todo {
+		for _, val := range p.values {
+			if _, err := tx.ExecContext(ctx, stmt, p.id, val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func splitCountryCodes(v sql.Null[string]) []string {
+	if !v.Valid {
+		return nil
+	}
+	var out []string
+	for _, code := range strings.Split(v.V, ",") {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code == "" {
+			continue
+		}
+		out = append(out, code)
+	}
+	return out
+}
+
+func splitLanguageCodes(v sql.Null[string]) []string {
+	if !v.Valid {
+		return nil
+	}
+	var out []string
+	for _, code := range strings.Split(v.V, ",") {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if code == "" {
+			continue
+		}
+		out = append(out, code)
+	}
+	return out
+}
+
+func splitGenres(v sql.Null[string]) []string {
+	if !v.Valid {
+		return nil
+	}
+	var out []string
+	for _, name := range strings.Split(v.V, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		out = append(out, name)
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
+)
+
+const storeFuncPrefix = "github.com/handsomefox/website-rating/internal/store."
+
+// slowQueryHook logs statements that take longer than threshold, named after
+// the Store method that ran them.
+type slowQueryHook struct {
+	threshold time.Duration
+	count     atomic.Int64
+}
+
+var _ bun.QueryHook = (*slowQueryHook)(nil)
+
+func (h *slowQueryHook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	return ctx
+}
+
+func (h *slowQueryHook) AfterQuery(_ context.Context, event *bun.QueryEvent) {
+	elapsed := time.Since(event.StartTime)
+	if elapsed < h.threshold {
+		return
+	}
+	total := h.count.Add(1)
+
+	slog.Warn("slow query",
+		slog.String("name", queryName()),
+		slog.String("op", event.Operation()),
+		slog.Int("args", queryArgCount(event)),
+		slog.Duration("elapsed", elapsed),
+		slog.Int64("slow_total", total),
+	)
+}
+
+// LogSlowQueries logs every statement slower than threshold. Call it once,
+// right after Open.
+func (s *Store) LogSlowQueries(threshold time.Duration) {
+	s.slow = &slowQueryHook{threshold: threshold}
+	s.db.AddQueryHook(s.slow)
+}
+
+// SlowQueries returns how many slow statements were logged since startup.
+func (s *Store) SlowQueries() int64 {
+	if s.slow == nil {
+		return 0
+	}
+	return s.slow.count.Load()
+}
+
+// queryName returns the outermost Store method on the stack, e.g. "ListShows".
+func queryName() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	name := "unknown"
+	for {
+		frame, more := frames.Next()
+		if fn, ok := strings.CutPrefix(frame.Function, storeFuncPrefix); ok {
+			name = fn[strings.LastIndex(fn, ".")+1:]
+		}
+		if !more {
+			break
+		}
+	}
+	return name
+}
+
+// queryArgCount counts bound arguments. Query builders inline them, so they
+// are counted as placeholders in the unformatted query.
+func queryArgCount(event *bun.QueryEvent) int {
+	if len(event.QueryArgs) > 0 || event.IQuery == nil {
+		return len(event.QueryArgs)
+	}
+	raw, err := event.IQuery.AppendQuery(schema.NewNopQueryGen(), nil)
+	if err != nil {
+		return 0
+	}
+	return strings.Count(string(raw), "?")
+}
@@ -0,0 +1,176 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// TasteBucket is one person's ratings aggregated over a group of shows.
+type TasteBucket struct {
+	Key     string  `bun:"key"`
+	Count   int64   `bun:"count"`
+	Average float64 `bun:"average"`
+}
+
+type TasteProfile struct {
+	Person    string
+	Rated     int64
+	Average   float64
+	Genres    []TasteBucket
+	Decades   []TasteBucket
+	Countries []TasteBucket
+	Runtimes  []TasteBucket
+}
+
+// runtimeBucketExpr groups runtimes (minutes) into coarse ranges.
+const runtimeBucketExpr = `CASE
+	WHEN s.runtime IS NULL THEN NULL
+	WHEN s.runtime < 30 THEN '<30'
+	WHEN s.runtime < 60 THEN '30-59'
+	WHEN s.runtime < 90 THEN '60-89'
+	WHEN s.runtime < 120 THEN '90-119'
+	ELSE '120+'
+END`
+
+// TasteProfile aggregates a person's ratings by genre, decade, origin country
+// and runtime bucket. Unrated shows are ignored.
+func (s *Store) TasteProfile(ctx context.Context, person string) (TasteProfile, error) {
+	if !ValidPerson(person) {
+		return TasteProfile{}, errors.New("invalid person")
+	}
+	// Safe to splice: person is one of the fixed column prefixes.
+	col := "s." + person + "_rating"
+
+	profile := TasteProfile{Person: person}
+
+	var overall struct {
+		Count   int64             `bun:"count"`
+		Average sql.Null[float64] `bun:"average"`
+	}
+	if err := s.db.NewSelect().
+		TableExpr("shows AS s").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("AVG("+col+") AS average").
+		Where(col+" IS NOT NULL").
+		Scan(ctx, &overall); err != nil {
+		return TasteProfile{}, err
+	}
+	profile.Rated = overall.Count
+	profile.Average = overall.Average.V
+
+	var err error
+	if profile.Genres, err = s.tasteBuckets(ctx, col, "sg.name", "JOIN show_genres AS sg ON sg.show_id = s.id"); err != nil {
+		return TasteProfile{}, err
+	}
+	if profile.Decades, err = s.tasteBuckets(ctx, col, "(s.year / 10 * 10) || 's'", ""); err != nil {
+		return TasteProfile{}, err
+	}
+	if profile.Countries, err = s.tasteBuckets(ctx, col, "sc.code", "JOIN show_countries AS sc ON sc.show_id = s.id"); err != nil {
+		return TasteProfile{}, err
+	}
+	if profile.Runtimes, err = s.tasteBuckets(ctx, col, runtimeBucketExpr, ""); err != nil {
+		return TasteProfile{}, err
+	}
+	return profile, nil
+}
+
+func (s *Store) tasteBuckets(ctx context.Context, ratingCol, keyExpr, join string) ([]TasteBucket, error) {
+	out := []TasteBucket{}
+	q := s.db.NewSelect().
+		TableExpr("shows AS s").
+		ColumnExpr(keyExpr + " AS key").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("AVG(" + ratingCol + ") AS average").
+		Where(ratingCol + " IS NOT NULL").
+		Where(keyExpr + " IS NOT NULL").
+		GroupExpr("key").
+		OrderExpr("average DESC").
+		OrderExpr("count DESC")
+	if join != "" {
+		q = q.Join(join)
+	}
+	if err := q.Scan(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BacklogTotal is the runtime left in the planned queue for one media type.
+type BacklogTotal struct {
+	MediaType string `bun:"media_type"`
+	Count     int64  `bun:"count"`
+	Minutes   int64  `bun:"minutes"`
+	Unknown   int64  `bun:"unknown"`
+}
+
+// backlogMinutesExpr is a planned title's remaining runtime: the movie
+// length, or every episode for TV. NULL when TMDB has not given us enough.
+const backlogMinutesExpr = `CASE
+	WHEN s.media_type = 'tv' THEN s.runtime * s.episode_count
+	ELSE s.runtime
+END`
+
+// Backlog sums the remaining runtime of planned shows per media type.
+func (s *Store) Backlog(ctx context.Context) ([]BacklogTotal, error) {
+	out := []BacklogTotal{}
+	err := s.db.NewSelect().
+		TableExpr("shows AS s").
+		ColumnExpr("s.media_type AS media_type").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("COALESCE(SUM("+backlogMinutesExpr+"), 0) AS minutes").
+		ColumnExpr("SUM(("+backlogMinutesExpr+") IS NULL) AS unknown").
+		Where("s.status = ?", StatusPlanned).
+		GroupExpr("s.media_type").
+		OrderExpr("s.media_type ASC").
+		Scan(ctx, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SharedRatings summarizes the shows both people rated.
+type SharedRatings struct {
+	Count int64
+	// AvgDelta is the mean |bf - gf|.
+	AvgDelta float64
+	// Agreed counts shows rated within one point of each other.
+	Agreed int64
+}
+
+func (s *Store) SharedRatings(ctx context.Context) (SharedRatings, error) {
+	var row struct {
+		Count    int64             `bun:"count"`
+		AvgDelta sql.Null[float64] `bun:"avg_delta"`
+		Agreed   sql.Null[int64]   `bun:"agreed"`
+	}
+	err := s.db.NewSelect().
+		Table("shows").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("AVG(ABS(bf_rating - gf_rating)) AS avg_delta").
+		ColumnExpr("SUM(ABS(bf_rating - gf_rating) <= 1) AS agreed").
+		Where("bf_rating IS NOT NULL AND gf_rating IS NOT NULL").
+		Scan(ctx, &row)
+	if err != nil {
+		return SharedRatings{}, err
+	}
+	return SharedRatings{Count: row.Count, AvgDelta: row.AvgDelta.V, Agreed: row.Agreed.V}, nil
+}
+
+// ListTopJoint returns the shows both people rated at least minRating, best
+// couple score first.
+func (s *Store) ListTopJoint(ctx context.Context, minRating int64, limit int) ([]Show, error) {
+	out := []Show{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("bf_rating >= ? AND gf_rating >= ?", minRating, minRating).
+		OrderExpr("couple_score DESC").
+		OrderExpr("updated_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
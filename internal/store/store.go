@@ -8,9 +8,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uptrace/bun"
@@ -20,8 +21,14 @@ import (
 )
 
 type Store struct {
-	sqldb *sql.DB
-	db    *bun.DB
+	sqldb     *sql.DB
+	db        *bun.DB
+	weights   ScoreWeights
+	slow      *slowQueryHook
+	refs      *libraryRefs
+	integrity atomic.Pointer[IntegrityReport]
+	// freezeAfter locks ratings this long after watching; zero never does.
+	freezeAfter time.Duration
 }
 
 // Cache used only for schema checks on startup.
@@ -40,18 +47,73 @@ type Show struct {
 	Overview      sql.Null[string]  `bun:"overview,nullzero"`
 	PosterPath    sql.Null[string]  `bun:"poster_path,nullzero"`
 	IMDbID        sql.Null[string]  `bun:"imdb_id,nullzero"`
+	TVDBID        sql.Null[int64]   `bun:"tvdb_id,nullzero"`
+	WikidataID    sql.Null[string]  `bun:"wikidata_id,nullzero"`
 	TMDBRating    sql.Null[float64] `bun:"tmdb_rating,nullzero"`
 	TMDBVotes     sql.Null[int64]   `bun:"tmdb_votes,nullzero"`
+	RTScore       sql.Null[int64]   `bun:"rt_score,nullzero"`
+	Metascore     sql.Null[int64]   `bun:"metascore,nullzero"`
 	OriginCountry sql.Null[string]  `bun:"origin_country,nullzero"`
+	Companies     sql.Null[string]  `bun:"companies,nullzero"`
+	Networks      sql.Null[string]  `bun:"networks,nullzero"`
+	Runtime       sql.Null[int64]   `bun:"runtime,nullzero"`
+	EpisodeCount  sql.Null[int64]   `bun:"episode_count,nullzero"`
+	ReleaseDate   sql.Null[string]  `bun:"release_date,nullzero"`
+	NextAirDate   sql.Null[string]  `bun:"next_air_date,nullzero"`
+	NextSeason    sql.Null[int64]   `bun:"next_episode_season,nullzero"`
+	NextEpisode   sql.Null[int64]   `bun:"next_episode_number,nullzero"`
 	Status        string            `bun:"status,notnull"`
+	Pinned        bool              `bun:"pinned,notnull"`
+	// WatchedAt is set when the show becomes watched and cleared when it
+	// goes back to planned.
+	WatchedAt sql.Null[string] `bun:"watched_at,nullzero"`
+	// PickedBy is the person who chose the show, recorded once it is
+	// watched ("bf" or "gf").
+	PickedBy sql.Null[string] `bun:"picked_by,nullzero"`
+	// VetoedBy is the person who vetoed the planned show, which hides it
+	// from the pickers.
+	VetoedBy sql.Null[string] `bun:"vetoed_by,nullzero"`
+	VetoedAt sql.Null[string] `bun:"vetoed_at,nullzero"`
+	// TMDBRefreshedAt is when UpsertShow last stored TMDB details.
+	TMDBRefreshedAt sql.Null[string] `bun:"tmdb_refreshed_at,nullzero"`
+	// AvailableOn lists the media servers (comma-separated) that have the
+	// show, as of the last sync.
+	AvailableOn sql.Null[string] `bun:"available_on,nullzero"`
+	// RequestStatus tracks a download request sent to Radarr/Sonarr or
+	// Overseerr.
+	RequestStatus sql.Null[string] `bun:"request_status,nullzero"`
+	RequestedAt   sql.Null[string] `bun:"requested_at,nullzero"`
+	// AudioLanguages and Translations are comma-separated ISO 639-1 codes
+	// from TMDB; filtering goes through show_languages.
+	AudioLanguages sql.Null[string] `bun:"audio_languages,nullzero"`
+	Translations   sql.Null[string] `bun:"translations,nullzero"`
 
 	BfRating  sql.Null[int64]  `bun:"bf_rating,nullzero"`
 	GfRating  sql.Null[int64]  `bun:"gf_rating,nullzero"`
 	BfComment sql.Null[string] `bun:"bf_comment,nullzero"`
 	GfComment sql.Null[string] `bun:"gf_comment,nullzero"`
+	// CoupleScore is the weighted mean of the present ratings, kept in sync
+	// on every rating write.
+	CoupleScore sql.Null[float64] `bun:"couple_score,nullzero"`
 
 	CreatedAt string `bun:"created_at,notnull"`
 	UpdatedAt string `bun:"updated_at,notnull"`
+
+	// CompanyRefs are the production companies behind Companies; UpsertShow
+	// stores them in show_companies.
+	CompanyRefs []Company `bun:"-"`
+	// NetworkRefs are the TV networks behind Networks.
+	NetworkRefs []Network `bun:"-"`
+}
+
+// Show statuses.
+const (
+	StatusPlanned = "planned"
+	StatusWatched = "watched"
+)
+
+func ValidStatus(status string) bool {
+	return status == StatusPlanned || status == StatusWatched
 }
 
 type ListFilters struct {
@@ -59,9 +121,34 @@ type ListFilters struct {
 	YearFrom *int
 	YearTo   *int
 	Genre    string
-	Country  string
-	Unrated  bool
-	Sort     string
+	// ExcludeGenres drops shows with any of the genre names.
+	ExcludeGenres []string
+	// Countries matches shows from any of the ISO 3166-1 codes.
+	Countries  []string
+	Unrated    bool
+	MaxRuntime *int
+	// AiringFrom/AiringTo (YYYY-MM-DD, inclusive) keep TV shows whose next
+	// episode airs in the range.
+	AiringFrom string
+	AiringTo   string
+	// AvailableOn keeps shows on the named media server, or on any server
+	// when set to "any".
+	AvailableOn string
+	// StreamingOn keeps shows streaming on any of the TMDB provider IDs.
+	StreamingOn []int
+	// CompanyID keeps shows from one production company.
+	CompanyID int64
+	// NetworkID keeps shows from one TV network.
+	NetworkID int64
+	// MinRTScore and MinMetascore (0-100) drop shows below, or without, the
+	// OMDb score.
+	MinRTScore   *int
+	MinMetascore *int
+	// Language keeps shows with audio or a translation in the ISO 639-1
+	// language; AudioLanguage only accepts audio.
+	Language      string
+	AudioLanguage string
+	Sort          string
 }
 
 type TMDBRef struct {
@@ -70,9 +157,11 @@ type TMDBRef struct {
 }
 
 type TMDBRefresh struct {
-	TMDBID    int64  `bun:"tmdb_id"`
-	MediaType string `bun:"media_type"`
-	Status    string `bun:"status"`
+	ID        int64            `bun:"id"`
+	TMDBID    int64            `bun:"tmdb_id"`
+	MediaType string           `bun:"media_type"`
+	Status    string           `bun:"status"`
+	IMDbID    sql.Null[string] `bun:"imdb_id"`
 }
 
 func Open(dbPath string) (*Store, error) {
@@ -118,7 +207,9 @@ func Open(dbPath string) (*Store, error) {
 	}
 
 	bdb := bun.NewDB(sqldb, sqlitedialect.New())
-	return &Store{sqldb: sqldb, db: bdb}, nil
+	refs := &libraryRefs{}
+	bdb.AddQueryHook(refs)
+	return &Store{sqldb: sqldb, db: bdb, weights: DefaultScoreWeights, refs: refs}, nil
 }
 
 func (s *Store) Close() error {
@@ -132,6 +223,7 @@ func applyPragmas(ctx context.Context, db *sql.DB) error {
 	stmts := []string{
 		"PRAGMA journal_mode = WAL;",
 		"PRAGMA busy_timeout = 5000;",
+		"PRAGMA foreign_keys = ON;",
 	}
 
 	for _, stmt := range stmts {
@@ -160,20 +252,280 @@ CREATE TABLE IF NOT EXISTS shows (
 	overview TEXT,
 	poster_path TEXT,
 	imdb_id TEXT,
+	tvdb_id INTEGER,
+	wikidata_id TEXT,
 	tmdb_rating REAL,
 	tmdb_votes INTEGER,
+	rt_score INTEGER,
+	metascore INTEGER,
 	origin_country TEXT,
+	companies TEXT,
+	networks TEXT,
+	runtime INTEGER,
+	episode_count INTEGER,
+	audio_languages TEXT,
+	translations TEXT,
+	release_date TEXT,
+	next_air_date TEXT,
+	next_episode_season INTEGER,
+	next_episode_number INTEGER,
 	status TEXT NOT NULL,
+	pinned INTEGER NOT NULL DEFAULT 0,
+	watched_at TEXT,
+	available_on TEXT,
+	streaming_on TEXT,
+	request_status TEXT,
+	requested_at TEXT,
 	bf_rating INTEGER,
 	gf_rating INTEGER,
 	bf_comment TEXT,
 	gf_comment TEXT,
+	couple_score REAL,
+	ratings_unfrozen_at TEXT,
+	first_watched_at TEXT,
+	picked_by TEXT,
+	vetoed_by TEXT,
+	vetoed_at TEXT,
+	tmdb_refreshed_at TEXT,
 	created_at TEXT NOT NULL,
 	updated_at TEXT NOT NULL,
 	UNIQUE(tmdb_id, media_type)
 );
 CREATE INDEX IF NOT EXISTS idx_shows_status ON shows(status);
 CREATE INDEX IF NOT EXISTS idx_shows_year ON shows(year);
+CREATE TABLE IF NOT EXISTS show_countries (
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	code TEXT NOT NULL,
+	PRIMARY KEY (show_id, code)
+);
+CREATE INDEX IF NOT EXISTS idx_show_countries_code ON show_countries(code);
+CREATE TABLE IF NOT EXISTS show_genres (
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	name TEXT NOT NULL COLLATE NOCASE,
+	PRIMARY KEY (show_id, name)
+);
+CREATE INDEX IF NOT EXISTS idx_show_genres_name ON show_genres(name);
+CREATE TABLE IF NOT EXISTS show_languages (
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	code TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	PRIMARY KEY (show_id, code, kind)
+);
+CREATE INDEX IF NOT EXISTS idx_show_languages_code ON show_languages(code);
+CREATE TABLE IF NOT EXISTS companies (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS show_companies (
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	company_id INTEGER NOT NULL REFERENCES companies(id),
+	PRIMARY KEY (show_id, company_id)
+);
+CREATE INDEX IF NOT EXISTS idx_show_companies_company ON show_companies(company_id);
+CREATE TABLE IF NOT EXISTS networks (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS show_networks (
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	network_id INTEGER NOT NULL REFERENCES networks(id),
+	PRIMARY KEY (show_id, network_id)
+);
+CREATE INDEX IF NOT EXISTS idx_show_networks_network ON show_networks(network_id);
+CREATE TABLE IF NOT EXISTS external_ratings (
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	source TEXT NOT NULL,
+	provider TEXT NOT NULL,
+	value REAL NOT NULL,
+	max REAL NOT NULL,
+	votes INTEGER NOT NULL DEFAULT 0,
+	fetched_at TEXT NOT NULL,
+	PRIMARY KEY (show_id, source)
+);
+CREATE TABLE IF NOT EXISTS wikipedia_summaries (
+	show_id INTEGER PRIMARY KEY REFERENCES shows(id) ON DELETE CASCADE,
+	extract TEXT NOT NULL,
+	url TEXT NOT NULL,
+	fetched_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS sessions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	token_hash TEXT NOT NULL UNIQUE,
+	name TEXT,
+	user_agent TEXT NOT NULL,
+	ip TEXT NOT NULL,
+	person TEXT,
+	created_at TEXT NOT NULL,
+	last_used_at TEXT NOT NULL,
+	expires_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS users (
+	person TEXT PRIMARY KEY,
+	salt TEXT NOT NULL,
+	hash TEXT NOT NULL,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS pending_imports (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	source TEXT NOT NULL,
+	line INTEGER NOT NULL,
+	title TEXT NOT NULL,
+	year TEXT NOT NULL,
+	media_type TEXT NOT NULL,
+	status TEXT NOT NULL,
+	bf_rating INTEGER,
+	gf_rating INTEGER,
+	bf_comment TEXT,
+	gf_comment TEXT,
+	candidates TEXT NOT NULL,
+	created_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS login_failures (
+	ip TEXT NOT NULL,
+	bucket TEXT NOT NULL,
+	count INTEGER NOT NULL,
+	last_at TEXT NOT NULL,
+	PRIMARY KEY (ip, bucket)
+);
+CREATE TABLE IF NOT EXISTS tmdb_genres (
+	language TEXT NOT NULL,
+	media_type TEXT NOT NULL,
+	id INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	fetched_at TEXT NOT NULL,
+	PRIMARY KEY (language, media_type, id)
+);
+CREATE TABLE IF NOT EXISTS tmdb_countries (
+	language TEXT NOT NULL,
+	code TEXT NOT NULL,
+	name TEXT NOT NULL,
+	fetched_at TEXT NOT NULL,
+	PRIMARY KEY (language, code)
+);
+CREATE TABLE IF NOT EXISTS tmdb_languages (
+	code TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	fetched_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS tmdb_cache (
+	key TEXT PRIMARY KEY,
+	value BLOB NOT NULL,
+	expires_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_tmdb_cache_expires_at ON tmdb_cache(expires_at);
+CREATE TABLE IF NOT EXISTS content_warnings (
+	show_id INTEGER PRIMARY KEY REFERENCES shows(id) ON DELETE CASCADE,
+	warnings TEXT NOT NULL,
+	fetched_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS show_changes (
+	seq INTEGER PRIMARY KEY AUTOINCREMENT,
+	show_id INTEGER NOT NULL,
+	deleted INTEGER NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_show_changes_show ON show_changes(show_id);
+CREATE TRIGGER IF NOT EXISTS shows_changes_insert AFTER INSERT ON shows BEGIN
+	DELETE FROM show_changes WHERE show_id = NEW.id;
+	INSERT INTO show_changes (show_id, deleted) VALUES (NEW.id, 0);
+END;
+CREATE TRIGGER IF NOT EXISTS shows_changes_update AFTER UPDATE ON shows BEGIN
+	DELETE FROM show_changes WHERE show_id = NEW.id;
+	INSERT INTO show_changes (show_id, deleted) VALUES (NEW.id, 0);
+END;
+CREATE TRIGGER IF NOT EXISTS shows_changes_delete AFTER DELETE ON shows BEGIN
+	DELETE FROM show_changes WHERE show_id = OLD.id;
+	INSERT INTO show_changes (show_id, deleted) VALUES (OLD.id, 1);
+END;
+CREATE TABLE IF NOT EXISTS watch_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	watched_at TEXT NOT NULL,
+	created_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_watch_events_show ON watch_events(show_id, watched_at);
+CREATE INDEX IF NOT EXISTS idx_watch_events_watched_at ON watch_events(watched_at);
+CREATE TRIGGER IF NOT EXISTS shows_watch_insert AFTER INSERT ON shows
+WHEN NEW.status = 'watched' BEGIN
+	INSERT INTO watch_events (show_id, watched_at, created_at)
+	VALUES (NEW.id, COALESCE(NEW.watched_at, strftime('%Y-%m-%dT%H:%M:%SZ', 'now')), strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+END;
+CREATE TRIGGER IF NOT EXISTS shows_watch_update AFTER UPDATE OF status ON shows
+WHEN NEW.status = 'watched' AND OLD.status != 'watched' BEGIN
+	INSERT INTO watch_events (show_id, watched_at, created_at)
+	VALUES (NEW.id, COALESCE(NEW.watched_at, strftime('%Y-%m-%dT%H:%M:%SZ', 'now')), strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+END;
+CREATE TRIGGER IF NOT EXISTS shows_watch_date AFTER UPDATE OF watched_at ON shows
+WHEN OLD.status = 'watched' AND NEW.status = 'watched' AND NEW.watched_at != OLD.watched_at BEGIN
+	UPDATE watch_events SET watched_at = NEW.watched_at WHERE show_id = NEW.id AND watched_at = OLD.watched_at;
+END;
+CREATE TABLE IF NOT EXISTS library_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	show_id INTEGER NOT NULL,
+	tmdb_id INTEGER NOT NULL,
+	media_type TEXT NOT NULL,
+	title TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	old_bf_rating INTEGER,
+	old_gf_rating INTEGER,
+	new_bf_rating INTEGER,
+	new_gf_rating INTEGER,
+	old_tmdb_id INTEGER,
+	created_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_library_events_created_at ON library_events(created_at);
+CREATE TRIGGER IF NOT EXISTS shows_log_insert AFTER INSERT ON shows BEGIN
+	INSERT INTO library_events (show_id, tmdb_id, media_type, title, kind, new_bf_rating, new_gf_rating, created_at)
+	VALUES (NEW.id, NEW.tmdb_id, NEW.media_type, NEW.title, 'added', NEW.bf_rating, NEW.gf_rating, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+END;
+CREATE TRIGGER IF NOT EXISTS shows_log_delete AFTER DELETE ON shows BEGIN
+	INSERT INTO library_events (show_id, tmdb_id, media_type, title, kind, old_bf_rating, old_gf_rating, created_at)
+	VALUES (OLD.id, OLD.tmdb_id, OLD.media_type, OLD.title, 'deleted', OLD.bf_rating, OLD.gf_rating, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+END;
+CREATE TRIGGER IF NOT EXISTS shows_log_ratings AFTER UPDATE OF bf_rating, gf_rating ON shows
+WHEN OLD.bf_rating IS NOT NEW.bf_rating OR OLD.gf_rating IS NOT NEW.gf_rating BEGIN
+	INSERT INTO library_events (show_id, tmdb_id, media_type, title, kind, old_bf_rating, old_gf_rating, new_bf_rating, new_gf_rating, created_at)
+	VALUES (NEW.id, NEW.tmdb_id, NEW.media_type, NEW.title, 'rated', OLD.bf_rating, OLD.gf_rating, NEW.bf_rating, NEW.gf_rating, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
+END;
+CREATE TABLE IF NOT EXISTS preferences (
+	person TEXT PRIMARY KEY,
+	metadata_language TEXT,
+	reminders_snoozed_until TEXT,
+	updated_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS search_history (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	person TEXT NOT NULL,
+	query TEXT NOT NULL COLLATE NOCASE,
+	count INTEGER NOT NULL,
+	searched_at TEXT NOT NULL,
+	UNIQUE(person, query)
+);
+CREATE INDEX IF NOT EXISTS idx_search_history_recent ON search_history(person, searched_at);
+CREATE TABLE IF NOT EXISTS settings (
+	key TEXT PRIMARY KEY,
+	value TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS smart_lists (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT NOT NULL,
+	criteria TEXT NOT NULL,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS plans (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+	scheduled_at TEXT NOT NULL,
+	note TEXT,
+	bf_confirmed INTEGER NOT NULL DEFAULT 0,
+	gf_confirmed INTEGER NOT NULL DEFAULT 0,
+	completed_at TEXT,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_plans_scheduled_at ON plans(scheduled_at);
 `
 	if _, err := tx.ExecContext(ctx, schema); err != nil {
 		return err
@@ -192,6 +544,151 @@ CREATE INDEX IF NOT EXISTS idx_shows_year ON shows(year);
 	if err := addColumnIfMissingTx(ctx, tx, "shows", "origin_country", "ALTER TABLE shows ADD COLUMN origin_country TEXT"); err != nil {
 		return err
 	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "tvdb_id", "ALTER TABLE shows ADD COLUMN tvdb_id INTEGER"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "wikidata_id", "ALTER TABLE shows ADD COLUMN wikidata_id TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "runtime", "ALTER TABLE shows ADD COLUMN runtime INTEGER"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "release_date", "ALTER TABLE shows ADD COLUMN release_date TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "next_air_date", "ALTER TABLE shows ADD COLUMN next_air_date TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "next_episode_season", "ALTER TABLE shows ADD COLUMN next_episode_season INTEGER"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "next_episode_number", "ALTER TABLE shows ADD COLUMN next_episode_number INTEGER"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "pinned", "ALTER TABLE shows ADD COLUMN pinned INTEGER NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "watched_at", "ALTER TABLE shows ADD COLUMN watched_at TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "available_on", "ALTER TABLE shows ADD COLUMN available_on TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "companies", "ALTER TABLE shows ADD COLUMN companies TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "networks", "ALTER TABLE shows ADD COLUMN networks TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "episode_count", "ALTER TABLE shows ADD COLUMN episode_count INTEGER"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "audio_languages", "ALTER TABLE shows ADD COLUMN audio_languages TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "translations", "ALTER TABLE shows ADD COLUMN translations TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "rt_score", "ALTER TABLE shows ADD COLUMN rt_score INTEGER"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "metascore", "ALTER TABLE shows ADD COLUMN metascore INTEGER"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "streaming_on", "ALTER TABLE shows ADD COLUMN streaming_on TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "request_status", "ALTER TABLE shows ADD COLUMN request_status TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "requested_at", "ALTER TABLE shows ADD COLUMN requested_at TEXT"); err != nil {
+		return err
+	}
+
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "couple_score", "ALTER TABLE shows ADD COLUMN couple_score REAL"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "ratings_unfrozen_at", "ALTER TABLE shows ADD COLUMN ratings_unfrozen_at TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "picked_by", "ALTER TABLE shows ADD COLUMN picked_by TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "vetoed_by", "ALTER TABLE shows ADD COLUMN vetoed_by TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "vetoed_at", "ALTER TABLE shows ADD COLUMN vetoed_at TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "tmdb_refreshed_at", "ALTER TABLE shows ADD COLUMN tmdb_refreshed_at TEXT"); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "CREATE INDEX IF NOT EXISTS idx_shows_couple_score ON shows(couple_score)"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "preferences", "reminders_snoozed_until", "ALTER TABLE preferences ADD COLUMN reminders_snoozed_until TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "sessions", "person", "ALTER TABLE sessions ADD COLUMN person TEXT"); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "shows", "first_watched_at", "ALTER TABLE shows ADD COLUMN first_watched_at TEXT"); err != nil {
+		return err
+	}
+	// first_watched_at starts the rating freeze window. It is set the first
+	// time a show is watched, no later than now, and never moves again, so
+	// resetting the status or watch date doesn't reopen ratings.
+	if _, err := tx.ExecContext(ctx, `
+CREATE TRIGGER IF NOT EXISTS shows_first_watched_insert AFTER INSERT ON shows
+WHEN NEW.status = 'watched' BEGIN
+	UPDATE shows SET first_watched_at = MIN(COALESCE(NEW.watched_at, strftime('%Y-%m-%dT%H:%M:%SZ', 'now')), strftime('%Y-%m-%dT%H:%M:%SZ', 'now'))
+	WHERE id = NEW.id;
+END;
+CREATE TRIGGER IF NOT EXISTS shows_first_watched_update AFTER UPDATE OF status ON shows
+WHEN NEW.status = 'watched' AND NEW.first_watched_at IS NULL BEGIN
+	UPDATE shows SET first_watched_at = MIN(COALESCE(NEW.watched_at, strftime('%Y-%m-%dT%H:%M:%SZ', 'now')), strftime('%Y-%m-%dT%H:%M:%SZ', 'now'))
+	WHERE id = NEW.id;
+END;`); err != nil {
+		return err
+	}
+	if err := addColumnIfMissingTx(ctx, tx, "library_events", "old_tmdb_id", "ALTER TABLE library_events ADD COLUMN old_tmdb_id INTEGER"); err != nil {
+		return err
+	}
+
+	if err := backfillShowTagsTx(ctx, tx); err != nil {
+		return err
+	}
+	// Watched shows from before watch_events get their one known watch.
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO watch_events (show_id, watched_at, created_at)
+SELECT id, watched_at, updated_at FROM shows
+WHERE status = 'watched' AND watched_at IS NOT NULL
+	AND NOT EXISTS (SELECT 1 FROM watch_events WHERE show_id = shows.id)`); err != nil {
+		return err
+	}
+	// Shows watched before first_watched_at existed start from their
+	// earliest logged watch.
+	if _, err := tx.ExecContext(ctx, `
+UPDATE shows SET first_watched_at = COALESCE(
+	(SELECT MIN(watched_at) FROM watch_events WHERE show_id = shows.id),
+	CASE WHEN status = 'watched' THEN watched_at END)
+WHERE first_watched_at IS NULL`); err != nil {
+		return err
+	}
+	// The first change-tracking triggers used INSERT OR REPLACE, which an
+	// upsert's ON CONFLICT overrides, so re-adding a show failed.
+	if _, err := tx.ExecContext(ctx, `
+DROP TRIGGER IF EXISTS shows_track_insert;
+DROP TRIGGER IF EXISTS shows_track_update;
+DROP TRIGGER IF EXISTS shows_track_delete;`); err != nil {
+		return err
+	}
+	// Shows that predate change tracking count as changed once.
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO show_changes (show_id, deleted)
+SELECT id, 0 FROM shows WHERE id NOT IN (SELECT show_id FROM show_changes)`); err != nil {
+		return err
+	}
 
 	return tx.Commit()
 }
@@ -262,6 +759,9 @@ func nowUTC() string {
 	return time.Now().UTC().Format(time.RFC3339)
 }
 
+// UpsertShow adds a show or refreshes the metadata of the one already in the
+// library. Adding as watched leaves the show planned when the "block"
+// watched-ratings rule would refuse it.
 func (s *Store) UpsertShow(ctx context.Context, show *Show) (int64, error) {
 	if show == nil {
 		return 0, errors.New("show is nil")
@@ -274,6 +774,7 @@ func (s *Store) UpsertShow(ctx context.Context, show *Show) (int64, error) {
 
 	sh.CreatedAt = now
 	sh.UpdatedAt = now
+	sh.TMDBRefreshedAt = sql.Null[string]{V: now, Valid: true}
 
 	// Ensure new inserts start with NULL ratings/comments.
 	sh.BfRating = sql.Null[int64]{}
@@ -281,46 +782,108 @@ func (s *Store) UpsertShow(ctx context.Context, show *Show) (int64, error) {
 	sh.BfComment = sql.Null[string]{}
 	sh.GfComment = sql.Null[string]{}
 
-	_, err := s.db.NewInsert().
-		Model(&sh).
-		Column(
-			"tmdb_id",
-			"media_type",
-			"title",
-			"year",
-			"genres",
-			"overview",
-			"poster_path",
-			"imdb_id",
-			"tmdb_rating",
-			"tmdb_votes",
-			"origin_country",
-			"status",
-			"bf_rating",
-			"gf_rating",
-			"bf_comment",
-			"gf_comment",
-			"created_at",
-			"updated_at",
-		).
-		On("CONFLICT (tmdb_id, media_type) DO UPDATE").
-		Set("title = EXCLUDED.title").
-		Set("year = EXCLUDED.year").
-		Set("genres = EXCLUDED.genres").
-		Set("overview = EXCLUDED.overview").
-		Set("poster_path = EXCLUDED.poster_path").
-		Set("imdb_id = EXCLUDED.imdb_id").
-		Set("tmdb_rating = EXCLUDED.tmdb_rating").
-		Set("tmdb_votes = EXCLUDED.tmdb_votes").
-		Set("origin_country = EXCLUDED.origin_country").
-		Set("status = EXCLUDED.status").
-		Set("updated_at = EXCLUDED.updated_at").
-		Exec(ctx)
+	sh.WatchedAt = sql.Null[string]{}
+	if sh.Status == "watched" {
+		sh.WatchedAt = sql.Null[string]{V: now, Valid: true}
+	}
+
+	var id int64
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if sh.Status == StatusWatched {
+			blocked, err := addWatchBlocked(ctx, tx, sh.TMDBID, sh.MediaType)
+			if err != nil {
+				return err
+			}
+			if blocked {
+				sh.Status = StatusPlanned
+				sh.WatchedAt = sql.Null[string]{}
+			}
+		}
+
+		_, err := tx.NewInsert().
+			Model(&sh).
+			Column(
+				"tmdb_id",
+				"media_type",
+				"title",
+				"year",
+				"genres",
+				"overview",
+				"poster_path",
+				"imdb_id",
+				"tvdb_id",
+				"wikidata_id",
+				"tmdb_rating",
+				"tmdb_votes",
+				"origin_country",
+				"companies",
+				"networks",
+				"runtime",
+				"episode_count",
+				"audio_languages",
+				"translations",
+				"release_date",
+				"next_air_date",
+				"next_episode_season",
+				"next_episode_number",
+				"status",
+				"watched_at",
+				"bf_rating",
+				"gf_rating",
+				"bf_comment",
+				"gf_comment",
+				"tmdb_refreshed_at",
+				"created_at",
+				"updated_at",
+			).
+			On("CONFLICT (tmdb_id, media_type) DO UPDATE").
+			Set("title = EXCLUDED.title").
+			Set("year = EXCLUDED.year").
+			Set("genres = EXCLUDED.genres").
+			Set("overview = EXCLUDED.overview").
+			Set("poster_path = EXCLUDED.poster_path").
+			Set("imdb_id = EXCLUDED.imdb_id").
+			Set("tvdb_id = EXCLUDED.tvdb_id").
+			Set("wikidata_id = EXCLUDED.wikidata_id").
+			Set("tmdb_rating = EXCLUDED.tmdb_rating").
+			Set("tmdb_votes = EXCLUDED.tmdb_votes").
+			Set("origin_country = EXCLUDED.origin_country").
+			Set("companies = EXCLUDED.companies").
+			Set("networks = EXCLUDED.networks").
+			Set("runtime = EXCLUDED.runtime").
+			Set("episode_count = EXCLUDED.episode_count").
+			Set("audio_languages = EXCLUDED.audio_languages").
+			Set("translations = EXCLUDED.translations").
+			Set("release_date = EXCLUDED.release_date").
+			Set("next_air_date = EXCLUDED.next_air_date").
+			Set("next_episode_season = EXCLUDED.next_episode_season").
+			Set("next_episode_number = EXCLUDED.next_episode_number").
+			Set("tmdb_refreshed_at = EXCLUDED.tmdb_refreshed_at").
+			// Re-adding never downgrades: watched wins over planned. Moving a
+			// show back to planned goes through UpdateStatus.
+			Set("status = CASE WHEN status = 'watched' THEN status ELSE EXCLUDED.status END").
+			Set("watched_at = CASE WHEN status = 'watched' OR EXCLUDED.status = 'watched' THEN COALESCE(watched_at, EXCLUDED.watched_at) END").
+			Set("updated_at = EXCLUDED.updated_at").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.NewSelect().
+			Table("shows").
+			Column("id").
+			Where("tmdb_id = ?", sh.TMDBID).
+			Where("media_type = ?", sh.MediaType).
+			Limit(1).
+			Scan(ctx, &id); err != nil {
+			return err
+		}
+		return replaceShowTags(ctx, tx, id, &sh)
+	})
 	if err != nil {
 		return 0, err
 	}
-
-	return s.GetShowIDByTMDB(ctx, sh.TMDBID, sh.MediaType)
+	return id, nil
 }
 
 func (s *Store) GetShowIDByTMDB(ctx context.Context, tmdbID int64, mediaType string) (int64, error) {
@@ -338,6 +901,22 @@ func (s *Store) GetShowIDByTMDB(ctx context.Context, tmdbID int64, mediaType str
 	return id, nil
 }
 
+// GetShowIDByIMDb finds a library show by its IMDb ID.
+func (s *Store) GetShowIDByIMDb(ctx context.Context, imdbID, mediaType string) (int64, error) {
+	var id int64
+	err := s.db.NewSelect().
+		Table("shows").
+		Column("id").
+		Where("imdb_id = ?", imdbID).
+		Where("media_type = ?", mediaType).
+		Limit(1).
+		Scan(ctx, &id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
 func (s *Store) GetShow(ctx context.Context, id int64) (Show, error) {
 	var sh Show
 	err := s.db.NewSelect().
@@ -355,45 +934,305 @@ type RatingsUpdate struct {
 	GfComment *sql.Null[string]
 }
 
+// UpdateRatings writes ratings and comments and marks the show watched. Under
+// the "block" watched-ratings rule a show someone hasn't rated yet keeps its
+// status until both ratings are in.
 func (s *Store) UpdateRatings(ctx context.Context, id int64, update RatingsUpdate) error {
-	if update.BfRating == nil && update.GfRating == nil && update.BfComment == nil && update.GfComment == nil {
+	if update.empty() {
 		return errors.New("no ratings fields provided")
 	}
 
+	if err := s.checkRatingsOpen(ctx, id); err != nil {
+		return err
+	}
+	blocked, err := watchBlocked(ctx, s.db, id, update)
+	if err != nil {
+		return err
+	}
+
+	now := nowUTC()
+
+	q := s.db.NewUpdate().
+		Table("shows").
+		Where("id = ?", id).
+		Set("updated_at = ?", now)
+	if !blocked {
+		q = q.Set("status = ?", "watched").
+			Set("watched_at = COALESCE(watched_at, ?)", now)
+	}
+	q = update.apply(q)
+
+	res, err := q.Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if err := expectRowsAffected(res); err != nil {
+		return err
+	}
+	return s.refreshCoupleScores(ctx, id)
+}
+
+func (u RatingsUpdate) empty() bool {
+	return u.BfRating == nil && u.GfRating == nil && u.BfComment == nil && u.GfComment == nil
+}
+
+func (u RatingsUpdate) apply(q *bun.UpdateQuery) *bun.UpdateQuery {
+	if u.BfRating != nil {
+		q = q.Set("bf_rating = ?", *u.BfRating)
+	}
+	if u.GfRating != nil {
+		q = q.Set("gf_rating = ?", *u.GfRating)
+	}
+	if u.BfComment != nil {
+		q = q.Set("bf_comment = ?", *u.BfComment)
+	}
+	if u.GfComment != nil {
+		q = q.Set("gf_comment = ?", *u.GfComment)
+	}
+	return q
+}
+
+// ShowPatch is a sparse show update; nil fields are left unchanged.
+type ShowPatch struct {
+	Status  *string
+	Ratings RatingsUpdate
+	// WatchedAt overrides the date otherwise derived from Status.
+	WatchedAt *sql.Null[string]
+	Pinned    *bool
+	// PickedBy records who chose the show; going back to planned clears it.
+	PickedBy *sql.Null[string]
+}
+
+func (p ShowPatch) Empty() bool {
+	return p.Status == nil && p.Ratings.empty() && p.WatchedAt == nil && p.Pinned == nil && p.PickedBy == nil
+}
+
+// PatchShow applies a sparse update in one statement. As with UpdateRatings,
+// rating a show marks it watched unless the patch sets a status or the
+// watched-ratings rule holds it back.
+func (s *Store) PatchShow(ctx context.Context, id int64, patch ShowPatch) error {
+	if patch.Empty() {
+		return errors.New("empty patch")
+	}
+	if !patch.Ratings.empty() {
+		if err := s.checkRatingsOpen(ctx, id); err != nil {
+			return err
+		}
+	}
+
 	now := nowUTC()
 
 	q := s.db.NewUpdate().
 		Table("shows").
 		Where("id = ?", id).
-		Set("status = ?", "watched").
 		Set("updated_at = ?", now)
 
-	if update.BfRating != nil {
-		q = q.Set("bf_rating = ?", *update.BfRating)
+	status := patch.Status
+	if status != nil && *status == StatusWatched {
+		if err := checkCanWatch(ctx, s.db, id, patch.Ratings); err != nil {
+			return err
+		}
+	}
+	if status == nil && !patch.Ratings.empty() {
+		blocked, err := watchBlocked(ctx, s.db, id, patch.Ratings)
+		if err != nil {
+			return err
+		}
+		if !blocked {
+			watched := "watched"
+			status = &watched
+		}
+	}
+	if status != nil {
+		q = q.Set("status = ?", *status)
+		if patch.WatchedAt == nil {
+			q = q.Set("watched_at = CASE WHEN ? = 'watched' THEN COALESCE(watched_at, ?) END", *status, now)
+		}
+		if patch.PickedBy == nil {
+			q = q.Set("picked_by = CASE WHEN ? = 'watched' THEN picked_by END", *status)
+		}
 	}
-	if update.GfRating != nil {
-		q = q.Set("gf_rating = ?", *update.GfRating)
+	if patch.WatchedAt != nil {
+		q = q.Set("watched_at = ?", *patch.WatchedAt)
 	}
-	if update.BfComment != nil {
-		q = q.Set("bf_comment = ?", *update.BfComment)
+	if patch.PickedBy != nil {
+		q = q.Set("picked_by = ?", *patch.PickedBy)
 	}
-	if update.GfComment != nil {
-		q = q.Set("gf_comment = ?", *update.GfComment)
+	if patch.Pinned != nil {
+		q = q.Set("pinned = ?", *patch.Pinned)
 	}
+	q = patch.Ratings.apply(q)
 
 	res, err := q.Exec(ctx)
 	if err != nil {
 		return err
 	}
-	return expectRowsAffected(res)
+	if err := expectRowsAffected(res); err != nil {
+		return err
+	}
+	if patch.Ratings.empty() {
+		return nil
+	}
+	return s.refreshCoupleScores(ctx, id)
 }
 
 func (s *Store) UpdateStatus(ctx context.Context, id int64, status string) error {
+	if status == StatusWatched {
+		if err := checkCanWatch(ctx, s.db, id, RatingsUpdate{}); err != nil {
+			return err
+		}
+	}
 	now := nowUTC()
 
 	res, err := s.db.NewUpdate().
 		Table("shows").
 		Set("status = ?", status).
+		Set("watched_at = CASE WHEN ? = 'watched' THEN COALESCE(watched_at, ?) END", status, now).
+		Set("picked_by = CASE WHEN ? = 'watched' THEN picked_by END", status).
+		Set("updated_at = ?", now).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// MarkWatched sets a show to watched at the given time, e.g. when a media
+// server reports that playback finished.
+func (s *Store) MarkWatched(ctx context.Context, id int64, watchedAt string) error {
+	if err := checkCanWatch(ctx, s.db, id, RatingsUpdate{}); err != nil {
+		return err
+	}
+	res, err := s.db.NewUpdate().
+		Table("shows").
+		Set("status = 'watched'").
+		Set("watched_at = ?", watchedAt).
+		Set("updated_at = ?", nowUTC()).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// SetRequested records that a download request was sent for the show.
+func (s *Store) SetRequested(ctx context.Context, id int64, status string) error {
+	now := nowUTC()
+
+	res, err := s.db.NewUpdate().
+		Table("shows").
+		Set("request_status = ?", status).
+		Set("requested_at = ?", now).
+		Set("updated_at = ?", now).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// SetRequestStatus updates the status of an existing download request.
+func (s *Store) SetRequestStatus(ctx context.Context, id int64, status string) error {
+	res, err := s.db.NewUpdate().
+		Table("shows").
+		Set("request_status = ?", status).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
+
+// ListOpenRequests returns shows whose download request has not finished.
+func (s *Store) ListOpenRequests(ctx context.Context) ([]Show, error) {
+	out := []Show{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("request_status IS NOT NULL").
+		Where("request_status NOT IN ('available', 'declined')").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ErrTMDBIDTaken is returned by RemapTMDBID when another library show
+// already has the new TMDB entry.
+var ErrTMDBIDTaken = errors.New("tmdb id already in library")
+
+// RemapTMDBID re-points a show at a new TMDB entry after an upstream merge or
+// renumbering and logs it to library_events. When another show already has
+// the new entry, the show is left alone, the skipped remap is logged, and
+// ErrTMDBIDTaken is returned.
+func (s *Store) RemapTMDBID(ctx context.Context, id, tmdbID int64) error {
+	var taken bool
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var show Show
+		if err := tx.NewSelect().Model(&show).Column("id", "tmdb_id", "media_type", "title").Where("id = ?", id).Scan(ctx); err != nil {
+			return err
+		}
+
+		var err error
+		taken, err = tx.NewSelect().
+			Table("shows").
+			Where("tmdb_id = ?", tmdbID).
+			Where("media_type = ?", show.MediaType).
+			Where("id != ?", id).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+
+		now := nowUTC()
+		event := LibraryEvent{
+			ShowID:    show.ID,
+			TMDBID:    tmdbID,
+			MediaType: show.MediaType,
+			Title:     show.Title,
+			Kind:      EventRemapped,
+			OldTMDBID: sql.Null[int64]{V: show.TMDBID, Valid: true},
+			CreatedAt: now,
+		}
+		if taken {
+			event.Kind = EventRemapSkipped
+		} else {
+			res, err := tx.NewUpdate().
+				Table("shows").
+				Set("tmdb_id = ?", tmdbID).
+				Set("updated_at = ?", now).
+				Where("id = ?", id).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			if err := expectRowsAffected(res); err != nil {
+				return err
+			}
+		}
+		_, err = tx.NewInsert().Model(&event).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	// Reported after the commit, so the skipped remap stays logged.
+	if taken {
+		return ErrTMDBIDTaken
+	}
+	return nil
+}
+
+func (s *Store) SetPinned(ctx context.Context, id int64, pinned bool) error {
+	now := nowUTC()
+
+	res, err := s.db.NewUpdate().
+		Table("shows").
+		Set("pinned = ?", pinned).
 		Set("updated_at = ?", now).
 		Where("id = ?", id).
 		Exec(ctx)
@@ -404,6 +1243,9 @@ func (s *Store) UpdateStatus(ctx context.Context, id int64, status string) error
 }
 
 func (s *Store) ClearRatings(ctx context.Context, id int64) error {
+	if err := s.checkRatingsOpen(ctx, id); err != nil {
+		return err
+	}
 	now := nowUTC()
 
 	res, err := s.db.NewUpdate().
@@ -412,6 +1254,7 @@ func (s *Store) ClearRatings(ctx context.Context, id int64) error {
 		Set("gf_rating = NULL").
 		Set("bf_comment = NULL").
 		Set("gf_comment = NULL").
+		Set("couple_score = NULL").
 		Set("updated_at = ?", now).
 		Where("id = ?", id).
 		Exec(ctx)
@@ -421,6 +1264,32 @@ func (s *Store) ClearRatings(ctx context.Context, id int64) error {
 	return expectRowsAffected(res)
 }
 
+// ClearPersonRating removes one person's rating and comment, leaving the
+// other person's untouched.
+func (s *Store) ClearPersonRating(ctx context.Context, id int64, person string) error {
+	if !ValidPerson(person) {
+		return errors.New("invalid person")
+	}
+	if err := s.checkRatingsOpen(ctx, id); err != nil {
+		return err
+	}
+
+	res, err := s.db.NewUpdate().
+		Table("shows").
+		Set(person+"_rating = NULL").
+		Set(person+"_comment = NULL").
+		Set("updated_at = ?", nowUTC()).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if err := expectRowsAffected(res); err != nil {
+		return err
+	}
+	return s.refreshCoupleScores(ctx, id)
+}
+
 func (s *Store) DeleteShow(ctx context.Context, id int64) error {
 	res, err := s.db.NewDelete().
 		Table("shows").
@@ -444,7 +1313,22 @@ func expectRowsAffected(res sql.Result) error {
 }
 
 func (s *Store) InLibraryByTMDB(ctx context.Context, refs []TMDBRef) (map[TMDBRef]bool, error) {
-	out := make(map[TMDBRef]bool, len(refs))
+	statuses, err := s.LibraryStatusByTMDB(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[TMDBRef]bool, len(statuses))
+	for ref := range statuses {
+		out[ref] = true
+	}
+	return out, nil
+}
+
+// LibraryStatusByTMDB returns the library status ("planned"/"watched") for the
+// refs that are in the library, from the in-memory copy of the library's
+// refs.
+func (s *Store) LibraryStatusByTMDB(ctx context.Context, refs []TMDBRef) (map[TMDBRef]string, error) {
+	out := make(map[TMDBRef]string, len(refs))
 	if len(refs) == 0 {
 		return out, nil
 	}
@@ -466,27 +1350,14 @@ func (s *Store) InLibraryByTMDB(ctx context.Context, refs []TMDBRef) (map[TMDBRe
 		return out, nil
 	}
 
-	q := s.db.NewSelect().
-		Table("shows").
-		Column("tmdb_id", "media_type")
-
-	first := true
-	for _, ref := range uniq {
-		if first {
-			q = q.Where("tmdb_id = ? AND media_type = ?", ref.ID, ref.MediaType)
-			first = false
-			continue
-		}
-		q = q.WhereOr("tmdb_id = ? AND media_type = ?", ref.ID, ref.MediaType)
-	}
-
-	var found []TMDBRef
-	if err := q.Scan(ctx, &found); err != nil {
+	statuses, err := s.libraryStatuses(ctx)
+	if err != nil {
 		return nil, err
 	}
-
-	for _, ref := range found {
-		out[TMDBRef{ID: ref.ID, MediaType: ref.MediaType}] = true
+	for _, ref := range uniq {
+		if status, ok := statuses[ref]; ok {
+			out[ref] = status
+		}
 	}
 	return out, nil
 }
@@ -504,16 +1375,51 @@ func (s *Store) ListShows(ctx context.Context, filters ListFilters) (out []Show,
 		q = q.Where("year <= ?", *filters.YearTo)
 	}
 	if filters.Genre != "" {
-		q = q.Where("genres LIKE ?", "%"+filters.Genre+"%")
+		q = q.Where("EXISTS (SELECT 1 FROM show_genres AS sg WHERE sg.show_id = s.id AND sg.name = ?)", filters.Genre)
+	}
+	if len(filters.ExcludeGenres) > 0 {
+		q = q.Where("NOT EXISTS (SELECT 1 FROM show_genres AS sg WHERE sg.show_id = s.id AND sg.name IN (?))", bun.In(filters.ExcludeGenres))
+	}
+	if filters.CompanyID > 0 {
+		q = q.Where("EXISTS (SELECT 1 FROM show_companies AS sco WHERE sco.show_id = s.id AND sco.company_id = ?)", filters.CompanyID)
+	}
+	if filters.Language != "" {
+		q = q.Where("EXISTS (SELECT 1 FROM show_languages AS sl WHERE sl.show_id = s.id AND sl.code = ?)", filters.Language)
+	}
+	if filters.AudioLanguage != "" {
+		q = q.Where("EXISTS (SELECT 1 FROM show_languages AS sl WHERE sl.show_id = s.id AND sl.code = ? AND sl.kind = ?)", filters.AudioLanguage, LanguageAudio)
+	}
+	if filters.NetworkID > 0 {
+		q = q.Where("EXISTS (SELECT 1 FROM show_networks AS sn WHERE sn.show_id = s.id AND sn.network_id = ?)", filters.NetworkID)
+	}
+	if len(filters.Countries) > 0 {
+		q = q.Where("EXISTS (SELECT 1 FROM show_countries AS sc WHERE sc.show_id = s.id AND sc.code IN (?))", bun.In(filters.Countries))
+	}
+	if filters.MaxRuntime != nil {
+		q = q.Where("runtime IS NOT NULL AND runtime <= ?", *filters.MaxRuntime)
 	}
-	if filters.Country != "" {
-		c := filters.Country
+	if filters.MinRTScore != nil {
+		q = q.Where("rt_score >= ?", *filters.MinRTScore)
+	}
+	if filters.MinMetascore != nil {
+		q = q.Where("metascore >= ?", *filters.MinMetascore)
+	}
+	if filters.AiringFrom != "" && filters.AiringTo != "" {
+		q = q.Where("next_air_date BETWEEN ? AND ?", filters.AiringFrom, filters.AiringTo)
+	}
+	switch filters.AvailableOn {
+	case "":
+	case "any":
+		q = q.Where("available_on IS NOT NULL")
+	default:
+		q = q.Where("(',' || available_on || ',') LIKE ?", "%,"+filters.AvailableOn+",%")
+	}
+	if len(filters.StreamingOn) > 0 {
 		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
-			return q.
-				Where("origin_country = ?", c).
-				WhereOr("origin_country LIKE ?", c+",%").
-				WhereOr("origin_country LIKE ?", "%, "+c+",%").
-				WhereOr("origin_country LIKE ?", "%, "+c)
+			for _, id := range filters.StreamingOn {
+				q = q.WhereOr("(',' || streaming_on || ',') LIKE ?", "%,"+strconv.Itoa(id)+",%")
+			}
+			return q
 		})
 	}
 	if filters.Unrated {
@@ -522,23 +1428,28 @@ func (s *Store) ListShows(ctx context.Context, filters ListFilters) (out []Show,
 		})
 	}
 
+	// Pinned shows always come first, whatever the sort.
+	q = q.OrderExpr("pinned DESC")
+
 	switch filters.Sort {
 	case "avg":
-		q = q.OrderExpr(`
-CASE
-	WHEN bf_rating IS NULL AND gf_rating IS NULL THEN NULL
-	ELSE (COALESCE(bf_rating, 0) + COALESCE(gf_rating, 0)) * 1.0 /
-		NULLIF((bf_rating IS NOT NULL) + (gf_rating IS NOT NULL), 0)
-END DESC
-`)
+		q = q.OrderExpr("couple_score DESC")
+	case "delta":
+		q = q.OrderExpr("ABS(bf_rating - gf_rating) DESC")
 	case "bf":
 		q = q.OrderExpr("bf_rating DESC")
 	case "gf":
 		q = q.OrderExpr("gf_rating DESC")
 	case "year":
 		q = q.OrderExpr("year DESC")
+	case "rt":
+		q = q.OrderExpr("rt_score DESC")
+	case "metascore":
+		q = q.OrderExpr("metascore DESC")
 	case "title":
 		q = q.OrderExpr("title COLLATE NOCASE ASC")
+	case "watched":
+		q = q.OrderExpr("(SELECT MAX(we.watched_at) FROM watch_events AS we WHERE we.show_id = s.id) DESC")
 	default:
 		q = q.OrderExpr("updated_at DESC")
 	}
@@ -548,70 +1459,45 @@ END DESC
 }
 
 func (s *Store) ListAllGenres(ctx context.Context) ([]string, error) {
-	var rows []string
+	out := []string{}
 	err := s.db.NewSelect().
-		Table("shows").
-		Column("genres").
-		Where("genres IS NOT NULL").
-		Where("genres != ''").
-		Scan(ctx, &rows)
+		Table("show_genres").
+		ColumnExpr("DISTINCT name").
+		OrderExpr("name COLLATE NOCASE ASC").
+		Scan(ctx, &out)
 	if err != nil {
 		return nil, err
 	}
-
-	seen := map[string]struct{}{}
-	for _, genres := range rows {
-		for _, g := range strings.Split(genres, ",") {
-			g = strings.TrimSpace(g)
-			if g == "" {
-				continue
-			}
-			seen[g] = struct{}{}
-		}
-	}
-
-	out := make([]string, 0, len(seen))
-	for g := range seen {
-		out = append(out, g)
-	}
-
-	slices.SortFunc(out, func(a, b string) int {
-		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
-	})
 	return out, nil
 }
 
 func (s *Store) ListAllCountries(ctx context.Context) ([]string, error) {
-	var rows []string
+	out := []string{}
 	err := s.db.NewSelect().
-		Table("shows").
-		Column("origin_country").
-		Where("origin_country IS NOT NULL").
-		Where("origin_country != ''").
-		Scan(ctx, &rows)
+		Table("show_countries").
+		ColumnExpr("DISTINCT code").
+		OrderExpr("code ASC").
+		Scan(ctx, &out)
 	if err != nil {
 		return nil, err
 	}
+	return out, nil
+}
 
-	seen := map[string]struct{}{}
-	for _, codes := range rows {
-		for _, code := range strings.Split(codes, ",") {
-			code = strings.TrimSpace(code)
-			if code == "" {
-				continue
-			}
-			seen[code] = struct{}{}
-		}
-	}
-
-	out := make([]string, 0, len(seen))
-	for code := range seen {
-		out = append(out, code)
+// ListTMDBStale returns up to limit shows whose TMDB details were last
+// stored before the given time, never-refreshed ones first.
+func (s *Store) ListTMDBStale(ctx context.Context, before string, limit int) ([]TMDBRefresh, error) {
+	out := []TMDBRefresh{}
+	err := s.db.NewSelect().
+		Table("shows").
+		Column("id", "tmdb_id", "media_type", "status", "imdb_id").
+		Where("tmdb_refreshed_at IS NULL OR tmdb_refreshed_at < ?", before).
+		OrderExpr("tmdb_refreshed_at IS NOT NULL, tmdb_refreshed_at ASC, id ASC").
+		Limit(limit).
+		Scan(ctx, &out)
+	if err != nil {
+		return nil, err
 	}
-
-	slices.SortFunc(out, func(a, b string) int {
-		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
-	})
 	return out, nil
 }
 
@@ -619,8 +1505,10 @@ func (s *Store) ListTMDBMissing(ctx context.Context) ([]TMDBRefresh, error) {
 	out := []TMDBRefresh{}
 	err := s.db.NewSelect().
 		Table("shows").
-		Column("tmdb_id", "media_type", "status").
-		Where("tmdb_rating IS NULL OR tmdb_votes IS NULL OR imdb_id IS NULL OR origin_country IS NULL OR origin_country = ''").
+		Column("id", "tmdb_id", "media_type", "status", "imdb_id").
+		Where("tmdb_rating IS NULL OR tmdb_votes IS NULL OR imdb_id IS NULL OR origin_country IS NULL OR origin_country = '' OR release_date IS NULL").
+		// Episodes that already aired leave a stale next-episode date behind.
+		WhereOr("media_type = 'tv' AND next_air_date < date('now')").
 		Scan(ctx, &out)
 	if err != nil {
 		return nil, err
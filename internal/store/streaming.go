@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// StreamingRef is a show whose streaming availability gets refreshed.
+type StreamingRef struct {
+	ID          int64            `bun:"id"`
+	TMDBID      int64            `bun:"tmdb_id"`
+	MediaType   string           `bun:"media_type"`
+	StreamingOn sql.Null[string] `bun:"streaming_on"`
+}
+
+func (s *Store) ListStreamingRefs(ctx context.Context) ([]StreamingRef, error) {
+	out := []StreamingRef{}
+	err := s.db.NewSelect().
+		Table("shows").
+		Column("id", "tmdb_id", "media_type", "streaming_on").
+		OrderExpr("id ASC").
+		Scan(ctx, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetStreamingOn stores the TMDB provider IDs that stream the show. It skips
+// the write when nothing changed, so refreshes don't churn the sync feed.
+func (s *Store) SetStreamingOn(ctx context.Context, ref StreamingRef, providerIDs []int) error {
+	value := joinProviderIDs(providerIDs)
+	if value == ref.StreamingOn {
+		return nil
+	}
+	_, err := s.db.NewUpdate().
+		Table("shows").
+		Set("streaming_on = ?", value).
+		Where("id = ?", ref.ID).
+		Exec(ctx)
+	return err
+}
+
+func joinProviderIDs(ids []int) sql.Null[string] {
+	if len(ids) == 0 {
+		return sql.Null[string]{}
+	}
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.Itoa(id))
+	}
+	return sql.Null[string]{V: strings.Join(parts, ","), Valid: true}
+}
@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// ShowChanges is everything that changed after a sync cursor. show_changes
+// keeps only the latest change per show, written by triggers on shows, so
+// deletions survive as tombstones.
+type ShowChanges struct {
+	Shows   []Show
+	Deleted []int64
+	// Cursor is passed as since on the next sync.
+	Cursor int64
+}
+
+// ChangesSince returns shows changed and deleted after the since cursor; zero
+// returns the whole library.
+func (s *Store) ChangesSince(ctx context.Context, since int64) (ShowChanges, error) {
+	out := ShowChanges{Shows: []Show{}, Deleted: []int64{}}
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewSelect().
+			Table("show_changes").
+			ColumnExpr("COALESCE(MAX(seq), 0)").
+			Scan(ctx, &out.Cursor); err != nil {
+			return err
+		}
+
+		if err := tx.NewSelect().
+			Model(&out.Shows).
+			Where("s.id IN (SELECT show_id FROM show_changes WHERE seq > ? AND deleted = 0)", since).
+			OrderExpr("s.id ASC").
+			Scan(ctx); err != nil {
+			return err
+		}
+
+		return tx.NewSelect().
+			Table("show_changes").
+			Column("show_id").
+			Where("seq > ?", since).
+			Where("deleted = 1").
+			OrderExpr("show_id ASC").
+			Scan(ctx, &out.Deleted)
+	})
+	if err != nil {
+		return ShowChanges{}, err
+	}
+	if out.Cursor < since {
+		out.Cursor = since
+	}
+	return out, nil
+}
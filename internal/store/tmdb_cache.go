@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// tmdbCacheEntry is a cached TMDB response, as the tmdb package encoded it.
+type tmdbCacheEntry struct {
+	bun.BaseModel `bun:"table:tmdb_cache,alias:tc"`
+
+	Key       string `bun:"key,pk"`
+	Value     []byte `bun:"value,notnull"`
+	ExpiresAt string `bun:"expires_at,notnull"`
+}
+
+// tmdbCacheRetention is how long expired responses are kept to fall back on
+// while TMDB is down.
+const tmdbCacheRetention = 7 * 24 * time.Hour
+
+// GetTMDBCache returns the cached response for key and when it expires. An
+// expired entry is still returned; ok is false if there is none.
+func (s *Store) GetTMDBCache(ctx context.Context, key string) (value []byte, expiresAt time.Time, ok bool, err error) {
+	var row tmdbCacheEntry
+	err = s.db.NewSelect().Model(&row).Where("key = ?", key).Limit(1).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, time.Time{}, false, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	expiresAt, err = time.Parse(time.RFC3339, row.ExpiresAt)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	return row.Value, expiresAt, true, nil
+}
+
+// PutTMDBCache stores a response under key and drops entries that expired
+// more than tmdbCacheRetention ago.
+func (s *Store) PutTMDBCache(ctx context.Context, key string, value []byte, expiresAt time.Time) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		row := tmdbCacheEntry{Key: key, Value: value, ExpiresAt: expiresAt.UTC().Format(time.RFC3339)}
+		if _, err := tx.NewInsert().
+			Model(&row).
+			On("CONFLICT (key) DO UPDATE").
+			Set("value = EXCLUDED.value").
+			Set("expires_at = EXCLUDED.expires_at").
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.NewDelete().
+			Model((*tmdbCacheEntry)(nil)).
+			Where("expires_at < ?", time.Now().UTC().Add(-tmdbCacheRetention).Format(time.RFC3339)).
+			Exec(ctx)
+		return err
+	})
+}
@@ -0,0 +1,27 @@
+package store
+
+import "context"
+
+// ListTonightPicks returns up to limit random planned shows that fit in
+// maxMinutes: the whole movie, or a single episode for TV. Shows without a
+// stored runtime and vetoed shows are skipped. An empty mediaType allows
+// both.
+func (s *Store) ListTonightPicks(ctx context.Context, maxMinutes int, mediaType string, limit int) ([]Show, error) {
+	out := []Show{}
+	q := s.db.NewSelect().
+		Model(&out).
+		Where("status = ?", StatusPlanned).
+		Where("vetoed_by IS NULL").
+		Where("runtime IS NOT NULL AND runtime <= ?", maxMinutes)
+	if mediaType != "" {
+		q = q.Where("media_type = ?", mediaType)
+	}
+	err := q.
+		OrderExpr("RANDOM()").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+)
+
+// User is a personal account for one of the two people. Once a person has
+// one, they sign in with their own password instead of the shared one.
+type User struct {
+	bun.BaseModel `bun:"table:users,alias:u"`
+
+	Person    string `bun:"person,pk"`
+	Salt      string `bun:"salt,notnull"`
+	Hash      string `bun:"hash,notnull"`
+	CreatedAt string `bun:"created_at,notnull"`
+	UpdatedAt string `bun:"updated_at,notnull"`
+}
+
+// GetUser returns the person's account. It reports false when the person
+// has none yet.
+func (s *Store) GetUser(ctx context.Context, person string) (User, bool, error) {
+	var out User
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("person = ?", person).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, false, nil
+	}
+	if err != nil {
+		return User{}, false, err
+	}
+	return out, true, nil
+}
+
+// HasUsers reports whether anyone has a personal account.
+func (s *Store) HasUsers(ctx context.Context) (bool, error) {
+	return s.db.NewSelect().Model((*User)(nil)).Exists(ctx)
+}
+
+// SetUserPassword creates or updates the person's account, binds
+// keepSessionID to it and signs out the person's other account sessions and
+// all shared-password sessions, which could otherwise still act as the
+// person.
+func (s *Store) SetUserPassword(ctx context.Context, person, salt, hash string, keepSessionID int64) error {
+	now := nowUTC()
+	user := User{Person: person, Salt: salt, Hash: hash, CreatedAt: now, UpdatedAt: now}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(&user).
+			On("CONFLICT (person) DO UPDATE").
+			Set("salt = EXCLUDED.salt").
+			Set("hash = EXCLUDED.hash").
+			Set("updated_at = EXCLUDED.updated_at").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewDelete().
+			Model((*Session)(nil)).
+			WhereGroup(" AND ", func(q *bun.DeleteQuery) *bun.DeleteQuery {
+				return q.Where("person = ?", person).WhereOr("person IS NULL")
+			}).
+			Where("id != ?", keepSessionID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.NewUpdate().
+			Model((*Session)(nil)).
+			Set("person = ?", person).
+			Where("id = ?", keepSessionID).
+			Exec(ctx)
+		return err
+	})
+}
+
+// UpdateUserHash replaces the person's password hash and salt, keeping their
+// sessions.
+func (s *Store) UpdateUserHash(ctx context.Context, person, salt, hash string) error {
+	res, err := s.db.NewUpdate().
+		Model((*User)(nil)).
+		Set("salt = ?", salt).
+		Set("hash = ?", hash).
+		Set("updated_at = ?", nowUTC()).
+		Where("person = ?", person).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+)
+
+var (
+	// ErrVetoLimit is returned when the person already has as many active
+	// vetoes as allowed.
+	ErrVetoLimit = errors.New("store: no vetoes left")
+	// ErrNotVetoable is returned for shows that are not planned or already
+	// vetoed.
+	ErrNotVetoable = errors.New("store: show cannot be vetoed")
+)
+
+// VetoCounts are each person's active vetoes (vetoed shows still planned)
+// and how many vetoed shows were watched anyway.
+type VetoCounts struct {
+	Bf        int64
+	Gf        int64
+	Overruled int64
+}
+
+func (s *Store) VetoCounts(ctx context.Context) (VetoCounts, error) {
+	var counts VetoCounts
+	err := s.db.NewSelect().
+		Table("shows").
+		ColumnExpr("COUNT(CASE WHEN status = ? AND vetoed_by = ? THEN 1 END)", StatusPlanned, PersonBf).
+		ColumnExpr("COUNT(CASE WHEN status = ? AND vetoed_by = ? THEN 1 END)", StatusPlanned, PersonGf).
+		ColumnExpr("COUNT(CASE WHEN status = ? AND vetoed_by IS NOT NULL THEN 1 END)", StatusWatched).
+		Scan(ctx, &counts.Bf, &counts.Gf, &counts.Overruled)
+	return counts, err
+}
+
+// Veto vetoes a planned show for person, who may hold at most limit active
+// vetoes. The check and the write share a transaction.
+func (s *Store) Veto(ctx context.Context, id int64, person string, limit int) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var show struct {
+			Status   string           `bun:"status"`
+			VetoedBy sql.Null[string] `bun:"vetoed_by"`
+		}
+		if err := tx.NewSelect().
+			Table("shows").
+			Column("status", "vetoed_by").
+			Where("id = ?", id).
+			Limit(1).
+			Scan(ctx, &show); err != nil {
+			return err
+		}
+		if show.Status != StatusPlanned || show.VetoedBy.Valid {
+			return ErrNotVetoable
+		}
+
+		var active int
+		if err := tx.NewSelect().
+			Table("shows").
+			ColumnExpr("COUNT(*)").
+			Where("status = ?", StatusPlanned).
+			Where("vetoed_by = ?", person).
+			Scan(ctx, &active); err != nil {
+			return err
+		}
+		if active >= limit {
+			return ErrVetoLimit
+		}
+
+		_, err := tx.NewUpdate().
+			Table("shows").
+			Set("vetoed_by = ?", person).
+			Set("vetoed_at = ?", nowUTC()).
+			Where("id = ?", id).
+			Exec(ctx)
+		return err
+	})
+}
+
+// Unveto lifts the show's veto. It is a no-op for shows without one.
+func (s *Store) Unveto(ctx context.Context, id int64) error {
+	res, err := s.db.NewUpdate().
+		Table("shows").
+		Set("vetoed_by = NULL").
+		Set("vetoed_at = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return expectRowsAffected(res)
+}
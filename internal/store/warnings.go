@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ContentWarning is one cached DoesTheDogDie topic for a show.
+type ContentWarning struct {
+	Topic string `json:"topic"`
+	Yes   int    `json:"yes"`
+	No    int    `json:"no"`
+}
+
+type contentWarnings struct {
+	bun.BaseModel `bun:"table:content_warnings,alias:cw"`
+
+	ShowID    int64  `bun:"show_id,pk"`
+	Warnings  string `bun:"warnings,notnull"`
+	FetchedAt string `bun:"fetched_at,notnull"`
+}
+
+// GetContentWarnings returns the cached warnings for a show and when they
+// were fetched. A zero time means nothing is cached.
+func (s *Store) GetContentWarnings(ctx context.Context, showID int64) ([]ContentWarning, time.Time, error) {
+	var row contentWarnings
+	err := s.db.NewSelect().Model(&row).Where("show_id = ?", showID).Limit(1).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, time.Time{}, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	fetchedAt, err := time.Parse(time.RFC3339, row.FetchedAt)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	warnings := []ContentWarning{}
+	if err := json.Unmarshal([]byte(row.Warnings), &warnings); err != nil {
+		return nil, time.Time{}, err
+	}
+	return warnings, fetchedAt, nil
+}
+
+// SetContentWarnings caches the warnings for a show, replacing older ones.
+func (s *Store) SetContentWarnings(ctx context.Context, showID int64, warnings []ContentWarning) error {
+	if warnings == nil {
+		warnings = []ContentWarning{}
+	}
+	raw, err := json.Marshal(warnings)
+	if err != nil {
+		return err
+	}
+	row := contentWarnings{ShowID: showID, Warnings: string(raw), FetchedAt: nowUTC()}
+	_, err = s.db.NewInsert().
+		Model(&row).
+		On("CONFLICT (show_id) DO UPDATE").
+		Set("warnings = EXCLUDED.warnings").
+		Set("fetched_at = EXCLUDED.fetched_at").
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+)
+
+// ErrRatingsRequired is returned by writes that would mark a show watched
+// while someone hasn't rated it and the watched-ratings rule is
+// RatingRuleBlock.
+var ErrRatingsRequired = errors.New("ratings required")
+
+// checkCanWatch applies the watched-ratings rule before an explicit move of
+// show id to watched, counting the ratings update makes in the same write.
+func checkCanWatch(ctx context.Context, db bun.IDB, id int64, update RatingsUpdate) error {
+	blocked, err := watchBlocked(ctx, db, id, update)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrRatingsRequired
+	}
+	return nil
+}
+
+// watchBlocked reports whether the watched-ratings rule keeps show id from
+// becoming watched: the rule is RatingRuleBlock and someone would still be
+// missing a rating after update. Shows already watched are never blocked.
+func watchBlocked(ctx context.Context, db bun.IDB, id int64, update RatingsUpdate) (bool, error) {
+	block, err := ratingsBlock(ctx, db)
+	if err != nil || !block {
+		return false, err
+	}
+
+	var row struct {
+		Status   string          `bun:"status"`
+		BfRating sql.Null[int64] `bun:"bf_rating"`
+		GfRating sql.Null[int64] `bun:"gf_rating"`
+	}
+	err = db.NewSelect().
+		Table("shows").
+		Column("status", "bf_rating", "gf_rating").
+		Where("id = ?", id).
+		Limit(1).
+		Scan(ctx, &row)
+	if err != nil {
+		return false, err
+	}
+	if row.Status == StatusWatched {
+		return false, nil
+	}
+
+	bf, gf := row.BfRating, row.GfRating
+	if update.BfRating != nil {
+		bf = *update.BfRating
+	}
+	if update.GfRating != nil {
+		gf = *update.GfRating
+	}
+	return !bf.Valid || !gf.Valid, nil
+}
+
+// addWatchBlocked is watchBlocked for adding a title as watched: a show new
+// to the library has no ratings yet.
+func addWatchBlocked(ctx context.Context, db bun.IDB, tmdbID int64, mediaType string) (bool, error) {
+	var id int64
+	err := db.NewSelect().
+		Table("shows").
+		Column("id").
+		Where("tmdb_id = ?", tmdbID).
+		Where("media_type = ?", mediaType).
+		Limit(1).
+		Scan(ctx, &id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ratingsBlock(ctx, db)
+	}
+	if err != nil {
+		return false, err
+	}
+	return watchBlocked(ctx, db, id, RatingsUpdate{})
+}
+
+// ratingsBlock reports whether the watched-ratings rule is RatingRuleBlock.
+func ratingsBlock(ctx context.Context, db bun.IDB) (bool, error) {
+	rule := RatingRuleOff
+	if _, err := getSetting(ctx, db, settingRatingRule, &rule); err != nil {
+		return false, err
+	}
+	return rule == RatingRuleBlock, nil
+}
@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// WatchEvent is one viewing of a show. Marking a show watched records one
+// through a trigger, so going back to planned keeps the history; rewatches
+// are logged with LogWatch.
+type WatchEvent struct {
+	bun.BaseModel `bun:"table:watch_events,alias:we"`
+
+	ID        int64  `bun:"id,pk,autoincrement"`
+	ShowID    int64  `bun:"show_id,notnull"`
+	WatchedAt string `bun:"watched_at,notnull"`
+	CreatedAt string `bun:"created_at,notnull"`
+}
+
+// MonthActivity counts the watches in one month (YYYY-MM).
+type MonthActivity struct {
+	Month   string `bun:"month"`
+	Watches int64  `bun:"watches"`
+	// Shows counts distinct shows, so rewatches within the month count once.
+	Shows int64 `bun:"shows"`
+}
+
+// ListWatches returns the show's watches, most recent first.
+func (s *Store) ListWatches(ctx context.Context, showID int64) ([]WatchEvent, error) {
+	out := []WatchEvent{}
+	err := s.db.NewSelect().
+		Model(&out).
+		Where("show_id = ?", showID).
+		OrderExpr("watched_at DESC, id DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LogWatch records a viewing at watchedAt. A planned show becomes watched,
+// which records the event through the status trigger.
+func (s *Store) LogWatch(ctx context.Context, showID int64, watchedAt string) error {
+	now := nowUTC()
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var status string
+		if err := tx.NewSelect().
+			Table("shows").
+			Column("status").
+			Where("id = ?", showID).
+			Limit(1).
+			Scan(ctx, &status); err != nil {
+			return err
+		}
+
+		if status != StatusWatched {
+			if err := checkCanWatch(ctx, tx, showID, RatingsUpdate{}); err != nil {
+				return err
+			}
+			_, err := tx.NewUpdate().
+				Table("shows").
+				Set("status = ?", StatusWatched).
+				Set("watched_at = ?", watchedAt).
+				Set("updated_at = ?", now).
+				Where("id = ?", showID).
+				Exec(ctx)
+			return err
+		}
+
+		event := WatchEvent{ShowID: showID, WatchedAt: watchedAt, CreatedAt: now}
+		if _, err := tx.NewInsert().Model(&event).Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.NewUpdate().
+			Table("shows").
+			Set("updated_at = ?", now).
+			Where("id = ?", showID).
+			Exec(ctx)
+		return err
+	})
+}
+
+// DeleteWatch removes one watch. Removing a show's last watch moves it back
+// to planned.
+func (s *Store) DeleteWatch(ctx context.Context, showID, watchID int64) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewDelete().
+			Model((*WatchEvent)(nil)).
+			Where("id = ?", watchID).
+			Where("show_id = ?", showID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := expectRowsAffected(res); err != nil {
+			return err
+		}
+
+		left, err := tx.NewSelect().
+			Model((*WatchEvent)(nil)).
+			Where("show_id = ?", showID).
+			Exists(ctx)
+		if err != nil || left {
+			return err
+		}
+		_, err = tx.NewUpdate().
+			Table("shows").
+			Set("status = ?", StatusPlanned).
+			Set("watched_at = NULL").
+			Set("picked_by = NULL").
+			Set("updated_at = ?", nowUTC()).
+			Where("id = ?", showID).
+			Exec(ctx)
+		return err
+	})
+}
+
+// WatchActivity counts watches per month from the given month (YYYY-MM)
+// on, oldest first. Months without watches are left out.
+func (s *Store) WatchActivity(ctx context.Context, fromMonth string) ([]MonthActivity, error) {
+	out := []MonthActivity{}
+	err := s.db.NewSelect().
+		TableExpr("watch_events AS we").
+		ColumnExpr("substr(we.watched_at, 1, 7) AS month").
+		ColumnExpr("COUNT(*) AS watches").
+		ColumnExpr("COUNT(DISTINCT we.show_id) AS shows").
+		Where("substr(we.watched_at, 1, 7) >= ?", fromMonth).
+		GroupExpr("month").
+		OrderExpr("month ASC").
+		Scan(ctx, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// WikipediaSummary is a cached article lead for a show. An empty Extract
+// records that the show has no article.
+type WikipediaSummary struct {
+	bun.BaseModel `bun:"table:wikipedia_summaries,alias:ws"`
+
+	ShowID    int64  `bun:"show_id,pk"`
+	Extract   string `bun:"extract,notnull"`
+	URL       string `bun:"url,notnull"`
+	FetchedAt string `bun:"fetched_at,notnull"`
+}
+
+// GetWikipediaSummary returns the cached summary for a show and when it was
+// fetched. A zero time means nothing is cached.
+func (s *Store) GetWikipediaSummary(ctx context.Context, showID int64) (WikipediaSummary, time.Time, error) {
+	var row WikipediaSummary
+	err := s.db.NewSelect().Model(&row).Where("show_id = ?", showID).Limit(1).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return WikipediaSummary{}, time.Time{}, nil
+	}
+	if err != nil {
+		return WikipediaSummary{}, time.Time{}, err
+	}
+
+	fetchedAt, err := time.Parse(time.RFC3339, row.FetchedAt)
+	if err != nil {
+		return WikipediaSummary{}, time.Time{}, err
+	}
+	return row, fetchedAt, nil
+}
+
+// SetWikipediaSummary caches the summary for a show.
+func (s *Store) SetWikipediaSummary(ctx context.Context, summary *WikipediaSummary) error {
+	summary.FetchedAt = nowUTC()
+	_, err := s.db.NewInsert().
+		Model(summary).
+		On("CONFLICT (show_id) DO UPDATE").
+		Set("extract = EXCLUDED.extract").
+		Set("url = EXCLUDED.url").
+		Set("fetched_at = EXCLUDED.fetched_at").
+		Exec(ctx)
+	return err
+}
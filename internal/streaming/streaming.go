@@ -0,0 +1,48 @@
+// Package streaming keeps track of which library titles stream on the
+// household's subscriptions, using TMDB watch-provider data.
+package streaming
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/handsomefox/website-rating/internal/logger"
+	"github.com/handsomefox/website-rating/internal/store"
+	"github.com/handsomefox/website-rating/internal/tmdb"
+)
+
+// Refresh looks up the flat-rate providers of every library title in the
+// subscriptions region. It does nothing until subscriptions are configured.
+func Refresh(ctx context.Context, st *store.Store, client tmdb.MetadataProvider) error {
+	subs, err := st.GetSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	if !subs.Configured() {
+		return nil
+	}
+
+	refs, err := st.ListStreamingRefs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		providers, err := client.FetchWatchProviders(ctx, ref.TMDBID, ref.MediaType, subs.Region)
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+		if err != nil {
+			slog.Warn("watch providers failed", slog.Int64("id", ref.ID), logger.Error(err))
+			continue
+		}
+		ids := make([]int, 0, len(providers))
+		for _, p := range providers {
+			ids = append(ids, p.ID)
+		}
+		if err := st.SetStreamingOn(ctx, ref, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
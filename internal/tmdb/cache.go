@@ -0,0 +1,202 @@
+package tmdb
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// CacheStore persists cached responses across restarts. It returns expired
+// entries too, so they can stand in while TMDB is down.
+type CacheStore interface {
+	GetTMDBCache(ctx context.Context, key string) (value []byte, expiresAt time.Time, ok bool, err error)
+	PutTMDBCache(ctx context.Context, key string, value []byte, expiresAt time.Time) error
+}
+
+type CacheConfig struct {
+	// Size is how many responses are kept in memory.
+	Size int
+	// DetailsTTL applies to FetchDetails, SearchTTL to SearchPage,
+	// DiscoverPage and FetchWatchProviders.
+	DetailsTTL time.Duration
+	SearchTTL  time.Duration
+	// Store, if set, keeps responses across restarts.
+	Store CacheStore
+}
+
+// Cached is a MetadataProvider that caches details, search, discover and
+// watch-provider responses from the one it wraps. When a fetch fails, an expired response
+// is returned in its place if there is one.
+type Cached struct {
+	MetadataProvider
+	cache *responseCache
+}
+
+var _ MetadataProvider = (*Cached)(nil)
+
+// NewCached wraps provider with a cache configured by cfg.
+func NewCached(provider MetadataProvider, cfg CacheConfig) *Cached {
+	return &Cached{
+		MetadataProvider: provider,
+		cache: &responseCache{
+			cfg:     cfg,
+			order:   list.New(),
+			entries: map[string]*list.Element{},
+		},
+	}
+}
+
+// Unwrap returns the wrapped provider.
+func (c *Cached) Unwrap() MetadataProvider {
+	return c.MetadataProvider
+}
+
+// WithLanguage returns a localized provider sharing this one's cache.
+func (c *Cached) WithLanguage(language string) MetadataProvider {
+	inner := c.MetadataProvider.WithLanguage(language)
+	if inner == c.MetadataProvider {
+		return c
+	}
+	return &Cached{MetadataProvider: inner, cache: c.cache}
+}
+
+func (c *Cached) FetchDetails(ctx context.Context, id int64, mediaType string) (*Detail, error) {
+	key := fmt.Sprintf("details:%s:%s:%d", c.Language(), mediaType, id)
+	return cached(ctx, c.cache, key, c.cache.cfg.DetailsTTL, func() (*Detail, error) {
+		return c.MetadataProvider.FetchDetails(ctx, id, mediaType)
+	})
+}
+
+func (c *Cached) SearchPage(ctx context.Context, query, mediaType string, page int) (SearchPage, error) {
+	key := fmt.Sprintf("search:%s:%s:%d:%s", c.Language(), mediaType, page, query)
+	return cached(ctx, c.cache, key, c.cache.cfg.SearchTTL, func() (SearchPage, error) {
+		return c.MetadataProvider.SearchPage(ctx, query, mediaType, page)
+	})
+}
+
+func (c *Cached) DiscoverPage(ctx context.Context, mediaType string, filters DiscoverFilters, page int) (SearchPage, error) {
+	raw, err := json.Marshal(filters)
+	if err != nil {
+		return c.MetadataProvider.DiscoverPage(ctx, mediaType, filters, page)
+	}
+	key := fmt.Sprintf("discover:%s:%s:%d:%s", c.Language(), mediaType, page, raw)
+	return cached(ctx, c.cache, key, c.cache.cfg.SearchTTL, func() (SearchPage, error) {
+		return c.MetadataProvider.DiscoverPage(ctx, mediaType, filters, page)
+	})
+}
+
+func (c *Cached) FetchWatchProviders(ctx context.Context, id int64, mediaType, region string) ([]WatchProvider, error) {
+	key := fmt.Sprintf("providers:%s:%d:%s", mediaType, id, region)
+	return cached(ctx, c.cache, key, c.cache.cfg.SearchTTL, func() ([]WatchProvider, error) {
+		return c.MetadataProvider.FetchWatchProviders(ctx, id, mediaType, region)
+	})
+}
+
+// cached answers from the cache while the entry for key is fresh, and
+// otherwise calls fetch and caches what it returns for ttl. Responses are
+// kept encoded so callers never share one.
+func cached[T any](ctx context.Context, c *responseCache, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	var zero T
+	entry, ok := c.get(ctx, key)
+	if ok && time.Now().Before(entry.expiresAt) {
+		var out T
+		if err := json.Unmarshal(entry.value, &out); err == nil {
+			return out, nil
+		}
+	}
+
+	out, err := fetch()
+	if err == nil {
+		if raw, merr := json.Marshal(out); merr == nil {
+			c.put(ctx, key, raw, time.Now().Add(ttl))
+		}
+		return out, nil
+	}
+	if !ok || errors.Is(err, ErrNotFound) || ctx.Err() != nil {
+		return zero, err
+	}
+
+	var stale T
+	if uerr := json.Unmarshal(entry.value, &stale); uerr != nil {
+		return zero, err
+	}
+	slog.Warn("tmdb: serving expired cache entry", slog.String("key", key), slog.Any("err", err))
+	return stale, nil
+}
+
+type cacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// responseCache is an LRU of encoded responses in front of an optional
+// CacheStore.
+type responseCache struct {
+	cfg     CacheConfig
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func (c *responseCache) get(ctx context.Context, key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		entry := *elem.Value.(*cacheEntry)
+		c.mu.Unlock()
+		return entry, true
+	}
+	c.mu.Unlock()
+
+	if c.cfg.Store == nil {
+		return cacheEntry{}, false
+	}
+	value, expiresAt, ok, err := c.cfg.Store.GetTMDBCache(ctx, key)
+	if err != nil {
+		slog.Warn("tmdb: cache read failed", slog.String("key", key), slog.Any("err", err))
+		return cacheEntry{}, false
+	}
+	if !ok {
+		return cacheEntry{}, false
+	}
+	entry := cacheEntry{key: key, value: value, expiresAt: expiresAt}
+	c.remember(entry)
+	return entry, true
+}
+
+func (c *responseCache) put(ctx context.Context, key string, value []byte, expiresAt time.Time) {
+	c.remember(cacheEntry{key: key, value: value, expiresAt: expiresAt})
+	if c.cfg.Store == nil {
+		return
+	}
+	if err := c.cfg.Store.PutTMDBCache(ctx, key, value, expiresAt); err != nil {
+		slog.Warn("tmdb: cache write failed", slog.String("key", key), slog.Any("err", err))
+	}
+}
+
+// remember adds entry to the LRU, evicting the least recently used one
+// when it is full.
+func (c *responseCache) remember(entry cacheEntry) {
+	if c.cfg.Size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[entry.key]; ok {
+		*elem.Value.(*cacheEntry) = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[entry.key] = c.order.PushFront(&entry)
+	if c.order.Len() > c.cfg.Size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -15,10 +15,19 @@ import (
 
 const baseURL = "https://api.themoviedb.org/3"
 
+// ErrNotFound is returned when TMDB responds with 404, which usually means the
+// entry was merged or renumbered upstream.
+var ErrNotFound = errors.New("tmdb: not found")
+
 type Client struct {
-	http      *http.Client
-	apiKey    string
-	readToken string
+	http *http.Client
+	// creds is shared with the client's WithLanguage/WithIncludeAdult
+	// copies so they all rotate together.
+	creds *credentials
+	// limiter is shared the same way; nil means no limit.
+	limiter      *limiter
+	language     string
+	includeAdult bool
 }
 
 type SearchResult struct {
@@ -36,31 +45,53 @@ type SearchResult struct {
 }
 
 type SearchPage struct {
-	Results      []SearchResult
+	Results []SearchResult
+	// People holds person hits; only multi-search returns them.
+	People       []Person
 	Page         int
 	TotalPages   int
 	TotalResults int
 }
 
+// Person is a cast or crew member found by multi-search.
+type Person struct {
+	ID                 int64
+	Name               string
+	ProfilePath        string
+	KnownForDepartment string
+	KnownFor           []SearchResult
+}
+
+type searchItem struct {
+	MediaType        string   `json:"media_type"`
+	Title            string   `json:"title"`
+	Name             string   `json:"name"`
+	ReleaseDate      string   `json:"release_date"`
+	FirstAirDate     string   `json:"first_air_date"`
+	PosterPath       string   `json:"poster_path"`
+	Overview         string   `json:"overview"`
+	ID               int64    `json:"id"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int      `json:"vote_count"`
+	GenreIDs         []int    `json:"genre_ids"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	// Person hits from /search/multi.
+	ProfilePath        string       `json:"profile_path"`
+	KnownForDepartment string       `json:"known_for_department"`
+	KnownFor           []searchItem `json:"known_for"`
+}
+
 type searchResponse struct {
-	Results []struct {
-		MediaType        string   `json:"media_type"`
-		Title            string   `json:"title"`
-		Name             string   `json:"name"`
-		ReleaseDate      string   `json:"release_date"`
-		FirstAirDate     string   `json:"first_air_date"`
-		PosterPath       string   `json:"poster_path"`
-		Overview         string   `json:"overview"`
-		ID               int64    `json:"id"`
-		VoteAverage      float64  `json:"vote_average"`
-		VoteCount        int      `json:"vote_count"`
-		GenreIDs         []int    `json:"genre_ids"`
-		OriginCountry    []string `json:"origin_country"`
-		OriginalLanguage string   `json:"original_language"`
-	} `json:"results"`
-	Page         int `json:"page"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Results      []searchItem `json:"results"`
+	Page         int          `json:"page"`
+	TotalPages   int          `json:"total_pages"`
+	TotalResults int          `json:"total_results"`
+}
+
+type findResponse struct {
+	MovieResults []searchItem `json:"movie_results"`
+	TVResults    []searchItem `json:"tv_results"`
 }
 
 type detailResponse struct {
@@ -75,24 +106,53 @@ type detailResponse struct {
 		ISO3166_1 string `json:"iso_3166_1"`
 	} `json:"production_countries"`
 	ExternalIDs struct {
-		IMDbID string `json:"imdb_id"`
+		IMDbID     string `json:"imdb_id"`
+		TVDBID     int64  `json:"tvdb_id"`
+		WikidataID string `json:"wikidata_id"`
 	} `json:"external_ids"`
 	Genres []struct {
 		Name string `json:"name"`
 	} `json:"genres"`
-	ID          int64   `json:"id"`
-	VoteAverage float64 `json:"vote_average"`
-	VoteCount   int     `json:"vote_count"`
+	ID             int64   `json:"id"`
+	VoteAverage    float64 `json:"vote_average"`
+	VoteCount      int     `json:"vote_count"`
+	Runtime        int     `json:"runtime"`
+	EpisodeRunTime []int   `json:"episode_run_time"`
+	NextEpisode    *struct {
+		AirDate       string `json:"air_date"`
+		SeasonNumber  int    `json:"season_number"`
+		EpisodeNumber int    `json:"episode_number"`
+	} `json:"next_episode_to_air"`
+	ProductionCompanies []Company `json:"production_companies"`
+	Networks            []Network `json:"networks"`
+	NumberOfEpisodes    int       `json:"number_of_episodes"`
+	SpokenLanguages     []struct {
+		ISO639_1 string `json:"iso_639_1"`
+	} `json:"spoken_languages"`
+	Translations struct {
+		Translations []struct {
+			ISO639_1 string `json:"iso_639_1"`
+		} `json:"translations"`
+	} `json:"translations"`
 }
 
-func New(apiKey, readToken string) *Client {
+// New returns a client for the given credentials. fallbacks are further API
+// keys or read tokens to rotate to when TMDB keeps rejecting or throttling
+// the active one.
+func New(apiKey, readToken string, fallbacks ...string) *Client {
 	if strings.TrimSpace(readToken) == "" && looksLikeJWT(apiKey) {
 		readToken = apiKey
 		apiKey = ""
 	}
+	list := []credential{{apiKey: strings.TrimSpace(apiKey), readToken: strings.TrimSpace(readToken)}}
+	for _, key := range fallbacks {
+		if key = strings.TrimSpace(key); key != "" {
+			list = append(list, newCredential(key))
+		}
+	}
 	return &Client{
-		apiKey:    strings.TrimSpace(apiKey),
-		readToken: strings.TrimSpace(readToken),
+		creds:   &credentials{list: list},
+		limiter: newLimiter(DefaultRateLimit),
 		http: &http.Client{
 			Timeout: 10 * time.Second,
 		},
@@ -106,11 +166,57 @@ type Detail struct {
 	Overview      string
 	PosterPath    string
 	IMDbID        string
+	WikidataID    string
 	Genres        []string
 	OriginCountry []string
+	Companies     []Company
+	Networks      []Network
 	TMDBID        int64
+	TVDBID        int64
 	VoteAverage   float64
 	VoteCount     int
+	// Runtime is in minutes: the movie length, or a typical episode for TV.
+	Runtime int
+	// ReleaseDate is the movie release or TV first air date (YYYY-MM-DD).
+	ReleaseDate string
+	// NextAirDate is when the next TV episode airs, if TMDB knows it, along
+	// with that episode's season and number.
+	NextAirDate string
+	NextSeason  int
+	NextEpisode int
+	// Episodes is the TV episode count (zero for movies).
+	Episodes int
+	// AudioLanguages are the spoken languages and Translations the languages
+	// TMDB has a translation in, as lowercase ISO 639-1 codes.
+	AudioLanguages []string
+	Translations   []string
+}
+
+// WithLanguage returns a client whose requests ask TMDB for localized data
+// (e.g. "uk-UA"). An empty language uses TMDB's English default.
+func (c *Client) WithLanguage(language string) MetadataProvider {
+	language = strings.TrimSpace(language)
+	if language == c.language {
+		return c
+	}
+	clone := *c
+	clone.language = language
+	return &clone
+}
+
+func (c *Client) Language() string {
+	return c.language
+}
+
+// WithIncludeAdult returns a client whose searches and discover calls include
+// adult titles.
+func (c *Client) WithIncludeAdult(include bool) *Client {
+	if include == c.includeAdult {
+		return c
+	}
+	clone := *c
+	clone.includeAdult = include
+	return &clone
 }
 
 type DiscoverFilters struct {
@@ -122,6 +228,32 @@ type DiscoverFilters struct {
 	Sort             string
 	OriginCountry    string
 	OriginalLanguage string
+	// WatchRegion is an ISO 3166-1 code; TMDB requires it for provider filters.
+	WatchRegion string
+	// WatchProviders is a TMDB provider ID list ("8|337" = any, "8,337" = all).
+	WatchProviders string
+	// CertificationCountry and MaxCertification filter by age rating, e.g.
+	// "US" + "PG-13" for everything rated PG-13 or lower.
+	CertificationCountry string
+	MaxCertification     string
+	// WithoutGenres excludes titles with any of the genre IDs ("27|53").
+	WithoutGenres string
+	// Companies is a production company ID list ("41077|10342" = any).
+	Companies string
+	// Networks is a TV network ID list ("49|2739" = any); TV only.
+	Networks string
+}
+
+// Company is a production company (studio).
+type Company struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Network is a TV network.
+type Network struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
 }
 
 type Genre struct {
@@ -141,6 +273,16 @@ type Country struct {
 type countryResponse []struct {
 	ISO3166_1   string `json:"iso_3166_1"`
 	EnglishName string `json:"english_name"`
+	NativeName  string `json:"native_name"`
+}
+
+type Keyword struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type keywordResponse struct {
+	Results []Keyword `json:"results"`
 }
 
 type Language struct {
@@ -170,15 +312,36 @@ func (c *Client) SearchPage(ctx context.Context, query string, mediaType string,
 	}
 
 	values := url.Values{}
-	c.maybeSetAPIKey(values)
+	c.maybeSetLanguage(values)
 	values.Set("query", query)
-	values.Set("include_adult", "false")
+	values.Set("include_adult", strconv.FormatBool(c.includeAdult))
 	values.Set("page", strconv.Itoa(page))
 
 	endpoint := baseURL + "/search/" + mediaType + "?" + values.Encode()
 	return c.fetchSearch(ctx, endpoint, mediaType)
 }
 
+// MultiSearchPage searches movies and TV together via /search/multi. Person
+// hits are returned separately in People.
+func (c *Client) MultiSearchPage(ctx context.Context, query string, page int) (SearchPage, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return SearchPage{}, nil
+	}
+	if page < 1 {
+		page = 1
+	}
+
+	values := url.Values{}
+	c.maybeSetLanguage(values)
+	values.Set("query", query)
+	values.Set("include_adult", strconv.FormatBool(c.includeAdult))
+	values.Set("page", strconv.Itoa(page))
+
+	endpoint := baseURL + "/search/multi?" + values.Encode()
+	return c.fetchSearch(ctx, endpoint, "")
+}
+
 func (c *Client) DiscoverPage(ctx context.Context, mediaType string, filters DiscoverFilters, page int) (SearchPage, error) {
 	if mediaType != "movie" && mediaType != "tv" {
 		return SearchPage{}, errors.New("invalid media type")
@@ -188,8 +351,8 @@ func (c *Client) DiscoverPage(ctx context.Context, mediaType string, filters Dis
 	}
 
 	values := url.Values{}
-	c.maybeSetAPIKey(values)
-	values.Set("include_adult", "false")
+	c.maybeSetLanguage(values)
+	values.Set("include_adult", strconv.FormatBool(c.includeAdult))
 	sortBy := strings.TrimSpace(filters.Sort)
 	if sortBy == "" {
 		sortBy = "popularity.desc"
@@ -206,12 +369,32 @@ func (c *Client) DiscoverPage(ctx context.Context, mediaType string, filters Dis
 	if strings.TrimSpace(filters.Genres) != "" {
 		values.Set("with_genres", strings.TrimSpace(filters.Genres))
 	}
+	if strings.TrimSpace(filters.Companies) != "" {
+		values.Set("with_companies", strings.TrimSpace(filters.Companies))
+	}
+	if mediaType == "tv" && strings.TrimSpace(filters.Networks) != "" {
+		values.Set("with_networks", strings.TrimSpace(filters.Networks))
+	}
+	if strings.TrimSpace(filters.WithoutGenres) != "" {
+		values.Set("without_genres", strings.TrimSpace(filters.WithoutGenres))
+	}
 	if strings.TrimSpace(filters.OriginCountry) != "" {
 		values.Set("with_origin_country", strings.TrimSpace(filters.OriginCountry))
 	}
 	if strings.TrimSpace(filters.OriginalLanguage) != "" {
 		values.Set("with_original_language", strings.TrimSpace(filters.OriginalLanguage))
 	}
+	if strings.TrimSpace(filters.WatchRegion) != "" {
+		values.Set("watch_region", strings.TrimSpace(filters.WatchRegion))
+		if strings.TrimSpace(filters.WatchProviders) != "" {
+			values.Set("with_watch_providers", strings.TrimSpace(filters.WatchProviders))
+		}
+	}
+
+	if strings.TrimSpace(filters.CertificationCountry) != "" && strings.TrimSpace(filters.MaxCertification) != "" {
+		values.Set("certification_country", strings.TrimSpace(filters.CertificationCountry))
+		values.Set("certification.lte", strings.TrimSpace(filters.MaxCertification))
+	}
 
 	dateFromKey := "primary_release_date.gte"
 	dateToKey := "primary_release_date.lte"
@@ -237,7 +420,7 @@ func (c *Client) FetchGenres(ctx context.Context, mediaType string) ([]Genre, er
 	}
 
 	values := url.Values{}
-	c.maybeSetAPIKey(values)
+	c.maybeSetLanguage(values)
 	endpoint := baseURL + "/genre/" + mediaType + "/list?" + values.Encode()
 
 	var payload genreResponse
@@ -249,7 +432,7 @@ func (c *Client) FetchGenres(ctx context.Context, mediaType string) ([]Genre, er
 
 func (c *Client) FetchCountries(ctx context.Context) ([]Country, error) {
 	values := url.Values{}
-	c.maybeSetAPIKey(values)
+	c.maybeSetLanguage(values)
 	endpoint := baseURL + "/configuration/countries?" + values.Encode()
 
 	var payload countryResponse
@@ -261,6 +444,9 @@ func (c *Client) FetchCountries(ctx context.Context) ([]Country, error) {
 	for _, item := range payload {
 		code := strings.TrimSpace(item.ISO3166_1)
 		name := strings.TrimSpace(item.EnglishName)
+		if c.language != "" && strings.TrimSpace(item.NativeName) != "" {
+			name = strings.TrimSpace(item.NativeName)
+		}
 		if code == "" {
 			continue
 		}
@@ -271,7 +457,7 @@ func (c *Client) FetchCountries(ctx context.Context) ([]Country, error) {
 
 func (c *Client) FetchLanguages(ctx context.Context) ([]Language, error) {
 	values := url.Values{}
-	c.maybeSetAPIKey(values)
+	c.maybeSetLanguage(values)
 	endpoint := baseURL + "/configuration/languages?" + values.Encode()
 
 	var payload languageResponse
@@ -300,8 +486,8 @@ func (c *Client) FetchDetails(ctx context.Context, id int64, mediaType string) (
 	}
 
 	values := url.Values{}
-	c.maybeSetAPIKey(values)
-	values.Set("append_to_response", "external_ids")
+	c.maybeSetLanguage(values)
+	values.Set("append_to_response", "external_ids,translations")
 
 	endpoint := fmt.Sprintf("%s/%s/%d?%s", baseURL, mediaType, id, values.Encode())
 
@@ -320,14 +506,28 @@ func (c *Client) FetchDetails(ctx context.Context, id int64, mediaType string) (
 		VoteAverage:   payload.VoteAverage,
 		VoteCount:     payload.VoteCount,
 		IMDbID:        payload.ExternalIDs.IMDbID,
+		TVDBID:        payload.ExternalIDs.TVDBID,
+		WikidataID:    payload.ExternalIDs.WikidataID,
 		Year:          yearFromDate(payload.ReleaseDate),
+		ReleaseDate:   payload.ReleaseDate,
 	}
 
 	if mediaType == "tv" {
 		detail.Title = payload.Name
 		detail.Year = yearFromDate(payload.FirstAirDate)
+		detail.ReleaseDate = payload.FirstAirDate
+		if len(payload.EpisodeRunTime) > 0 {
+			detail.Runtime = payload.EpisodeRunTime[0]
+		}
+		if payload.NextEpisode != nil {
+			detail.NextAirDate = payload.NextEpisode.AirDate
+			detail.NextSeason = payload.NextEpisode.SeasonNumber
+			detail.NextEpisode = payload.NextEpisode.EpisodeNumber
+		}
+		detail.Episodes = payload.NumberOfEpisodes
 	} else {
 		detail.Title = payload.Title
+		detail.Runtime = payload.Runtime
 	}
 
 	for _, g := range payload.Genres {
@@ -337,6 +537,34 @@ func (c *Client) FetchDetails(ctx context.Context, id int64, mediaType string) (
 		detail.Genres = append(detail.Genres, g.Name)
 	}
 
+	for _, company := range payload.ProductionCompanies {
+		if company.ID <= 0 || strings.TrimSpace(company.Name) == "" {
+			continue
+		}
+		detail.Companies = append(detail.Companies, company)
+	}
+	for _, network := range payload.Networks {
+		if network.ID <= 0 || strings.TrimSpace(network.Name) == "" {
+			continue
+		}
+		detail.Networks = append(detail.Networks, network)
+	}
+
+	seen := map[string]bool{}
+	for _, lang := range payload.SpokenLanguages {
+		if code := strings.ToLower(strings.TrimSpace(lang.ISO639_1)); code != "" && !seen[code] {
+			seen[code] = true
+			detail.AudioLanguages = append(detail.AudioLanguages, code)
+		}
+	}
+	clear(seen)
+	for _, tr := range payload.Translations.Translations {
+		if code := strings.ToLower(strings.TrimSpace(tr.ISO639_1)); code != "" && !seen[code] {
+			seen[code] = true
+			detail.Translations = append(detail.Translations, code)
+		}
+	}
+
 	if len(payload.OriginCountry) > 0 {
 		for _, code := range payload.OriginCountry {
 			code = strings.TrimSpace(code)
@@ -358,6 +586,69 @@ func (c *Client) FetchDetails(ctx context.Context, id int64, mediaType string) (
 	return detail, nil
 }
 
+// FetchRecommendations returns TMDB's recommendations for a title, i.e. what
+// people who liked it also liked.
+func (c *Client) FetchRecommendations(ctx context.Context, id int64, mediaType string) (SearchPage, error) {
+	if mediaType != "movie" && mediaType != "tv" {
+		return SearchPage{}, errors.New("invalid media type")
+	}
+
+	values := url.Values{}
+	c.maybeSetLanguage(values)
+	values.Set("page", "1")
+
+	endpoint := fmt.Sprintf("%s/%s/%d/recommendations?%s", baseURL, mediaType, id, values.Encode())
+	return c.fetchSearch(ctx, endpoint, mediaType)
+}
+
+// SearchKeywords returns TMDB keywords matching query, for typeahead.
+func (c *Client) SearchKeywords(ctx context.Context, query string) ([]Keyword, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+
+	values := url.Values{}
+	values.Set("query", query)
+	values.Set("page", "1")
+
+	endpoint := baseURL + "/search/keyword?" + values.Encode()
+
+	var payload keywordResponse
+	if err := c.doJSON(ctx, http.MethodGet, endpoint, &payload); err != nil {
+		return nil, err
+	}
+	return payload.Results, nil
+}
+
+// FindByIMDbID resolves an IMDb title ID to the matching TMDB movie/TV entries.
+func (c *Client) FindByIMDbID(ctx context.Context, imdbID string) ([]SearchResult, error) {
+	imdbID = strings.TrimSpace(imdbID)
+	if imdbID == "" {
+		return nil, errors.New("imdb id is required")
+	}
+
+	values := url.Values{}
+	c.maybeSetLanguage(values)
+	values.Set("external_source", "imdb_id")
+
+	endpoint := baseURL + "/find/" + url.PathEscape(imdbID) + "?" + values.Encode()
+
+	var payload findResponse
+	if err := c.doJSON(ctx, http.MethodGet, endpoint, &payload); err != nil {
+		return nil, err
+	}
+
+	out := make([]SearchResult, 0, len(payload.MovieResults)+len(payload.TVResults))
+	for i := range payload.MovieResults {
+		out = append(out, toSearchResult(&payload.MovieResults[i], "movie"))
+	}
+	for i := range payload.TVResults {
+		out = append(out, toSearchResult(&payload.TVResults[i], "tv"))
+	}
+	return out, nil
+}
+
 /* internals */
 
 func (c *Client) fetchSearch(ctx context.Context, endpoint, mediaTypeOverride string) (SearchPage, error) {
@@ -367,56 +658,75 @@ func (c *Client) fetchSearch(ctx context.Context, endpoint, mediaTypeOverride st
 	}
 
 	out := make([]SearchResult, 0, len(payload.Results))
+	var people []Person
 	for i := range payload.Results {
-		r := payload.Results[i]
+		r := &payload.Results[i]
 
 		mediaType := r.MediaType
 		if mediaTypeOverride != "" {
 			mediaType = mediaTypeOverride
 		}
-		if mediaType != "movie" && mediaType != "tv" {
+		if mediaType == "person" {
+			people = append(people, toPerson(r))
 			continue
 		}
-
-		res := SearchResult{
-			ID:               r.ID,
-			MediaType:        mediaType,
-			PosterPath:       r.PosterPath,
-			Overview:         r.Overview,
-			VoteAverage:      r.VoteAverage,
-			VoteCount:        r.VoteCount,
-			GenreIDs:         r.GenreIDs,
-			OriginCountry:    r.OriginCountry,
-			OriginalLanguage: r.OriginalLanguage,
-		}
-
-		if mediaType == "movie" {
-			res.Title = r.Title
-			res.Year = yearFromDate(r.ReleaseDate)
-		} else {
-			res.Title = r.Name
-			res.Year = yearFromDate(r.FirstAirDate)
+		if mediaType != "movie" && mediaType != "tv" {
+			continue
 		}
-		out = append(out, res)
+		out = append(out, toSearchResult(r, mediaType))
 	}
 
 	return SearchPage{
 		Results:      out,
+		People:       people,
 		Page:         payload.Page,
 		TotalPages:   min(payload.TotalPages, 500),
 		TotalResults: payload.TotalResults,
 	}, nil
 }
 
-func (c *Client) doJSON(ctx context.Context, method, endpoint string, dst any) error {
-	req, err := http.NewRequestWithContext(ctx, method, endpoint, http.NoBody)
-	if err != nil {
-		return err
+func toSearchResult(r *searchItem, mediaType string) SearchResult {
+	res := SearchResult{
+		ID:               r.ID,
+		MediaType:        mediaType,
+		PosterPath:       r.PosterPath,
+		Overview:         r.Overview,
+		VoteAverage:      r.VoteAverage,
+		VoteCount:        r.VoteCount,
+		GenreIDs:         r.GenreIDs,
+		OriginCountry:    r.OriginCountry,
+		OriginalLanguage: r.OriginalLanguage,
+	}
+
+	if mediaType == "movie" {
+		res.Title = r.Title
+		res.Year = yearFromDate(r.ReleaseDate)
+	} else {
+		res.Title = r.Name
+		res.Year = yearFromDate(r.FirstAirDate)
 	}
+	return res
+}
 
-	c.applyAuth(req)
+func toPerson(r *searchItem) Person {
+	p := Person{
+		ID:                 r.ID,
+		Name:               r.Name,
+		ProfilePath:        r.ProfilePath,
+		KnownForDepartment: r.KnownForDepartment,
+	}
+	for i := range r.KnownFor {
+		item := &r.KnownFor[i]
+		if item.MediaType != "movie" && item.MediaType != "tv" {
+			continue
+		}
+		p.KnownFor = append(p.KnownFor, toSearchResult(item, item.MediaType))
+	}
+	return p
+}
 
-	resp, err := c.http.Do(req)
+func (c *Client) doJSON(ctx context.Context, method, endpoint string, dst any) error {
+	resp, err := c.send(ctx, method, endpoint)
 	if err != nil {
 		return err
 	}
@@ -426,6 +736,9 @@ func (c *Client) doJSON(ctx context.Context, method, endpoint string, dst any) e
 		}
 	}()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrNotFound, resp.Status)
+	}
 	if resp.StatusCode >= 400 {
 		return fmt.Errorf("tmdb request failed: %s", resp.Status)
 	}
@@ -433,17 +746,10 @@ func (c *Client) doJSON(ctx context.Context, method, endpoint string, dst any) e
 	return json.NewDecoder(resp.Body).Decode(dst)
 }
 
-func (c *Client) maybeSetAPIKey(values url.Values) {
-	if c.apiKey != "" {
-		values.Set("api_key", c.apiKey)
-	}
-}
-
-func (c *Client) applyAuth(req *http.Request) {
-	if strings.TrimSpace(c.readToken) == "" {
-		return
+func (c *Client) maybeSetLanguage(values url.Values) {
+	if c.language != "" {
+		values.Set("language", c.language)
 	}
-	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(c.readToken))
 }
 
 func yearFromDate(date string) string {
@@ -0,0 +1,151 @@
+package tmdb
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rotateAfter consecutive 401 or 429 responses on the active credential
+// switch to the next one.
+const rotateAfter = 3
+
+// credential is one TMDB API key (sent as api_key) or read token (sent as a
+// bearer token).
+type credential struct {
+	apiKey    string
+	readToken string
+}
+
+func newCredential(key string) credential {
+	if looksLikeJWT(key) {
+		return credential{readToken: key}
+	}
+	return credential{apiKey: key}
+}
+
+func (c credential) apply(req *http.Request) {
+	if c.apiKey != "" {
+		query := req.URL.Query()
+		query.Set("api_key", c.apiKey)
+		req.URL.RawQuery = query.Encode()
+	}
+	if c.readToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.readToken)
+	}
+}
+
+type credentials struct {
+	mu        sync.Mutex
+	list      []credential
+	active    int
+	failures  int
+	rotations int
+	rotatedAt time.Time
+}
+
+// CredentialStatus reports which configured credential is in use.
+type CredentialStatus struct {
+	// Count is how many credentials are configured.
+	Count int
+	// Active is the index of the credential in use; 0 is the primary.
+	Active int
+	// Failures counts consecutive 401/429 responses on the active one.
+	Failures  int
+	Rotations int
+	// RotatedAt is when the client last switched; zero if it never did.
+	RotatedAt time.Time
+}
+
+// CredentialStatus reports the credential rotation state.
+func (c *Client) CredentialStatus() CredentialStatus {
+	c.creds.mu.Lock()
+	defer c.creds.mu.Unlock()
+	return CredentialStatus{
+		Count:     len(c.creds.list),
+		Active:    c.creds.active,
+		Failures:  c.creds.failures,
+		Rotations: c.creds.rotations,
+		RotatedAt: c.creds.rotatedAt,
+	}
+}
+
+func (c *credentials) current() (int, credential) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.active, c.list[c.active]
+}
+
+// report records the response to a request made with credential idx and
+// reports whether it is worth retrying with the now active one.
+func (c *credentials) report(idx, status int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if status != http.StatusUnauthorized && status != http.StatusTooManyRequests {
+		if idx == c.active {
+			c.failures = 0
+		}
+		return false
+	}
+	if idx != c.active {
+		// Another request already rotated past this credential.
+		return true
+	}
+	c.failures++
+	if c.failures < rotateAfter || len(c.list) < 2 {
+		return false
+	}
+
+	c.active = (c.active + 1) % len(c.list)
+	c.failures = 0
+	c.rotations++
+	c.rotatedAt = time.Now()
+	slog.Warn("tmdb: rotating credentials",
+		slog.Int("status", status),
+		slog.Int("from", idx),
+		slog.Int("to", c.active),
+	)
+	return true
+}
+
+// send performs a request with the active credential. It waits for the rate
+// limiter, retries right away with the next credential when a failure made
+// the client rotate, and retries 429 and 5xx responses up to maxRetries
+// times after Retry-After or a backoff.
+func (c *Client) send(ctx context.Context, method, endpoint string) (*http.Response, error) {
+	rotations, retries := 0, 0
+	for {
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+		idx, cred := c.creds.current()
+		cred.apply(req)
+
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case c.creds.report(idx, resp.StatusCode) && rotations+1 < len(c.creds.list):
+			rotations++
+		case retryable(resp.StatusCode) && retries < maxRetries:
+			retries++
+			if !waitRetry(ctx, retryDelay(resp, retries), resp.StatusCode) {
+				return resp, nil
+			}
+		default:
+			return resp, nil
+		}
+		// Best-effort close; the request is retried.
+		_ = resp.Body.Close()
+	}
+}
@@ -0,0 +1,35 @@
+package tmdb
+
+import "context"
+
+// MetadataProvider is the show metadata source the handlers depend on.
+// *Client implements it against TMDB; another source (TVmaze, an offline
+// stub) needs to answer in TMDB's IDs and types.
+type MetadataProvider interface {
+	// WithLanguage returns a provider that localizes its answers (e.g.
+	// "uk-UA"); Language reports the current one.
+	WithLanguage(language string) MetadataProvider
+	Language() string
+
+	// Search.
+	SearchPage(ctx context.Context, query, mediaType string, page int) (SearchPage, error)
+	MultiSearchPage(ctx context.Context, query string, page int) (SearchPage, error)
+	DiscoverPage(ctx context.Context, mediaType string, filters DiscoverFilters, page int) (SearchPage, error)
+	FindByIMDbID(ctx context.Context, imdbID string) ([]SearchResult, error)
+	SearchKeywords(ctx context.Context, query string) ([]Keyword, error)
+	FetchRecommendations(ctx context.Context, id int64, mediaType string) (SearchPage, error)
+
+	// Details.
+	FetchDetails(ctx context.Context, id int64, mediaType string) (*Detail, error)
+
+	// Genres and other reference data.
+	FetchGenres(ctx context.Context, mediaType string) ([]Genre, error)
+	FetchCountries(ctx context.Context) ([]Country, error)
+	FetchLanguages(ctx context.Context) ([]Language, error)
+
+	// Streaming providers.
+	FetchWatchProviders(ctx context.Context, id int64, mediaType, region string) ([]WatchProvider, error)
+	ListWatchProviders(ctx context.Context, mediaType, region string) ([]WatchProvider, error)
+}
+
+var _ MetadataProvider = (*Client)(nil)
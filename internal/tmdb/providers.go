@@ -0,0 +1,60 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// WatchProvider is a streaming service as TMDB (via JustWatch) lists it.
+type WatchProvider struct {
+	ID       int    `json:"provider_id"`
+	Name     string `json:"provider_name"`
+	LogoPath string `json:"logo_path"`
+}
+
+type watchProvidersResponse struct {
+	Results map[string]struct {
+		Flatrate []WatchProvider `json:"flatrate"`
+	} `json:"results"`
+}
+
+type providerListResponse struct {
+	Results []WatchProvider `json:"results"`
+}
+
+// FetchWatchProviders returns the flat-rate (subscription) providers that
+// stream a title in region, an ISO 3166-1 code.
+func (c *Client) FetchWatchProviders(ctx context.Context, id int64, mediaType, region string) ([]WatchProvider, error) {
+	if mediaType != "movie" && mediaType != "tv" {
+		return nil, errors.New("invalid media type")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/%d/watch/providers", baseURL, mediaType, id)
+	var payload watchProvidersResponse
+	if err := c.doJSON(ctx, http.MethodGet, endpoint, &payload); err != nil {
+		return nil, err
+	}
+	return payload.Results[strings.ToUpper(region)].Flatrate, nil
+}
+
+// ListWatchProviders lists the providers TMDB knows for mediaType in region.
+func (c *Client) ListWatchProviders(ctx context.Context, mediaType, region string) ([]WatchProvider, error) {
+	if mediaType != "movie" && mediaType != "tv" {
+		return nil, errors.New("invalid media type")
+	}
+
+	values := url.Values{}
+	c.maybeSetLanguage(values)
+	values.Set("watch_region", strings.ToUpper(region))
+
+	endpoint := baseURL + "/watch/providers/" + mediaType + "?" + values.Encode()
+	var payload providerListResponse
+	if err := c.doJSON(ctx, http.MethodGet, endpoint, &payload); err != nil {
+		return nil, err
+	}
+	return payload.Results, nil
+}
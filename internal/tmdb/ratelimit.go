@@ -0,0 +1,122 @@
+package tmdb
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is the default number of requests per second, under
+// TMDB's documented ceiling of about 50.
+const DefaultRateLimit = 40
+
+// Retries of throttled (429) and failed (5xx) requests.
+const (
+	maxRetries = 3
+	retryBase  = 500 * time.Millisecond
+	// maxRetryWait caps both the backoff and a server's Retry-After.
+	maxRetryWait = 30 * time.Second
+)
+
+// limiter is a token bucket holding up to burst requests, refilled at rate
+// per second.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(perSecond float64) *limiter {
+	burst := max(perSecond, 1)
+	return &limiter{rate: perSecond, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// wait blocks until a request may be sent or ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// WithRateLimit returns a client that sends at most perSecond requests per
+// second; zero or less turns limiting off. The limit is shared with clients
+// derived from the returned one.
+func (c *Client) WithRateLimit(perSecond float64) *Client {
+	clone := *c
+	clone.limiter = nil
+	if perSecond > 0 {
+		clone.limiter = newLimiter(perSecond)
+	}
+	return &clone
+}
+
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryDelay is how long to wait before retry number retry (from 1): the
+// response's Retry-After if it has one, else exponential backoff with jitter.
+func retryDelay(resp *http.Response, retry int) time.Duration {
+	if after, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		return min(after, maxRetryWait)
+	}
+	backoff := retryBase << (retry - 1)
+	backoff += rand.N(backoff / 2)
+	return min(backoff, maxRetryWait)
+}
+
+// parseRetryAfter reads a Retry-After header in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// waitRetry sleeps for delay before a retry. It reports false without
+// waiting when ctx would expire first, so the caller can return the failed
+// response instead of an error it caused itself.
+func waitRetry(ctx context.Context, delay time.Duration, status int) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+	slog.Debug("tmdb: retrying", slog.Int("status", status), slog.Duration("delay", delay))
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,105 @@
+// Package wikipedia fetches article summaries for Wikidata items.
+package wikipedia
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const wikidataBase = "https://www.wikidata.org/wiki/Special:EntityData/"
+
+type Client struct {
+	http *http.Client
+	// language is the Wikipedia edition ("en", "uk").
+	language string
+}
+
+// New returns a client for the Wikipedia edition in language ("en" when
+// empty).
+func New(language string) *Client {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if language == "" {
+		language = "en"
+	}
+	return &Client{
+		http:     &http.Client{Timeout: 10 * time.Second},
+		language: language,
+	}
+}
+
+// Summary is the lead of a Wikipedia article.
+type Summary struct {
+	Extract string
+	URL     string
+}
+
+type entityResponse struct {
+	Entities map[string]struct {
+		Sitelinks map[string]struct {
+			Title string `json:"title"`
+		} `json:"sitelinks"`
+	} `json:"entities"`
+}
+
+type summaryResponse struct {
+	Extract     string `json:"extract"`
+	ContentURLs struct {
+		Desktop struct {
+			Page string `json:"page"`
+		} `json:"desktop"`
+	} `json:"content_urls"`
+}
+
+// Summary resolves the Wikidata item (e.g. "Q185888") to its article and
+// returns the article's first paragraph, which stays clear of plot details.
+// It returns a zero Summary when the item has no article in the edition.
+func (c *Client) Summary(ctx context.Context, wikidataID string) (Summary, error) {
+	var entity entityResponse
+	if err := c.get(ctx, wikidataBase+url.PathEscape(wikidataID)+".json", &entity); err != nil {
+		return Summary{}, err
+	}
+
+	var title string
+	for _, e := range entity.Entities {
+		title = e.Sitelinks[c.language+"wiki"].Title
+	}
+	if title == "" {
+		return Summary{}, nil
+	}
+
+	endpoint := "https://" + c.language + ".wikipedia.org/api/rest_v1/page/summary/" +
+		url.PathEscape(strings.ReplaceAll(title, " ", "_"))
+	var page summaryResponse
+	if err := c.get(ctx, endpoint, &page); err != nil {
+		return Summary{}, err
+	}
+
+	extract, _, _ := strings.Cut(strings.TrimSpace(page.Extract), "\n")
+	return Summary{Extract: extract, URL: page.ContentURLs.Desktop.Page}, nil
+}
+
+func (c *Client) get(ctx context.Context, endpoint string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Wikimedia asks API clients to identify themselves.
+	req.Header.Set("User-Agent", "website-rating (github.com/handsomefox/website-rating)")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("wikipedia request failed: %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
@@ -13,10 +13,62 @@ export interface SessionResponse {
   image_base?: string | undefined;
   bf_name?: string | undefined;
   gf_name?: string | undefined;
+  /** Which person ("bf"/"gf") is using this session, when picked. */
+  person?: string | undefined;
+  /** Set when the instance runs with READ_ONLY; mutating calls return 403. */
+  read_only: boolean;
+  /**
+   * True when signed in to the person's own account: the person can't be
+   * switched and only their own ratings can be written.
+   */
+  account: boolean;
+}
+
+/** One signed-in device. */
+export interface SessionInfo {
+  id: number;
+  /** User-chosen device name ("Kitchen tablet"). */
+  name?: string | undefined;
+  user_agent: string;
+  /** Address of the latest request. */
+  ip: string;
+  created_at: string;
+  last_used_at: string;
+  expires_at: string;
+  /** Set on the session making the request. */
+  current: boolean;
+}
+
+export interface SessionsResponse {
+  sessions: SessionInfo[];
+}
+
+export interface SessionPatch {
+  name: string;
+}
+
+export interface ChangePasswordRequest {
+  current_password: string;
+  new_password: string;
 }
 
 export interface ErrorResponse {
   error: string;
+  /**
+   * ID of the failed request as logged by the server, to quote when
+   * reporting it.
+   */
+  request_id: string;
+}
+
+/** RFC 7807 problem details, served as application/problem+json. */
+export interface ProblemDetails {
+  type: string;
+  title: string;
+  status: number;
+  detail: string;
+  instance: string;
+  request_id: string;
 }
 
 export interface Show {
@@ -39,17 +91,364 @@ export interface Show {
   created_at: string;
   updated_at: string;
   origin_country: string[];
+  tvdb_id?: number | undefined;
+  wikidata_id?: string | undefined;
+  /** Minutes: movie length, or typical episode length for TV. */
+  runtime?: number | undefined;
+  pinned: boolean;
+  /** Movie release or TV first air date (YYYY-MM-DD). */
+  release_date?: string | undefined;
+  /** When the next TV episode airs (YYYY-MM-DD), if known. */
+  next_air_date?: string | undefined;
+  next_episode_season?: number | undefined;
+  next_episode_number?: number | undefined;
+  /** Weighted mean of the present ratings (BF_SCORE_WEIGHT/GF_SCORE_WEIGHT). */
+  couple_score?: number | undefined;
+  /** |bf_rating - gf_rating|, once both are set. */
+  rating_delta?: number | undefined;
+  /** "agree" (delta 0-1), "mixed" (2-3), "disagree" (4+), or "" until both rated. */
+  agreement: string;
+  watched_at?: string | undefined;
+  /** Media servers ("plex", "jellyfin") that had the show at the last sync. */
+  available_on: string[];
+  /**
+   * Download request state: "requested" once sent to Radarr/Sonarr, or the
+   * Overseerr status (pending, processing, partially_available, available,
+   * declined).
+   */
+  request_status?: string | undefined;
+  requested_at?: string | undefined;
+  /** Production company (studio) names. */
+  companies: string[];
+  /** TV network names. */
+  networks: string[];
+  /** TV episode count from TMDB. */
+  episode_count?: number | undefined;
+  /** OMDb scores (0-100), set when OMDB_API_KEY is configured. */
+  rt_score?: number | undefined;
+  metascore?: number | undefined;
+  /**
+   * ISO 639-1 codes: spoken languages, and languages TMDB has a
+   * translation in.
+   */
+  audio_languages: string[];
+  translations: string[];
+  /** Who chose the show ("bf" or "gf"), recorded once it is watched. */
+  picked_by?: string | undefined;
+  /**
+   * Who vetoed the show ("bf" or "gf"); vetoed shows are left out of the
+   * random picker.
+   */
+  vetoed_by?: string | undefined;
+  vetoed_at?: string | undefined;
 }
 
 export interface ShowDetail {
   show: Show | undefined;
   imdb_url?: string | undefined;
+  tvdb_url?: string | undefined;
+  wikidata_url?: string | undefined;
+  /**
+   * DoesTheDogDie topics matching DDTD_SENSITIVITIES; empty unless
+   * DDTD_API_KEY is set.
+   */
+  warnings: ContentWarning[];
+  /**
+   * Scores from TMDB and, with OMDB_API_KEY, IMDb, Rotten Tomatoes and
+   * Metacritic; filled in on TMDB refreshes.
+   */
+  external_ratings: ExternalRating[];
+  /**
+   * First paragraph of the Wikipedia article, found through the Wikidata
+   * ID; only with WIKIPEDIA_SUMMARIES=true.
+   */
+  wikipedia_summary?: string | undefined;
+  wikipedia_url?: string | undefined;
+  /**
+   * People ("bf"/"gf") without a rating, set when the show was just marked
+   * watched under the "warn" watched-ratings rule.
+   */
+  missing_ratings: string[];
+  /**
+   * Flat-rate providers the show streams on in watch_region; both empty
+   * without WATCH_REGION or a subscriptions region.
+   */
+  watch_providers: WatchProvider[];
+  watch_region: string;
+}
+
+export interface ExternalRating {
+  /** "tmdb", "imdb", "rotten_tomatoes" or "metacritic". */
+  source: string;
+  value: number;
+  /** Top of the scale: 10 for TMDB/IMDb, 100 for the others. */
+  max: number;
+  votes: number;
+  /** RFC 3339 time of the provider's last successful fetch. */
+  fetched_at: string;
+}
+
+export interface ContentWarning {
+  topic: string;
+  yes_votes: number;
+  no_votes: number;
 }
 
 export interface ListResponse {
   shows: Show[];
   genres: string[];
   countries: string[];
+  /**
+   * Decade buckets present in the library ("pre-1970", "1980s", ...), for
+   * the decade filter.
+   */
+  decades: string[];
+  /** Production companies of library shows, for the company filter. */
+  companies: Company[];
+  /** TV networks of library shows, for the network filter. */
+  networks: Network[];
+}
+
+export interface Network {
+  id: number;
+  name: string;
+}
+
+export interface Company {
+  id: number;
+  name: string;
+}
+
+/** Library criteria for a smart list; mirrors the /api/shows filters. */
+export interface ListCriteria {
+  status: string;
+  genre: string;
+  origin_country: string;
+  year_from?: number | undefined;
+  year_to?: number | undefined;
+  unrated: boolean;
+  max_runtime?: number | undefined;
+  sort: string;
+}
+
+export interface SavedList {
+  id: number;
+  name: string;
+  /** "smart" lists materialize from criteria on read. */
+  kind: string;
+  criteria: ListCriteria | undefined;
+  show_count: number;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface SavedListsResponse {
+  lists: SavedList[];
+}
+
+export interface SavedListRequest {
+  name: string;
+  criteria: ListCriteria | undefined;
+}
+
+export interface SavedListDetail {
+  list: SavedList | undefined;
+  shows: Show[];
+}
+
+/**
+ * Plan is a movie night: a show scheduled for a time that both partners
+ * confirm.
+ */
+export interface Plan {
+  id: number;
+  show: Show | undefined;
+  /** RFC 3339. */
+  scheduled_at: string;
+  note?: string | undefined;
+  bf_confirmed: boolean;
+  gf_confirmed: boolean;
+  /** Both partners confirmed. */
+  confirmed: boolean;
+  completed_at?: string | undefined;
+  created_at: string;
+}
+
+export interface PlansResponse {
+  plans: Plan[];
+}
+
+/** PlanRequest creates a plan or, on PATCH, changes the set fields. */
+export interface PlanRequest {
+  /** Required on create, ignored on PATCH. */
+  show_id: number;
+  /** RFC 3339; required on create. */
+  scheduled_at?: string | undefined;
+  /** An empty note clears it. */
+  note?: string | undefined;
+}
+
+export interface RSVPRequest {
+  /** "bf" or "gf"; defaults to the session's person. */
+  person: string;
+  going: boolean;
+}
+
+export interface CalendarEntry {
+  /** YYYY-MM-DD. */
+  date: string;
+  /**
+   * "release" for planned titles coming out, "episode" for TV episodes
+   * airing, "plan" for a scheduled movie night.
+   */
+  kind: string;
+  show: Show | undefined;
+  /** Set for "plan" entries. */
+  plan_id?: number | undefined;
+}
+
+export interface CalendarResponse {
+  /** YYYY-MM. */
+  month: string;
+  entries: CalendarEntry[];
+}
+
+export interface UpcomingItem {
+  show: Show | undefined;
+  /** YYYY-MM-DD. */
+  release_date: string;
+  days_until: number;
+}
+
+export interface UpcomingResponse {
+  items: UpcomingItem[];
+}
+
+export interface TasteBucket {
+  /** Genre name, decade ("1990s"), country code, or runtime range ("90-119"). */
+  key: string;
+  count: number;
+  average: number;
+}
+
+export interface TasteProfile {
+  person: string;
+  rated_count: number;
+  average: number;
+  genres: TasteBucket[];
+  decades: TasteBucket[];
+  countries: TasteBucket[];
+  runtimes: TasteBucket[];
+}
+
+export interface GenreCompatibility {
+  genre: string;
+  bf_average: number;
+  gf_average: number;
+  combined: number;
+}
+
+export interface FairnessResponse {
+  /** Watched shows each person picked. */
+  bf_picks: number;
+  gf_picks: number;
+  /** Watched shows with no recorded picker. */
+  unattributed: number;
+  /** Whose turn it is: whoever picked less, or on a tie who did not pick last. */
+  turn: string;
+}
+
+export interface VetoStats {
+  /** Active vetoes each person may hold (VETO_LIMIT). */
+  limit: number;
+  /** Vetoed shows still on the planned list. */
+  bf_active: number;
+  gf_active: number;
+  bf_remaining: number;
+  gf_remaining: number;
+  /** Vetoed shows that were watched anyway. */
+  overruled: number;
+}
+
+export interface WatchEvent {
+  id: number;
+  show_id: number;
+  watched_at: string;
+}
+
+export interface WatchesResponse {
+  /** Most recent first. */
+  watches: WatchEvent[];
+}
+
+export interface LogWatchRequest {
+  /** RFC 3339 or YYYY-MM-DD; defaults to now. */
+  watched_at?: string | undefined;
+}
+
+export interface MonthActivity {
+  /** YYYY-MM. */
+  month: string;
+  watches: number;
+  /** Distinct shows watched; rewatches within the month count once. */
+  shows: number;
+}
+
+export interface ActivityResponse {
+  /** Oldest first; months without watches are left out. */
+  months: MonthActivity[];
+}
+
+export interface CompatibilityResponse {
+  /** Shows both people rated. */
+  shared_count: number;
+  average_delta: number;
+  /** Share of shared shows rated within one point of each other (0-1). */
+  agreement_rate: number;
+  /** 0-100, from the average rating gap on shared shows. */
+  rating_score: number;
+  /** 0-100: share of common genres both rate on the same side of their own average. */
+  genre_overlap: number;
+  shared_favorite: GenreCompatibility[];
+  avoid_together: GenreCompatibility[];
+}
+
+export interface BacklogTotal {
+  /** "movie" or "tv"; empty for the overall total. */
+  media_type: string;
+  count: number;
+  minutes: number;
+  /** minutes / 60, rounded to one decimal. */
+  hours: number;
+  /** Planned titles left out of the sum: no runtime (or episode count) yet. */
+  unknown_count: number;
+}
+
+export interface BacklogResponse {
+  total: BacklogTotal | undefined;
+  media_types: BacklogTotal[];
+}
+
+export interface TonightResponse {
+  /** Random planned shows that fit the time budget. */
+  shows: Show[];
+  /** Whose turn it is to pick ("bf" or "gf"), from the pick counts. */
+  turn: string;
+}
+
+export interface RemindersResponse {
+  person: string;
+  /** Reminders are paused until then (RFC 3339). */
+  snoozed_until?: string | undefined;
+  /** Shows count once they were watched this many days ago. */
+  after_days: number;
+  /** Watched shows the person has not rated, oldest first. */
+  shows: Show[];
+}
+
+export interface SnoozeRequest {
+  /** Days to pause reminders for; 0 resumes them. */
+  days: number;
 }
 
 export interface GenresResponse {
@@ -68,6 +467,11 @@ export interface SearchResult {
   in_library: boolean;
   genres: string[];
   original_language: string;
+  /**
+   * Flat-rate providers in the response's watch_region; only filled for
+   * searches with with_providers=1.
+   */
+  watch_providers: WatchProvider[];
 }
 
 export interface SearchRequest {
@@ -82,6 +486,56 @@ export interface SearchRequest {
   origin_country: string;
   original_language: string;
   page: number;
+  watch_region: string;
+  watch_providers: string;
+  certification_country: string;
+  certification: string;
+  exclude_library: string;
+  hide_watched: string;
+  /** "1" limits discover results to the household's subscriptions. */
+  our_services: string;
+  /** "1980s" or "pre-1970"; expands to year_from/year_to and overrides them. */
+  decade: string;
+  /** Comma-separated TMDB genre IDs to leave out. */
+  genres_exclude: string;
+  /** TMDB production company IDs ("41077|10342" = any); discover only. */
+  companies: string;
+  /** TMDB TV network IDs ("49|2739" = any); TV discover only. */
+  networks: string;
+  /** "1" looks up where each result streams (see SearchResult). */
+  with_providers: string;
+}
+
+export interface SearchHistoryEntry {
+  query: string;
+  count: number;
+  searched_at: string;
+}
+
+export interface SearchHistoryResponse {
+  entries: SearchHistoryEntry[];
+}
+
+export interface Suggestion {
+  text: string;
+  /** "history" for past searches, "keyword" for TMDB keyword matches. */
+  source: string;
+}
+
+export interface SuggestResponse {
+  suggestions: Suggestion[];
+}
+
+export interface RecommendationRow {
+  /** "Because you both liked <title>". */
+  label: string;
+  /** The library show the row is based on. */
+  source: Show | undefined;
+  results: SearchResult[];
+}
+
+export interface RecommendationsResponse {
+  rows: RecommendationRow[];
 }
 
 export interface SearchResponse {
@@ -89,6 +543,34 @@ export interface SearchResponse {
   page: number;
   total_pages: number;
   total_results: number;
+  /**
+   * people are person hits from a combined (media_type=all) text search,
+   * first page only.
+   */
+  people: PersonResult[];
+  /**
+   * Set when a filtered search stopped reading TMDB pages early, at the
+   * page cap or because TMDB was too slow; results and totals are partial.
+   */
+  truncated: boolean;
+  /** Whether there is anything past this page. */
+  has_more: boolean;
+  /** TMDB pages read to answer the request. */
+  pages_scanned: number;
+  /**
+   * Region of the results' watch_providers; empty when they weren't looked
+   * up.
+   */
+  watch_region: string;
+}
+
+export interface PersonResult {
+  id: number;
+  name: string;
+  profile_path: string;
+  known_for_department: string;
+  known_for: SearchResult[];
+  tmdb_url: string;
 }
 
 export interface Genre {
@@ -126,12 +608,56 @@ export interface SearchResolveResponse {
 
 export interface LoginRequest {
   password: string;
+  person: string;
+  /**
+   * false signs in until the browser closes; unset or true uses the
+   * configured cookie lifetime.
+   */
+  remember?: boolean | undefined;
+}
+
+export interface SetPersonRequest {
+  person: string;
+}
+
+export interface Preferences {
+  person: string;
+  metadata_language?: string | undefined;
+}
+
+export interface PreferencesResponse {
+  preferences: Preferences[];
+  default_metadata_language: string;
+}
+
+export interface UpdatePreferencesRequest {
+  metadata_language?: string | undefined;
 }
 
 export interface AddShowRequest {
   tmdb_id: number;
   media_type: string;
   status: string;
+  /** IMDb title ID ("tt0133093") or IMDb URL; used when tmdb_id is not set. */
+  imdb_id: string;
+  /** Full themoviedb.org URL; media type and ID are parsed from the path. */
+  tmdb_url: string;
+}
+
+/**
+ * JSON body of POST /quick-add, required when signing in with the session
+ * cookie instead of the API token.
+ */
+export interface QuickAddRequest {
+  query: string;
+  media_type: string;
+  status: string;
+}
+
+export interface QuickAddResponse {
+  added: boolean;
+  show: ShowDetail | undefined;
+  candidates: SearchResult[];
 }
 
 export interface RatingsRequest {
@@ -139,13 +665,284 @@ export interface RatingsRequest {
   gf_rating?: number | undefined;
   bf_comment?: string | undefined;
   gf_comment?: string | undefined;
+  /**
+   * The signed-in person's own rating and comment, written to their
+   * bf_/gf_ fields; needs a person on the session.
+   */
+  rating?: number | undefined;
+  comment?: string | undefined;
+}
+
+/** Sparse show update for PATCH /shows/{id}; omitted fields are unchanged. */
+export interface ShowPatch {
+  /** "planned" or "watched". */
+  status?: string | undefined;
+  /** 1-10, or 0 to clear. */
+  bf_rating?: number | undefined;
+  gf_rating?: number | undefined;
+  bf_comment?: string | undefined;
+  gf_comment?: string | undefined;
+  /** RFC 3339 or YYYY-MM-DD; empty clears. */
+  watched_at?: string | undefined;
+  pinned?: boolean | undefined;
+  /** "bf" or "gf"; empty clears. */
+  picked_by?: string | undefined;
+}
+
+export interface MediaRequest {
+  /** Radarr/Sonarr quality profile; the configured default when unset. */
+  quality_profile_id?: number | undefined;
+}
+
+export interface StatusRequest {
+  /** "planned" or "watched". */
+  status: string;
+}
+
+export interface UnfreezeRatingsRequest {
+  /**
+   * Your password (the shared one, or your own with an account), asked for
+   * again.
+   */
+  password: string;
+}
+
+export interface EraseRequest {
+  /** The shared password, asked for again. */
+  password: string;
+  /** Must be exactly "erase everything". */
+  confirm: string;
+}
+
+export interface PinRequest {
+  pinned: boolean;
 }
 
 export interface RefreshResponse {
   updated: number;
 }
 
+export interface SyncResponse {
+  /** Shows added or changed since the cursor (all shows when since is 0). */
+  shows: Show[];
+  /** Shows deleted since the cursor. */
+  deleted_ids: number[];
+  /** Opaque; pass back as since on the next sync. */
+  cursor: string;
+}
+
+/**
+ * GET /health answers with status only; GET /admin/health, which needs a
+ * login, fills in the rest.
+ */
+export interface HealthResponse {
+  /**
+   * "ok", "warming" (startup warm-up still running), "degraded" (integrity
+   * problems), or "unavailable" (no database).
+   */
+  status: string;
+  /** When the last integrity check ran; empty before the first one. */
+  integrity_checked_at: string;
+  integrity_problems: string[];
+  /** Unset when the metadata provider has no credentials to rotate. */
+  tmdb: TMDBCredentials | undefined;
+}
+
+export interface TMDBCredentials {
+  /** Configured credentials: TMDB_API_KEY plus TMDB_FALLBACK_KEYS. */
+  count: number;
+  /** Index of the credential in use; 0 is TMDB_API_KEY. */
+  active: number;
+  /** Consecutive 401/429 responses on the active credential. */
+  failures: number;
+  rotations: number;
+  /** When the server last switched credentials; empty if it never did. */
+  rotated_at: string;
+}
+
+export interface OptimizeResponse {
+  /** Database size in bytes before and after maintenance. */
+  size_before: number;
+  size_after: number;
+}
+
+export interface LoginFailureIP {
+  ip: string;
+  count: number;
+  last_at: string;
+  /** Whether sign-ins from the IP are currently refused. */
+  locked: boolean;
+}
+
+export interface LoginFailureBucket {
+  /** Start of the hour, RFC 3339. */
+  start: string;
+  count: number;
+}
+
+export interface LibraryChange {
+  /** Deleted shows keep the ID they had. */
+  show_id: number;
+  tmdb_id: number;
+  media_type: string;
+  title: string;
+  /** Ratings at the start of the period; unset for added shows. */
+  old_bf_rating?: number | undefined;
+  old_gf_rating?: number | undefined;
+  /** Ratings at the end of the period; unset for deleted shows. */
+  new_bf_rating?: number | undefined;
+  new_gf_rating?: number | undefined;
+  /** When the show last changed within the period. */
+  changed_at: string;
+  /**
+   * Remaps only: "remapped" or "remap_skipped" when another show already
+   * had the new TMDB entry (tmdb_id), and the entry before (old_tmdb_id).
+   */
+  kind: string;
+  old_tmdb_id?: number | undefined;
+}
+
+export interface LibraryDiffResponse {
+  since: string;
+  until: string;
+  added: LibraryChange[];
+  deleted: LibraryChange[];
+  /** Shows whose ratings differ between the start and end of the period. */
+  rated: LibraryChange[];
+  /** TMDB entries shows were re-pointed at after upstream merges. */
+  remapped: LibraryChange[];
+}
+
+export interface JobStatus {
+  name: string;
+  /** Interval between runs, e.g. "24h0m0s". */
+  interval: string;
+  runs: number;
+  failures: number;
+  /** RFC 3339; empty before the first run. */
+  last_started_at: string;
+  last_finished_at: string;
+  /** The latest run's error; empty when it succeeded. */
+  last_error: string;
+  next_run_at: string;
+}
+
+export interface JobsResponse {
+  jobs: JobStatus[];
+}
+
+export interface SecurityReport {
+  days: number;
+  total_failures: number;
+  ips: LoginFailureIP[];
+  buckets: LoginFailureBucket[];
+}
+
+export interface WebhookResponse {
+  matched: boolean;
+  show_id: number;
+  /**
+   * The title was left planned: the watched-ratings rule is "block" and
+   * someone hasn't rated it.
+   */
+  ratings_required: boolean;
+}
+
+export interface WatchProvider {
+  id: number;
+  name: string;
+  logo_path: string;
+}
+
+export interface WatchProvidersResponse {
+  providers: WatchProvider[];
+}
+
+export interface Subscriptions {
+  /** ISO 3166-1 region the providers are looked up in. */
+  region: string;
+  providers: WatchProvider[];
+}
+
+export interface UpdateSubscriptionsRequest {
+  region: string;
+  /** TMDB provider IDs; names are resolved server-side. */
+  provider_ids: number[];
+}
+
+export interface WatchedRatingsSetting {
+  /**
+   * "off", "warn" (mark watched and list missing ratings) or "block"
+   * (refuse until both partners rated).
+   */
+  mode: string;
+}
+
+export interface ExportManifest {
+  schema_version: number;
+  show_count: number;
+  sha256: string;
+}
+
 export interface ExportPayload {
   exported_at: string;
   shows: Show[];
+  manifest: ExportManifest | undefined;
+}
+
+/** A spreadsheet row whose title matched more than one TMDB entry. */
+export interface CSVImportReview {
+  /** 1-based line in the file, counting the header. */
+  line: number;
+  title: string;
+  year: string;
+  /** The best matches. */
+  candidates: SearchResult[];
+  /** Pending import to confirm with POST /api/import/pending/{id}/confirm. */
+  id: number;
+}
+
+export interface CSVImportResponse {
+  /** Rows added or merged into the library. */
+  imported: number;
+  /** Rows that failed validation or matched nothing; see errors. */
+  skipped: number;
+  review: CSVImportReview[];
+  errors: string[];
+}
+
+export interface PendingImport {
+  id: number;
+  /** Importer the row came from, e.g. "csv". */
+  source: string;
+  line: number;
+  title: string;
+  year: string;
+  media_type: string;
+  status: string;
+  bf_rating?: number | undefined;
+  gf_rating?: number | undefined;
+  /** Up to three suggested matches, best first. */
+  candidates: SearchResult[];
+  created_at: string;
+}
+
+export interface PendingImportsResponse {
+  pending: PendingImport[];
+}
+
+export interface ConfirmImportRequest {
+  /** Usually one of the candidates, but any TMDB entry is accepted. */
+  tmdb_id: number;
+  media_type: string;
+}
+
+export interface ImportResponse {
+  created: number;
+  updated: number;
+  skipped: number;
+  /** One message per show that failed validation. */
+  errors: string[];
+  /** Existing shows whose frozen ratings and comments were kept. */
+  frozen: number;
 }
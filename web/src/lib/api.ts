@@ -7,6 +7,7 @@ export type ListResponse = pb.ListResponse;
 export type SearchResult = pb.SearchResult;
 export type SearchRequest = pb.SearchRequest;
 export type SearchResponse = pb.SearchResponse;
+export type PersonResult = pb.PersonResult;
 export type SearchGenresResponse = pb.SearchGenresResponse;
 export type SearchCountriesResponse = pb.SearchCountriesResponse;
 export type SearchLanguagesResponse = pb.SearchLanguagesResponse;
@@ -14,8 +15,53 @@ export type SearchResolveResponse = pb.SearchResolveResponse;
 export type LoginRequest = pb.LoginRequest;
 export type AddShowRequest = pb.AddShowRequest;
 export type RatingsRequest = pb.RatingsRequest;
+export type MediaRequest = pb.MediaRequest;
+export type ShowPatch = pb.ShowPatch;
+export type EraseRequest = pb.EraseRequest;
 export type RefreshResponse = pb.RefreshResponse;
 export type ExportPayload = pb.ExportPayload;
+export type ImportResponse = pb.ImportResponse;
+export type CSVImportResponse = pb.CSVImportResponse;
+export type PendingImportsResponse = pb.PendingImportsResponse;
+export type SearchHistoryResponse = pb.SearchHistoryResponse;
+export type SuggestResponse = pb.SuggestResponse;
+export type SavedList = pb.SavedList;
+export type SavedListsResponse = pb.SavedListsResponse;
+export type SavedListRequest = pb.SavedListRequest;
+export type SavedListDetail = pb.SavedListDetail;
+export type SetPersonRequest = pb.SetPersonRequest;
+export type Preferences = pb.Preferences;
+export type PreferencesResponse = pb.PreferencesResponse;
+export type UpdatePreferencesRequest = pb.UpdatePreferencesRequest;
+export type Subscriptions = pb.Subscriptions;
+export type UpdateSubscriptionsRequest = pb.UpdateSubscriptionsRequest;
+export type WatchedRatingsSetting = pb.WatchedRatingsSetting;
+export type WatchProvidersResponse = pb.WatchProvidersResponse;
+export type CalendarResponse = pb.CalendarResponse;
+export type UpcomingResponse = pb.UpcomingResponse;
+export type TasteProfile = pb.TasteProfile;
+export type CompatibilityResponse = pb.CompatibilityResponse;
+export type FairnessResponse = pb.FairnessResponse;
+export type VetoStats = pb.VetoStats;
+export type WatchesResponse = pb.WatchesResponse;
+export type ActivityResponse = pb.ActivityResponse;
+export type BacklogResponse = pb.BacklogResponse;
+export type TonightResponse = pb.TonightResponse;
+export type RemindersResponse = pb.RemindersResponse;
+export type SnoozeRequest = pb.SnoozeRequest;
+export type Plan = pb.Plan;
+export type PlansResponse = pb.PlansResponse;
+export type PlanRequest = pb.PlanRequest;
+export type RSVPRequest = pb.RSVPRequest;
+export type RecommendationsResponse = pb.RecommendationsResponse;
+export type SyncResponse = pb.SyncResponse;
+export type SessionInfo = pb.SessionInfo;
+export type SessionsResponse = pb.SessionsResponse;
+export type SessionPatch = pb.SessionPatch;
+export type ChangePasswordRequest = pb.ChangePasswordRequest;
+export type SecurityReport = pb.SecurityReport;
+export type LibraryDiffResponse = pb.LibraryDiffResponse;
+export type JobsResponse = pb.JobsResponse;
 
 async function jsonRequest<T>(input: RequestInfo, init?: RequestInit): Promise<T> {
   const res = await fetch(input, {
@@ -48,6 +94,33 @@ export const api = {
     jsonRequest<SessionResponse>("/api/logout", {
       method: "POST",
     }),
+  setPerson: (payload: SetPersonRequest) =>
+    jsonRequest<SessionResponse>("/api/session/person", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  getPreferences: () => jsonRequest<PreferencesResponse>("/api/preferences"),
+  updatePreferences: (person: string, payload: UpdatePreferencesRequest) =>
+    jsonRequest<Preferences>(`/api/preferences/${person}`, {
+      method: "PUT",
+      body: JSON.stringify(payload),
+    }),
+  getSubscriptions: () => jsonRequest<Subscriptions>("/api/settings/subscriptions"),
+  updateSubscriptions: (payload: UpdateSubscriptionsRequest) =>
+    jsonRequest<Subscriptions>("/api/settings/subscriptions", {
+      method: "PUT",
+      body: JSON.stringify(payload),
+    }),
+  getWatchedRatings: () => jsonRequest<WatchedRatingsSetting>("/api/settings/watched-ratings"),
+  updateWatchedRatings: (payload: WatchedRatingsSetting) =>
+    jsonRequest<WatchedRatingsSetting>("/api/settings/watched-ratings", {
+      method: "PUT",
+      body: JSON.stringify(payload),
+    }),
+  watchProviders: (region: string) =>
+    jsonRequest<WatchProvidersResponse>(
+      `/api/watch-providers?region=${encodeURIComponent(region)}`,
+    ),
   listShows: (params: URLSearchParams) =>
     jsonRequest<ListResponse>(`/api/shows?${params.toString()}`),
   getShow: (id: number) => jsonRequest<ApiShowDetail>(`/api/shows/${id}`),
@@ -65,18 +138,159 @@ export const api = {
       method: "POST",
       body: JSON.stringify(payload),
     }),
+  setStatus: (id: number, status: string) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/status`, {
+      method: "POST",
+      body: JSON.stringify({ status }),
+    }),
+  veto: (id: number) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/veto`, {
+      method: "POST",
+    }),
+  unveto: (id: number) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/veto`, {
+      method: "DELETE",
+    }),
+  watches: (id: number) =>
+    jsonRequest<WatchesResponse>(`/api/shows/${id}/watches`),
+  logWatch: (id: number, watchedAt?: string) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/watches`, {
+      method: "POST",
+      body: JSON.stringify(watchedAt ? { watched_at: watchedAt } : {}),
+    }),
+  deleteWatch: (id: number, watchId: number) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/watches/${watchId}`, {
+      method: "DELETE",
+    }),
   toggleStatus: (id: number) =>
     jsonRequest<ApiShowDetail>(`/api/shows/${id}/toggle-status`, {
       method: "POST",
     }),
+  clearPersonRating: (id: number, person: string) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/ratings/${person}`, {
+      method: "DELETE",
+    }),
   clearRatings: (id: number) =>
     jsonRequest<ApiShowDetail>(`/api/shows/${id}/clear-ratings`, {
       method: "POST",
     }),
+  setPinned: (id: number, pinned: boolean) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/pin`, {
+      method: "POST",
+      body: JSON.stringify({ pinned }),
+    }),
   refreshShow: (id: number) =>
     jsonRequest<ApiShowDetail>(`/api/shows/${id}/refresh-tmdb`, {
       method: "POST",
     }),
+  patchShow: (id: number, payload: ShowPatch) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}`, {
+      method: "PATCH",
+      body: JSON.stringify(payload),
+    }),
+  requestShow: (id: number, payload: MediaRequest = {}) =>
+    jsonRequest<ApiShowDetail>(`/api/shows/${id}/request`, {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  listLists: () => jsonRequest<SavedListsResponse>("/api/lists"),
+  getList: (id: number) => jsonRequest<SavedListDetail>(`/api/lists/${id}`),
+  createList: (payload: SavedListRequest) =>
+    jsonRequest<SavedListDetail>("/api/lists", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  updateList: (id: number, payload: SavedListRequest) =>
+    jsonRequest<SavedListDetail>(`/api/lists/${id}`, {
+      method: "PUT",
+      body: JSON.stringify(payload),
+    }),
+  deleteList: (id: number) =>
+    jsonRequest<void>(`/api/lists/${id}`, {
+      method: "DELETE",
+    }),
+  listPlans: (all = false) => jsonRequest<PlansResponse>(`/api/plans${all ? "?all=1" : ""}`),
+  getPlan: (id: number) => jsonRequest<Plan>(`/api/plans/${id}`),
+  createPlan: (payload: PlanRequest) =>
+    jsonRequest<Plan>("/api/plans", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  updatePlan: (id: number, payload: PlanRequest) =>
+    jsonRequest<Plan>(`/api/plans/${id}`, {
+      method: "PATCH",
+      body: JSON.stringify(payload),
+    }),
+  deletePlan: (id: number) =>
+    jsonRequest<void>(`/api/plans/${id}`, {
+      method: "DELETE",
+    }),
+  rsvpPlan: (id: number, payload: RSVPRequest) =>
+    jsonRequest<Plan>(`/api/plans/${id}/rsvp`, {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  completePlan: (id: number) =>
+    jsonRequest<Plan>(`/api/plans/${id}/complete`, {
+      method: "POST",
+    }),
+  sessions: () => jsonRequest<SessionsResponse>("/api/sessions"),
+  renameSession: (id: number, payload: SessionPatch) =>
+    jsonRequest<void>(`/api/sessions/${id}`, {
+      method: "PATCH",
+      body: JSON.stringify(payload),
+    }),
+  deleteSession: (id: number) =>
+    jsonRequest<void>(`/api/sessions/${id}`, {
+      method: "DELETE",
+    }),
+  changePassword: (payload: ChangePasswordRequest) =>
+    jsonRequest<void>("/api/auth/change-password", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  setAccountPassword: (payload: ChangePasswordRequest) =>
+    jsonRequest<void>("/api/auth/account-password", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  securityReport: (days = 7) => jsonRequest<SecurityReport>(`/api/admin/security?days=${days}`),
+  jobs: () => jsonRequest<JobsResponse>("/api/admin/jobs"),
+  libraryDiff: (since?: string, until?: string) => {
+    const params = new URLSearchParams();
+    if (since) params.set("since", since);
+    if (until) params.set("until", until);
+    return jsonRequest<LibraryDiffResponse>(`/api/admin/diff?${params}`);
+  },
+  unfreezeRatings: (id: number, password: string) =>
+    jsonRequest<ApiShowDetail>(`/api/admin/shows/${id}/unfreeze-ratings`, {
+      method: "POST",
+      body: JSON.stringify({ password }),
+    }),
+  calendar: (month: string) =>
+    jsonRequest<CalendarResponse>(`/api/calendar?month=${encodeURIComponent(month)}`),
+  upcoming: () => jsonRequest<UpcomingResponse>("/api/upcoming"),
+  tasteProfile: (person: string) => jsonRequest<TasteProfile>(`/api/stats/taste/${person}`),
+  compatibility: () => jsonRequest<CompatibilityResponse>("/api/stats/compatibility"),
+  backlog: () => jsonRequest<BacklogResponse>("/api/stats/backlog"),
+  fairness: () => jsonRequest<FairnessResponse>("/api/stats/fairness"),
+  vetoStats: () => jsonRequest<VetoStats>("/api/stats/vetoes"),
+  activity: (months = 12) =>
+    jsonRequest<ActivityResponse>(`/api/stats/activity?months=${months}`),
+  recommendations: () => jsonRequest<RecommendationsResponse>("/api/recommendations"),
+  tonight: (maxMinutes: number, mediaType = "") => {
+    const params = new URLSearchParams({ max_minutes: String(maxMinutes) });
+    if (mediaType) params.set("media_type", mediaType);
+    return jsonRequest<TonightResponse>(`/api/tonight?${params.toString()}`);
+  },
+  reminders: (person: string) => jsonRequest<RemindersResponse>(`/api/reminders/${person}`),
+  snoozeReminders: (person: string, payload: SnoozeRequest) =>
+    jsonRequest<RemindersResponse>(`/api/reminders/${person}/snooze`, {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  sync: (since = "0") =>
+    jsonRequest<SyncResponse>(`/api/sync?since=${encodeURIComponent(since)}`),
   search: (params: URLSearchParams) =>
     jsonRequest<SearchResponse>(`/api/search?${params.toString()}`),
   searchGenres: () => jsonRequest<SearchGenresResponse>("/api/search/genres"),
@@ -86,12 +300,47 @@ export const api = {
     jsonRequest<SearchResolveResponse>(
       `/api/search/resolve?tmdb_id=${tmdbId}&media_type=${mediaType}`,
     ),
+  searchHistory: () => jsonRequest<SearchHistoryResponse>("/api/search/history"),
+  clearSearchHistory: () =>
+    jsonRequest<void>("/api/search/history", {
+      method: "DELETE",
+    }),
+  searchSuggest: (q: string, { signal }: { signal?: AbortSignal } = {}) =>
+    jsonRequest<SuggestResponse>(`/api/search/suggest?q=${encodeURIComponent(q)}`, { signal }),
   refreshTMDB: () =>
     jsonRequest<RefreshResponse>("/api/refresh-tmdb", {
       method: "POST",
     }),
-  exportData: () =>
-    fetch("/api/export", {
+  importLibrary: (file: Blob, mode: "merge" | "replace" = "merge") =>
+    jsonRequest<ImportResponse>(`/api/import?mode=${mode}`, {
+      method: "POST",
+      headers: { "Content-Type": "application/octet-stream" },
+      body: file,
+    }),
+  importCSV: (file: Blob) =>
+    jsonRequest<CSVImportResponse>("/api/import/csv", {
+      method: "POST",
+      headers: { "Content-Type": "text/csv" },
+      body: file,
+    }),
+  pendingImports: () =>
+    jsonRequest<PendingImportsResponse>("/api/import/pending"),
+  confirmImport: (id: number, tmdbId: number, mediaType: string) =>
+    jsonRequest<ApiShowDetail>(`/api/import/pending/${id}/confirm`, {
+      method: "POST",
+      body: JSON.stringify({ tmdb_id: tmdbId, media_type: mediaType }),
+    }),
+  dismissImport: (id: number) =>
+    jsonRequest<void>(`/api/import/pending/${id}`, {
+      method: "DELETE",
+    }),
+  eraseAll: (payload: EraseRequest) =>
+    jsonRequest<SessionResponse>("/api/erase", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  exportData: (format = "json", anonymize = false) =>
+    fetch(`/api/export?format=${encodeURIComponent(format)}${anonymize ? "&anonymize=1" : ""}`, {
       method: "POST",
       credentials: "include",
     }),
@@ -136,8 +136,8 @@ function DetailContent({
     },
   });
 
-  const toggleStatusMutation = useMutation({
-    mutationFn: () => api.toggleStatus(showId),
+  const statusMutation = useMutation({
+    mutationFn: (status: string) => api.setStatus(showId, status),
     onSuccess: (data) => {
       queryClient.setQueryData(["show", String(showId)], data);
       queryClient.invalidateQueries({ queryKey: ["shows"] });
@@ -248,8 +248,10 @@ function DetailContent({
                   "rounded-full border px-2 py-0.5 uppercase tracking-wide transition hover:shadow-[0_0_0_1px_rgba(255,255,255,0.06)] hover:brightness-110",
                   statusTone,
                 )}
-                onClick={() => toggleStatusMutation.mutate()}
-                disabled={toggleStatusMutation.isPending}
+                onClick={() =>
+                  statusMutation.mutate(show.status === "watched" ? "planned" : "watched")
+                }
+                disabled={statusMutation.isPending}
               >
                 {show.status || "tbd"}
               </button>
@@ -4,6 +4,7 @@ import FilterField from "@/components/filter-field";
 import FiltersPane from "@/components/filters-pane";
 import { FiltersPaneContent } from "@/components/filters-pane-content";
 import { GenreCombobox } from "@/components/genre-combobox";
+import { LanguageCombobox } from "@/components/language-combobox";
 import { LoadingGrid } from "@/components/loading-grid";
 import { OriginCountriesChip } from "@/components/origin-countries-chip";
 import RatingChips from "@/components/rating-chips";
@@ -70,11 +71,16 @@ export function LibraryPage() {
   const initialParams = useMemo(() => new URLSearchParams(window.location.search), []);
   const [status, setStatus] = useState(() => initialParams.get("status") ?? "all");
   const [genre, setGenre] = useState(() => initialParams.get("genre") ?? "");
+  const [excludeGenre, setExcludeGenre] = useState(() => initialParams.get("genres_exclude") ?? "");
   const [originCountry, setOriginCountry] = useState(() =>
     (initialParams.get("origin_country") ?? "").toUpperCase(),
   );
   const [yearFrom, setYearFrom] = useState(() => initialParams.get("year_from") ?? "");
   const [yearTo, setYearTo] = useState(() => initialParams.get("year_to") ?? "");
+  const [decade, setDecade] = useState(() => initialParams.get("decade") ?? "");
+  const [company, setCompany] = useState(() => initialParams.get("company") ?? "");
+  const [network, setNetwork] = useState(() => initialParams.get("network") ?? "");
+  const [language, setLanguage] = useState(() => initialParams.get("language") ?? "");
   const [unrated, setUnrated] = useState(() => initialParams.get("unrated") === "1");
   const [sort, setSort] = useState(() => initialParams.get("sort") ?? "updated");
   const [filtersOpen, setFiltersOpen] = useState(false);
@@ -101,8 +107,18 @@ export function LibraryPage() {
     retry: 1,
   });
 
+  const languagesQuery = useQuery({
+    queryKey: ["search-languages"],
+    queryFn: api.searchLanguages,
+    staleTime: 1000 * 60 * 60 * 24,
+    refetchOnWindowFocus: false,
+    refetchOnReconnect: false,
+    refetchOnMount: false,
+    retry: 1,
+  });
+
   const debouncedFilters = useDebouncedValue(
-    { status, genre, originCountry, yearFrom, yearTo, unrated, sort },
+    { status, genre, excludeGenre, originCountry, yearFrom, yearTo, decade, company, network, language, unrated, sort },
     250,
   );
 
@@ -111,9 +127,14 @@ export function LibraryPage() {
     if (debouncedFilters.status && debouncedFilters.status !== "all")
       p.set("status", debouncedFilters.status);
     if (debouncedFilters.genre) p.set("genre", debouncedFilters.genre);
+    if (debouncedFilters.excludeGenre) p.set("genres_exclude", debouncedFilters.excludeGenre);
     if (debouncedFilters.originCountry) p.set("origin_country", debouncedFilters.originCountry);
     if (debouncedFilters.yearFrom) p.set("year_from", debouncedFilters.yearFrom);
     if (debouncedFilters.yearTo) p.set("year_to", debouncedFilters.yearTo);
+    if (debouncedFilters.decade) p.set("decade", debouncedFilters.decade);
+    if (debouncedFilters.company) p.set("company", debouncedFilters.company);
+    if (debouncedFilters.network) p.set("network", debouncedFilters.network);
+    if (debouncedFilters.language) p.set("language", debouncedFilters.language);
     if (debouncedFilters.unrated) p.set("unrated", "1");
     if (debouncedFilters.sort && debouncedFilters.sort !== "updated")
       p.set("sort", debouncedFilters.sort);
@@ -121,9 +142,14 @@ export function LibraryPage() {
   }, [
     debouncedFilters.status,
     debouncedFilters.genre,
+    debouncedFilters.excludeGenre,
     debouncedFilters.originCountry,
     debouncedFilters.yearFrom,
     debouncedFilters.yearTo,
+    debouncedFilters.decade,
+    debouncedFilters.company,
+    debouncedFilters.network,
+    debouncedFilters.language,
     debouncedFilters.unrated,
     debouncedFilters.sort,
   ]);
@@ -175,9 +201,14 @@ export function LibraryPage() {
     if (debouncedFilters.status && debouncedFilters.status !== "all")
       next.set("status", debouncedFilters.status);
     if (debouncedFilters.genre) next.set("genre", debouncedFilters.genre);
+    if (debouncedFilters.excludeGenre) next.set("genres_exclude", debouncedFilters.excludeGenre);
     if (debouncedFilters.originCountry) next.set("origin_country", debouncedFilters.originCountry);
     if (debouncedFilters.yearFrom) next.set("year_from", debouncedFilters.yearFrom);
     if (debouncedFilters.yearTo) next.set("year_to", debouncedFilters.yearTo);
+    if (debouncedFilters.decade) next.set("decade", debouncedFilters.decade);
+    if (debouncedFilters.company) next.set("company", debouncedFilters.company);
+    if (debouncedFilters.network) next.set("network", debouncedFilters.network);
+    if (debouncedFilters.language) next.set("language", debouncedFilters.language);
     if (debouncedFilters.unrated) next.set("unrated", "1");
     if (debouncedFilters.sort && debouncedFilters.sort !== "updated")
       next.set("sort", debouncedFilters.sort);
@@ -188,9 +219,14 @@ export function LibraryPage() {
   }, [
     debouncedFilters.status,
     debouncedFilters.genre,
+    debouncedFilters.excludeGenre,
     debouncedFilters.originCountry,
     debouncedFilters.yearFrom,
     debouncedFilters.yearTo,
+    debouncedFilters.decade,
+    debouncedFilters.company,
+    debouncedFilters.network,
+    debouncedFilters.language,
     debouncedFilters.unrated,
     debouncedFilters.sort,
   ]);
@@ -198,6 +234,10 @@ export function LibraryPage() {
   const shows = showsQuery.data?.shows ?? [];
   const genres = showsQuery.data?.genres ?? [];
   const countries = showsQuery.data?.countries ?? [];
+  const decades = showsQuery.data?.decades ?? [];
+  const companies = showsQuery.data?.companies ?? [];
+  const networks = showsQuery.data?.networks ?? [];
+  const languages = languagesQuery.data?.languages ?? [];
   const countryNames = countriesQuery.data?.countries ?? [];
   const countryLabel = (code: string) =>
     countryNames.find((country) => country.code === code)?.name ?? code;
@@ -210,6 +250,8 @@ export function LibraryPage() {
     { value: "avg", label: "Average rating" },
     { value: "bf", label: `${bfName} rating` },
     { value: "gf", label: `${gfName} rating` },
+    { value: "rt", label: "Rotten Tomatoes" },
+    { value: "metascore", label: "Metascore" },
     { value: "year", label: "Year" },
     { value: "title", label: "Title" },
   ];
@@ -244,6 +286,18 @@ export function LibraryPage() {
           </div>
         </FilterField>
 
+        <FilterField label="Exclude genre">
+          <div className="w-full min-w-0">
+            <GenreCombobox
+              value={excludeGenre}
+              onValueChange={setExcludeGenre}
+              genres={genres}
+              placeholder="None"
+              anyLabel="None"
+            />
+          </div>
+        </FilterField>
+
         <FilterField label="Origin country">
           <div className="w-full min-w-0">
             <CountryCombobox
@@ -277,6 +331,75 @@ export function LibraryPage() {
           </FilterField>
         </div>
 
+        <FilterField label="Decade">
+          <Select
+            value={decade || "any"}
+            onValueChange={(value) => setDecade(value === "any" ? "" : value)}
+          >
+            <SelectTrigger>
+              <SelectValue placeholder="Any" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="any">Any</SelectItem>
+              {decades.map((value) => (
+                <SelectItem key={value} value={value}>
+                  {value}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </FilterField>
+
+        <FilterField label="Studio">
+          <Select
+            value={company || "any"}
+            onValueChange={(value) => setCompany(value === "any" ? "" : value)}
+          >
+            <SelectTrigger>
+              <SelectValue placeholder="Any" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="any">Any</SelectItem>
+              {companies.map((item) => (
+                <SelectItem key={item.id} value={String(item.id)}>
+                  {item.name}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </FilterField>
+
+        <FilterField label="Network">
+          <Select
+            value={network || "any"}
+            onValueChange={(value) => setNetwork(value === "any" ? "" : value)}
+          >
+            <SelectTrigger>
+              <SelectValue placeholder="Any" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="any">Any</SelectItem>
+              {networks.map((item) => (
+                <SelectItem key={item.id} value={String(item.id)}>
+                  {item.name}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </FilterField>
+
+        <FilterField label="Audio or subtitles">
+          <div className="w-full min-w-0">
+            <LanguageCombobox
+              value={language}
+              onValueChange={setLanguage}
+              options={languages}
+              placeholder="Any"
+              anyLabel="Any"
+            />
+          </div>
+        </FilterField>
+
         <FilterField label="Sort">
           <Select value={sort} onValueChange={setSort}>
             <SelectTrigger>
@@ -319,9 +442,14 @@ export function LibraryPage() {
           onClick={() => {
             setStatus("all");
             setGenre("");
+            setExcludeGenre("");
             setOriginCountry("");
             setYearFrom("");
             setYearTo("");
+            setDecade("");
+            setCompany("");
+            setNetwork("");
+            setLanguage("");
             setUnrated(false);
             setSort("updated");
           }}
@@ -1,5 +1,6 @@
 import { Button } from "@/components/ui/button";
 import { Card, CardContent } from "@/components/ui/card";
+import { Checkbox } from "@/components/ui/checkbox";
 import { Input } from "@/components/ui/input";
 import { api, type SessionResponse } from "@/lib/api";
 import { withViewTransition } from "@/lib/view-transitions";
@@ -8,8 +9,16 @@ import { useNavigate } from "@tanstack/react-router";
 import type { FormEvent } from "react";
 import { useState } from "react";
 
+const personOptions = [
+  { value: "", label: "Shared" },
+  { value: "bf", label: "BF" },
+  { value: "gf", label: "GF" },
+];
+
 export function LoginPage() {
   const [password, setPassword] = useState("");
+  const [person, setPerson] = useState("");
+  const [remember, setRemember] = useState(true);
   const [error, setError] = useState("");
   const navigate = useNavigate();
   const queryClient = useQueryClient();
@@ -29,7 +38,7 @@ export function LoginPage() {
   const handleSubmit = (event: FormEvent) => {
     event.preventDefault();
     setError("");
-    loginMutation.mutate({ password });
+    loginMutation.mutate({ password, person, remember });
   };
 
   const sessionQuery = useQuery({
@@ -57,6 +66,21 @@ export function LoginPage() {
             </p>
           </div>
           <form className="space-y-4" onSubmit={handleSubmit}>
+            <div className="flex gap-2" role="radiogroup" aria-label="Who is signing in">
+              {personOptions.map((option) => (
+                <Button
+                  key={option.value}
+                  type="button"
+                  size="sm"
+                  role="radio"
+                  aria-checked={person === option.value}
+                  variant={person === option.value ? "default" : "outline"}
+                  onClick={() => setPerson(option.value)}
+                >
+                  {option.label}
+                </Button>
+              ))}
+            </div>
             <Input
               type="password"
               name="password"
@@ -65,6 +89,18 @@ export function LoginPage() {
               value={password}
               onChange={(event) => setPassword(event.target.value)}
             />
+            <label className="flex items-center gap-3 text-sm">
+              <Checkbox
+                checked={remember}
+                onCheckedChange={(value) => setRemember(Boolean(value))}
+              />
+              <span>
+                Keep me signed in
+                <span className="block text-xs text-muted-foreground">
+                  Leave unchecked on shared devices.
+                </span>
+              </span>
+            </label>
             <Button type="submit" className="w-full" disabled={loginMutation.isPending}>
               Enter
             </Button>
@@ -46,7 +46,7 @@ import {
   SelectValue,
 } from "@/components/ui/select";
 import { Separator } from "@/components/ui/separator";
-import type { SearchResponse, SearchResult } from "@/lib/api";
+import type { PersonResult, SearchResponse, SearchResult } from "@/lib/api";
 import { api } from "@/lib/api";
 import { useDebouncedValue } from "@/lib/use-debounced-value";
 import { useKeyboardInset } from "@/lib/use-keyboard-inset";
@@ -66,6 +66,7 @@ const sortOptions = [
 ] as const;
 
 const mediaTypeOptions = [
+  { value: "all", label: "All" },
   { value: "movie", label: "Movie" },
   { value: "tv", label: "TV" },
 ] as const;
@@ -75,7 +76,7 @@ type Sort = (typeof sortOptions)[number]["value"];
 
 function sanitizeMediaType(raw: string | null | undefined): MediaType {
   const v = (raw ?? "").toLowerCase().trim();
-  return v === "tv" ? "tv" : "movie";
+  return v === "tv" || v === "all" ? v : "movie";
 }
 
 function sanitizeSort(raw: string | null | undefined): Sort {
@@ -345,6 +346,7 @@ export function SearchPage() {
 
   const results: SearchResult[] =
     searchQuery.data?.results?.filter((item): item is SearchResult => Boolean(item)) ?? [];
+  const people: PersonResult[] = searchQuery.data?.people ?? [];
 
   const totalResults = searchQuery.data?.total_results ?? 0;
   const totalPages = searchQuery.data?.total_pages ?? 0;
@@ -408,10 +410,14 @@ export function SearchPage() {
     setGenreMode("all");
   }, [mediaType]);
 
+  const movieGenres = searchGenresQuery.data?.movie_genres ?? [];
+  const tvGenres = searchGenresQuery.data?.tv_genres ?? [];
   const availableGenres =
     mediaType === "movie"
-      ? (searchGenresQuery.data?.movie_genres ?? [])
-      : (searchGenresQuery.data?.tv_genres ?? []);
+      ? movieGenres
+      : mediaType === "tv"
+        ? tvGenres
+        : [...movieGenres, ...tvGenres.filter((g) => !movieGenres.some((m) => m.id === g.id))];
 
   const availableCountries = searchCountriesQuery.data?.countries ?? [];
   const availableLanguages = searchLanguagesQuery.data?.languages ?? [];
@@ -660,6 +666,40 @@ export function SearchPage() {
           </Empty>
         ) : null}
 
+        {people.length ? (
+          <div className="flex flex-wrap gap-3">
+            {people.map((person) => (
+              <a
+                key={person.id}
+                href={person.tmdb_url}
+                target="_blank"
+                rel="noreferrer"
+                className="flex max-w-xs items-center gap-3 rounded-lg border border-border/60 bg-card/30 p-2 hover:bg-card/60"
+              >
+                {person.profile_path ? (
+                  <img
+                    src={`${imageBase}${person.profile_path}`}
+                    alt={person.name}
+                    className="h-14 w-10 rounded object-cover"
+                    loading="lazy"
+                  />
+                ) : null}
+                <div className="min-w-0 text-sm">
+                  <div className="font-medium">{person.name}</div>
+                  {person.known_for_department ? (
+                    <div className="text-xs text-muted-foreground">{person.known_for_department}</div>
+                  ) : null}
+                  {person.known_for?.length ? (
+                    <div className="truncate text-xs text-muted-foreground">
+                      {person.known_for.map((item) => item.title).join(", ")}
+                    </div>
+                  ) : null}
+                </div>
+              </a>
+            ))}
+          </div>
+        ) : null}
+
         {isInitialLoading ? <LoadingGrid /> : null}
         <CardGrid className={`transition-opacity ${isFetching ? "opacity-60" : "opacity-100"}`}>
           {results.map((item) => {